@@ -13,7 +13,7 @@ func NewAccessAvailabilityControlInformation(info *IE) *IE {
 func (i *IE) AccessAvailabilityControlInformation() ([]*IE, error) {
 	switch i.Type {
 	case AccessAvailabilityControlInformation:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreateSRR:
 		ies, err := i.CreateSRR()
 		if err != nil {
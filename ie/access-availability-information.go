@@ -4,21 +4,69 @@
 
 package ie
 
+// AccessTypeValue is the Access Type in AccessAvailabilityInformation IE.
+type AccessTypeValue uint8
+
 // AccessType definitions.
 const (
-	AccessType3GPP    uint8 = 0
-	AccessTypeNon3GPP uint8 = 1
+	AccessType3GPP    AccessTypeValue = 0
+	AccessTypeNon3GPP AccessTypeValue = 1
 )
 
+var accessTypeValues = newEnum("AccessTypeValue", map[AccessTypeValue]string{
+	AccessType3GPP:    "AccessType3GPP",
+	AccessTypeNon3GPP: "AccessTypeNon3GPP",
+})
+
+// String returns the name of the AccessTypeValue, e.g., "AccessType3GPP".
+func (v AccessTypeValue) String() string {
+	return accessTypeValues.name(v)
+}
+
+// IsValid reports whether the AccessTypeValue is a defined value.
+func (v AccessTypeValue) IsValid() bool {
+	return accessTypeValues.valid(v)
+}
+
+// AccessTypeValueByName returns the AccessTypeValue of the given name, e.g., "AccessType3GPP".
+// The value in decimal is also accepted.
+func AccessTypeValueByName(name string) (AccessTypeValue, bool) {
+	return accessTypeValues.byName(name)
+}
+
+// AvailabilityStatusValue is the Availability Status in AccessAvailabilityInformation IE.
+type AvailabilityStatusValue uint8
+
 // AvailabilityStatus definitions.
 const (
-	AvailabilityStatusAccessHasBecomeUnavaiable uint8 = 0
-	AvailabilityStatusAccessHasBecomeAvaiable   uint8 = 1
+	AvailabilityStatusAccessHasBecomeUnavaiable AvailabilityStatusValue = 0
+	AvailabilityStatusAccessHasBecomeAvaiable   AvailabilityStatusValue = 1
 )
 
+var availabilityStatusValues = newEnum("AvailabilityStatusValue", map[AvailabilityStatusValue]string{
+	AvailabilityStatusAccessHasBecomeUnavaiable: "AvailabilityStatusAccessHasBecomeUnavaiable",
+	AvailabilityStatusAccessHasBecomeAvaiable:   "AvailabilityStatusAccessHasBecomeAvaiable",
+})
+
+// String returns the name of the AvailabilityStatusValue, e.g., "AvailabilityStatusAccessHasBecomeAvaiable".
+func (v AvailabilityStatusValue) String() string {
+	return availabilityStatusValues.name(v)
+}
+
+// IsValid reports whether the AvailabilityStatusValue is a defined value.
+func (v AvailabilityStatusValue) IsValid() bool {
+	return availabilityStatusValues.valid(v)
+}
+
+// AvailabilityStatusValueByName returns the AvailabilityStatusValue of the given name, e.g., "AvailabilityStatusAccessHasBecomeAvaiable".
+// The value in decimal is also accepted.
+func AvailabilityStatusValueByName(name string) (AvailabilityStatusValue, bool) {
+	return availabilityStatusValues.byName(name)
+}
+
 // NewAccessAvailabilityInformation creates a new AccessAvailabilityInformation IE.
-func NewAccessAvailabilityInformation(status, atype uint8) *IE {
-	return newUint8ValIE(AccessAvailabilityInformation, ((status&0x03)<<2)|atype&0x03)
+func NewAccessAvailabilityInformation(status AvailabilityStatusValue, atype AccessTypeValue) *IE {
+	return newUint8ValIE(AccessAvailabilityInformation, ((uint8(status)&0x03)<<2)|uint8(atype)&0x03)
 }
 
 // AccessAvailabilityInformation returns AccessAvailabilityInformation in uint8 if the type of IE matches.
@@ -43,22 +91,22 @@ func (i *IE) AccessAvailabilityInformation() (uint8, error) {
 	}
 }
 
-// AvailabilityStatus returns AvailabilityStatus in uint8 if the type of IE matches.
-func (i *IE) AvailabilityStatus() (uint8, error) {
+// AvailabilityStatus returns AvailabilityStatus in AvailabilityStatusValue if the type of IE matches.
+func (i *IE) AvailabilityStatus() (AvailabilityStatusValue, error) {
 	v, err := i.AccessAvailabilityInformation()
 	if err != nil {
 		return 0, err
 	}
 
-	return (v >> 2) & 0x03, nil
+	return AvailabilityStatusValue(v>>2) & 0x03, nil
 }
 
-// AccessType returns AccessType in uint8 if the type of IE matches.
-func (i *IE) AccessType() (uint8, error) {
+// AccessType returns AccessType in AccessTypeValue if the type of IE matches.
+func (i *IE) AccessType() (AccessTypeValue, error) {
 	v, err := i.AccessAvailabilityInformation()
 	if err != nil {
 		return 0, err
 	}
 
-	return v & 0x03, nil
+	return AccessTypeValue(v) & 0x03, nil
 }
@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewAddMBSUnicastParameters creates a new AddMBSUnicastParameters IE.
+func NewAddMBSUnicastParameters(ies ...*IE) *IE {
+	return newGroupedIE(AddMBSUnicastParameters, 0, ies...)
+}
+
+// AddMBSUnicastParameters returns the IEs above AddMBSUnicastParameters if the type of IE matches.
+func (i *IE) AddMBSUnicastParameters() ([]*IE, error) {
+	if i.Type != AddMBSUnicastParameters {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
@@ -13,7 +13,7 @@ func NewAdditionalMonitoringTime(ies ...*IE) *IE {
 func (i *IE) AdditionalMonitoringTime() ([]*IE, error) {
 	switch i.Type {
 	case AdditionalMonitoringTime:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreateURR:
 		ies, err := i.CreateURR()
 		if err != nil {
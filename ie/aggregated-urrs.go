@@ -13,7 +13,7 @@ func NewAggregatedURRs(ies ...*IE) *IE {
 func (i *IE) AggregatedURRs() ([]*IE, error) {
 	switch i.Type {
 	case AggregatedURRs:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreateURR:
 		ies, err := i.CreateURR()
 		if err != nil {
@@ -13,7 +13,7 @@ func NewApplicationDetectionInformation(ies ...*IE) *IE {
 func (i *IE) ApplicationDetectionInformation() ([]*IE, error) {
 	switch i.Type {
 	case ApplicationDetectionInformation:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case UsageReportWithinSessionModificationResponse,
 		UsageReportWithinSessionDeletionResponse,
 		UsageReportWithinSessionReportRequest:
@@ -6,6 +6,73 @@ package ie
 
 import "io"
 
+// ApplyActionFlags is a set of the flags in ApplyAction IE.
+type ApplyActionFlags uint16
+
+// ApplyAction flag definitions.
+const (
+	ApplyActionDROP ApplyActionFlags = 1 << iota
+	ApplyActionFORW
+	ApplyActionBUFF
+	ApplyActionNOCP
+	ApplyActionDUPL
+	ApplyActionIPMA
+	ApplyActionIPMD
+	ApplyActionDFRT
+	ApplyActionEDRT
+	ApplyActionBDPN
+	ApplyActionDDPN
+	ApplyActionFSSM
+	ApplyActionMBSU
+)
+
+var applyActionFlags = &flagSet{
+	names: []string{
+		"DROP", "FORW", "BUFF", "NOCP", "DUPL", "IPMA", "IPMD", "DFRT", "EDRT",
+		"BDPN", "DDPN", "FSSM", "MBSU",
+	},
+	minOctets: 1,
+}
+
+// Has reports whether all the given flags are set.
+func (f ApplyActionFlags) Has(flags ApplyActionFlags) bool {
+	return f&flags == flags
+}
+
+// Set sets the given flags.
+func (f *ApplyActionFlags) Set(flags ApplyActionFlags) {
+	*f |= flags
+}
+
+// Clear clears the given flags.
+func (f *ApplyActionFlags) Clear(flags ApplyActionFlags) {
+	*f &^= flags
+}
+
+// String returns the names of the set flags joined with "|", e.g., "FORW|NOCP".
+func (f ApplyActionFlags) String() string {
+	return applyActionFlags.string(uint64(f))
+}
+
+// Octets returns the flags serialized in as many octets as required by the set flags.
+func (f ApplyActionFlags) Octets() []byte {
+	return applyActionFlags.octets(uint64(f))
+}
+
+// NewApplyActionFlags creates a new ApplyAction IE from the flag set.
+func NewApplyActionFlags(flags ApplyActionFlags) *IE {
+	return New(ApplyAction, flags.Octets())
+}
+
+// ApplyActionFlags returns ApplyAction in ApplyActionFlags if the type of IE matches.
+func (i *IE) ApplyActionFlags() (ApplyActionFlags, error) {
+	v, err := i.ApplyAction()
+	if err != nil {
+		return 0, err
+	}
+	return ApplyActionFlags(applyActionFlags.fromOctets(v)), nil
+}
+
 // NewApplyAction creates a new ApplyAction IE.
 func NewApplyAction(flagsOctets ...uint8) *IE {
 	return New(ApplyAction, flagsOctets)
@@ -149,11 +216,20 @@ func (i *IE) HasNOCP() bool {
 
 // HasDUPL reports whether an IE has DUPL bit.
 func (i *IE) HasDUPL() bool {
-	v, err := i.ApplyAction()
-	if err != nil {
-		return false
+	switch i.Type {
+	case ReportingFlags, DirectReportingInformation:
+		v, err := i.ReportingFlags()
+		if err != nil {
+			return false
+		}
+		return has1stBit(v)
+	default:
+		v, err := i.ApplyAction()
+		if err != nil {
+			return false
+		}
+		return has5thBit(v[0])
 	}
-	return has5thBit(v[0])
 }
 
 // HasIPMA reports wether an IE has IPMA bit.
@@ -0,0 +1,53 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewAreaSessionID creates a new AreaSessionID IE.
+func NewAreaSessionID(v uint16) *IE {
+	return newUint16ValIE(AreaSessionID, v)
+}
+
+// AreaSessionID returns AreaSessionID in uint16 if the type of IE matches.
+func (i *IE) AreaSessionID() (uint16, error) {
+	switch i.Type {
+	case AreaSessionID:
+		return i.ValueAsUint16()
+	case MBSSessionN4mbControlInformation:
+		ies, err := i.MBSSessionN4mbControlInformation()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == AreaSessionID {
+				return x.AreaSessionID()
+			}
+		}
+		return 0, ErrIENotFound
+	case MBSSessionN4ControlInformation:
+		ies, err := i.MBSSessionN4ControlInformation()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == AreaSessionID {
+				return x.AreaSessionID()
+			}
+		}
+		return 0, ErrIENotFound
+	case MBSSessionN4Information:
+		ies, err := i.MBSSessionN4Information()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == AreaSessionID {
+				return x.AreaSessionID()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
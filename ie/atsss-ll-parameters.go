@@ -13,7 +13,7 @@ func NewATSSSLLParameters(info *IE) *IE {
 func (i *IE) ATSSSLLParameters() ([]*IE, error) {
 	switch i.Type {
 	case ATSSSLLParameters:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case ATSSSControlParameters:
 		ies, err := i.ATSSSControlParameters()
 		if err != nil {
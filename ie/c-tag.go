@@ -157,7 +157,7 @@ func (f *CTAGFields) UnmarshalBinary(b []byte) error {
 	}
 
 	if f.HasVID() {
-		f.CVID = binary.BigEndian.Uint16(b[offset:offset+2]) & 0xf0ff
+		f.CVID = uint16(b[offset]&0xf0)<<4 | uint16(b[offset+1])
 	}
 
 	return nil
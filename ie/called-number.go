@@ -0,0 +1,31 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewCalledNumber creates a new CalledNumber IE.
+func NewCalledNumber(v string) *IE {
+	return newStringIE(CalledNumber, v)
+}
+
+// CalledNumber returns CalledNumber in string if the type of IE matches.
+func (i *IE) CalledNumber() (string, error) {
+	switch i.Type {
+	case CalledNumber:
+		return i.ValueAsString()
+	case L2TPSessionInformation:
+		ies, err := i.L2TPSessionInformation()
+		if err != nil {
+			return "", err
+		}
+		for _, x := range ies {
+			if x.Type == CalledNumber {
+				return x.CalledNumber()
+			}
+		}
+		return "", ErrIENotFound
+	default:
+		return "", &InvalidTypeError{Type: i.Type}
+	}
+}
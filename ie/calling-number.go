@@ -0,0 +1,31 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewCallingNumber creates a new CallingNumber IE.
+func NewCallingNumber(v string) *IE {
+	return newStringIE(CallingNumber, v)
+}
+
+// CallingNumber returns CallingNumber in string if the type of IE matches.
+func (i *IE) CallingNumber() (string, error) {
+	switch i.Type {
+	case CallingNumber:
+		return i.ValueAsString()
+	case L2TPSessionInformation:
+		ies, err := i.L2TPSessionInformation()
+		if err != nil {
+			return "", err
+		}
+		for _, x := range ies {
+			if x.Type == CallingNumber {
+				return x.CallingNumber()
+			}
+		}
+		return "", ErrIENotFound
+	default:
+		return "", &InvalidTypeError{Type: i.Type}
+	}
+}
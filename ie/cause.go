@@ -4,36 +4,94 @@
 
 package ie
 
+// CauseValue is the value of Cause IE.
+type CauseValue uint8
+
 // Cause definitions.
 const (
-	_                                    uint8 = 0
-	CauseRequestAccepted                 uint8 = 1
-	CauseRequestRejected                 uint8 = 64
-	CauseSessionContextNotFound          uint8 = 65
-	CauseMandatoryIEMissing              uint8 = 66
-	CauseConditionalIEMissing            uint8 = 67
-	CauseInvalidLength                   uint8 = 68
-	CauseMandatoryIEIncorrect            uint8 = 69
-	CauseInvalidForwardingPolicy         uint8 = 70
-	CauseInvalidFTEIDAllocationOption    uint8 = 71
-	CauseNoEstablishedPFCPAssociation    uint8 = 72
-	CauseRuleCreationModificationFailure uint8 = 73
-	CausePFCPEntityInCongestion          uint8 = 74
-	CauseNoResourcesAvailable            uint8 = 75
-	CauseServiceNotSupported             uint8 = 76
-	CauseSystemFailure                   uint8 = 77
-	CauseRedirectionRequested            uint8 = 78
+	_                                                                CauseValue = 0
+	CauseRequestAccepted                                             CauseValue = 1
+	CauseRequestRejected                                             CauseValue = 64
+	CauseSessionContextNotFound                                      CauseValue = 65
+	CauseMandatoryIEMissing                                          CauseValue = 66
+	CauseConditionalIEMissing                                        CauseValue = 67
+	CauseInvalidLength                                               CauseValue = 68
+	CauseMandatoryIEIncorrect                                        CauseValue = 69
+	CauseInvalidForwardingPolicy                                     CauseValue = 70
+	CauseInvalidFTEIDAllocationOption                                CauseValue = 71
+	CauseNoEstablishedPFCPAssociation                                CauseValue = 72
+	CauseRuleCreationModificationFailure                             CauseValue = 73
+	CausePFCPEntityInCongestion                                      CauseValue = 74
+	CauseNoResourcesAvailable                                        CauseValue = 75
+	CauseServiceNotSupported                                         CauseValue = 76
+	CauseSystemFailure                                               CauseValue = 77
+	CauseRedirectionRequested                                        CauseValue = 78
+	CauseAllDynamicAddressesAreOccupied                              CauseValue = 79
+	CauseUnknownPreDefinedRule                                       CauseValue = 80
+	CauseUnknownApplicationID                                        CauseValue = 81
+	CauseL2TPTunnelEstablishmentFailure                              CauseValue = 82
+	CauseL2TPSessionEstablishmentFailure                             CauseValue = 83
+	CauseL2TPTunnelRelease                                           CauseValue = 84
+	CauseL2TPSessionRelease                                          CauseValue = 85
+	CausePFCPSessionRestorationFailureDueToRequestedSEIDAlreadyInUse CauseValue = 86
+	CausePFCPEntityNotResponsive                                     CauseValue = 87
 )
 
+var causeValues = newEnum("CauseValue", map[CauseValue]string{
+	CauseRequestAccepted:                                             "CauseRequestAccepted",
+	CauseRequestRejected:                                             "CauseRequestRejected",
+	CauseSessionContextNotFound:                                      "CauseSessionContextNotFound",
+	CauseMandatoryIEMissing:                                          "CauseMandatoryIEMissing",
+	CauseConditionalIEMissing:                                        "CauseConditionalIEMissing",
+	CauseInvalidLength:                                               "CauseInvalidLength",
+	CauseMandatoryIEIncorrect:                                        "CauseMandatoryIEIncorrect",
+	CauseInvalidForwardingPolicy:                                     "CauseInvalidForwardingPolicy",
+	CauseInvalidFTEIDAllocationOption:                                "CauseInvalidFTEIDAllocationOption",
+	CauseNoEstablishedPFCPAssociation:                                "CauseNoEstablishedPFCPAssociation",
+	CauseRuleCreationModificationFailure:                             "CauseRuleCreationModificationFailure",
+	CausePFCPEntityInCongestion:                                      "CausePFCPEntityInCongestion",
+	CauseNoResourcesAvailable:                                        "CauseNoResourcesAvailable",
+	CauseServiceNotSupported:                                         "CauseServiceNotSupported",
+	CauseSystemFailure:                                               "CauseSystemFailure",
+	CauseRedirectionRequested:                                        "CauseRedirectionRequested",
+	CauseAllDynamicAddressesAreOccupied:                              "CauseAllDynamicAddressesAreOccupied",
+	CauseUnknownPreDefinedRule:                                       "CauseUnknownPreDefinedRule",
+	CauseUnknownApplicationID:                                        "CauseUnknownApplicationID",
+	CauseL2TPTunnelEstablishmentFailure:                              "CauseL2TPTunnelEstablishmentFailure",
+	CauseL2TPSessionEstablishmentFailure:                             "CauseL2TPSessionEstablishmentFailure",
+	CauseL2TPTunnelRelease:                                           "CauseL2TPTunnelRelease",
+	CauseL2TPSessionRelease:                                          "CauseL2TPSessionRelease",
+	CausePFCPSessionRestorationFailureDueToRequestedSEIDAlreadyInUse: "CausePFCPSessionRestorationFailureDueToRequestedSEIDAlreadyInUse",
+	CausePFCPEntityNotResponsive:                                     "CausePFCPEntityNotResponsive",
+})
+
+// String returns the name of the CauseValue, e.g., "CauseRequestAccepted".
+func (v CauseValue) String() string {
+	return causeValues.name(v)
+}
+
+// IsValid reports whether the CauseValue is a defined value.
+func (v CauseValue) IsValid() bool {
+	return causeValues.valid(v)
+}
+
+// CauseValueByName returns the CauseValue of the given name, e.g., "CauseRequestAccepted".
+// The value in decimal is also accepted.
+func CauseValueByName(name string) (CauseValue, bool) {
+	return causeValues.byName(name)
+}
+
 // NewCause creates a new Cause IE.
-func NewCause(cause uint8) *IE {
-	return newUint8ValIE(Cause, cause)
+func NewCause(cause CauseValue) *IE {
+	return newUint8ValIE(Cause, uint8(cause))
 }
 
-// Cause returns Cause in uint8 if the type of IE matches.
-func (i *IE) Cause() (uint8, error) {
+// Cause returns Cause in CauseValue if the type of IE matches.
+func (i *IE) Cause() (CauseValue, error) {
 	if i.Type != Cause {
 		return 0, &InvalidTypeError{Type: i.Type}
 	}
-	return i.ValueAsUint8()
+
+	v, err := i.ValueAsUint8()
+	return CauseValue(v), err
 }
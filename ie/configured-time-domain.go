@@ -0,0 +1,29 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewConfiguredTimeDomain creates a new ConfiguredTimeDomain IE.
+func NewConfiguredTimeDomain(flags uint8) *IE {
+	return newUint8ValIE(ConfiguredTimeDomain, flags)
+}
+
+// ConfiguredTimeDomain returns ConfiguredTimeDomain in uint8 if the type of IE matches.
+func (i *IE) ConfiguredTimeDomain() (uint8, error) {
+	if i.Type != ConfiguredTimeDomain {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.ValueAsUint8()
+}
+
+// HasCTDI reports whether an IE has CTDI bit.
+func (i *IE) HasCTDI() bool {
+	v, err := i.ConfiguredTimeDomain()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
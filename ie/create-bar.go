@@ -13,7 +13,7 @@ func NewCreateBAR(ies ...*IE) *IE {
 func (i *IE) CreateBAR() ([]*IE, error) {
 	switch i.Type {
 	case CreateBAR:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	default:
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
@@ -13,7 +13,7 @@ func NewCreateSRR(ies ...*IE) *IE {
 func (i *IE) CreateSRR() ([]*IE, error) {
 	switch i.Type {
 	case CreateSRR:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	default:
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewCreatedL2TPSession creates a new CreatedL2TPSession IE.
+func NewCreatedL2TPSession(ies ...*IE) *IE {
+	return newGroupedIE(CreatedL2TPSession, 0, ies...)
+}
+
+// CreatedL2TPSession returns the IEs above CreatedL2TPSession if the type of IE matches.
+func (i *IE) CreatedL2TPSession() ([]*IE, error) {
+	if i.Type != CreatedL2TPSession {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
@@ -15,7 +15,7 @@ func (i *IE) CreatedTrafficEndpoint() ([]*IE, error) {
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
 
-	return ParseMultiIEs(i.Payload)
+	return i.childIEs()
 }
 
 // LocalFTEID returns FTEID that is found first in a grouped IE in structured format
@@ -0,0 +1,194 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+)
+
+// ParseOption is an option to change how ParseMultiIEs handles malformed IEs.
+//
+// Without any option, ParseMultiIEs is as tolerant as it has always been: an IE
+// whose payload is missing is accepted as an empty IE, for example.
+type ParseOption func(*decodeConfig)
+
+type decodeConfig struct {
+	strict   bool
+	lenient  bool
+	warnings *[]error
+}
+
+func newDecodeConfig(opts []ParseOption) decodeConfig {
+	if len(opts) == 0 {
+		return decodeConfig{}
+	}
+
+	c := &decodeConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return *c
+}
+
+// Strict makes the decoder return an error on any length inconsistency, e.g., the
+// Length field exceeding the given bytes, or the child IEs not filling the payload
+// of the grouped IE exactly.
+func Strict() ParseOption {
+	return func(c *decodeConfig) {
+		c.strict, c.lenient = true, false
+	}
+}
+
+// Lenient makes the decoder skip the malformed IEs and keep decoding the rest.
+// The problems found are appended to warnings, which can be nil if not needed.
+//
+// An IE whose child IE is malformed is kept without the malformed child. If the
+// Length of an IE exceeds the given bytes, the IE and the rest are skipped, as
+// there is no way to find the beginning of the next IE.
+func Lenient(warnings *[]error) ParseOption {
+	return func(c *decodeConfig) {
+		c.strict, c.lenient = false, true
+		c.warnings = warnings
+	}
+}
+
+func (c decodeConfig) warn(err error) {
+	if c.warnings != nil {
+		*c.warnings = append(*c.warnings, err)
+	}
+}
+
+// Validate checks if b consists of well-formed IEs in the same way as
+// ParseMultiIEs with Strict, without allocating anything if they are.
+// The error returned is *DecodeError.
+func Validate(b []byte) error {
+	for offset := 0; offset < len(b); {
+		n, err := viewLen(b[offset:])
+		if err != nil {
+			return newDecodeError(err, b, offset)
+		}
+
+		v := View{b: b[offset : offset+n]}
+		if v.IsGrouped() {
+			if err := Validate(v.Payload()); err != nil {
+				return newDecodeError(err, b, offset)
+			}
+		}
+		offset += n
+	}
+	return nil
+}
+
+// sanitize appends the well-formed IEs in b to dst, dropping the malformed ones and
+// recalculating the Length of grouped IEs. It returns the extended buffer.
+//
+// base and path are the location of b in the bytes given by the caller, used in
+// the warnings.
+func (c decodeConfig) sanitize(dst, b []byte, base int, path string) []byte {
+	for offset := 0; offset < len(b); {
+		n, err := viewLen(b[offset:])
+		if err != nil {
+			c.warn(&DecodeError{
+				Offset: base + offset,
+				Type:   typeAt(b, offset),
+				Path:   path + segmentAt(b, offset),
+				Err:    err,
+			})
+			if err != ErrInvalidLength {
+				// the end of the IE is unknown, so the rest cannot be decoded.
+				return dst
+			}
+
+			// the IE is malformed but the Length is still reliable.
+			offset += 4 + int(binary.BigEndian.Uint16(b[offset+2:offset+4]))
+			continue
+		}
+
+		v := View{b: b[offset : offset+n]}
+		if !v.IsGrouped() || Validate(v.Payload()) == nil {
+			dst = append(dst, v.Bytes()...)
+			offset += n
+			continue
+		}
+
+		hl := n - len(v.Payload())
+		start := len(dst)
+		dst = append(dst, v.Bytes()[:hl]...)
+		dst = c.sanitize(dst, v.Payload(), base+offset+hl, path+segmentAt(b, offset)+"/")
+		binary.BigEndian.PutUint16(dst[start+2:start+4], uint16(len(dst)-start-4))
+		offset += n
+	}
+	return dst
+}
+
+// typeAt returns the type of the IE at offset in b, or 0 if b is too short.
+func typeAt(b []byte, offset int) uint16 {
+	if len(b) < offset+2 {
+		return 0
+	}
+	return binary.BigEndian.Uint16(b[offset : offset+2])
+}
+
+// segmentAt returns the name of the IE at offset in b to be used in the path,
+// which has the index among the IEs of the same type if there are multiple.
+func segmentAt(b []byte, offset int) string {
+	typ := typeAt(b, offset)
+
+	var idx, count int
+	for o := 0; o < len(b) && len(b) >= o+2; {
+		if typeAt(b, o) == typ {
+			if o < offset {
+				idx++
+			}
+			count++
+		}
+		n, err := viewLen(b[o:])
+		if err != nil {
+			break
+		}
+		o += n
+	}
+	if count < 2 {
+		return TypeName(typ)
+	}
+	return TypeName(typ) + "[" + strconv.Itoa(idx) + "]"
+}
+
+// newDecodeError returns the err as *DecodeError located at offset in b. If err is
+// *DecodeError from the child IEs, the location is prefixed with the IE at offset.
+func newDecodeError(err error, b []byte, offset int) error {
+	seg := segmentAt(b, offset)
+
+	var de *DecodeError
+	if errors.As(err, &de) {
+		hl := 4
+		if len(b) > offset && b[offset]&0x80 != 0 {
+			hl = 6
+		}
+		return &DecodeError{
+			Offset: offset + hl + de.Offset,
+			Type:   de.Type,
+			Path:   seg + "/" + de.Path,
+			Err:    de.Err,
+		}
+	}
+	return &DecodeError{Offset: offset, Type: typeAt(b, offset), Path: seg, Err: err}
+}
+
+// Sanitize returns the bytes that contain only the well-formed IEs in b, in the same
+// way as ParseMultiIEs with Lenient. The problems found are appended to warnings
+// as *DecodeError.
+//
+// If nothing is dropped, b is returned as it is.
+func Sanitize(b []byte, warnings *[]error) []byte {
+	c := decodeConfig{lenient: true, warnings: warnings}
+	if Validate(b) == nil {
+		return b
+	}
+
+	return c.sanitize(make([]byte, 0, len(b)), b, 0, "")
+}
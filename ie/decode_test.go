@@ -0,0 +1,204 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func marshalIEs(t *testing.T, ies ...*ie.IE) []byte {
+	t.Helper()
+
+	var b []byte
+	for _, i := range ies {
+		var err error
+		b, err = i.AppendBinary(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return b
+}
+
+func TestDecodeModes(t *testing.T) {
+	pdrID := marshalIEs(t, ie.NewPDRID(1))
+	precedence := marshalIEs(t, ie.NewPrecedence(100))
+	// vendor-specific IE whose Length is too short to contain the Enterprise ID.
+	badVendor := []byte{0x80, 0x01, 0x00, 0x01, 0xff}
+
+	// CreatePDR that contains the bad IE between PDRID and Precedence.
+	badPDRPayload := append(append(append([]byte{}, pdrID...), badVendor...), precedence...)
+	badPDR := append([]byte{0x00, 0x01, 0x00, byte(len(badPDRPayload))}, badPDRPayload...)
+	goodPDR := marshalIEs(t, ie.NewCreatePDR(ie.NewPDRID(1), ie.NewPrecedence(100)))
+
+	// PDRID whose payload is missing.
+	truncated := []byte{0x00, 0x38, 0x00, 0x02}
+
+	cases := []struct {
+		description string
+		serialized  []byte
+		strictErr   error
+		want        []byte
+		warnings    int
+	}{
+		{
+			"Valid",
+			append(append([]byte{}, pdrID...), goodPDR...),
+			nil,
+			append(append([]byte{}, pdrID...), goodPDR...),
+			0,
+		}, {
+			"Truncated",
+			append(append([]byte{}, pdrID...), truncated...),
+			io.ErrUnexpectedEOF,
+			pdrID,
+			1,
+		}, {
+			"BadChild",
+			append(append([]byte{}, badPDR...), pdrID...),
+			ie.ErrInvalidLength,
+			append(append([]byte{}, goodPDR...), pdrID...),
+			1,
+		}, {
+			"BadTopLevel",
+			append(append(append([]byte{}, pdrID...), badVendor...), precedence...),
+			ie.ErrInvalidLength,
+			append(append([]byte{}, pdrID...), precedence...),
+			1,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			t.Run("Strict", func(t *testing.T) {
+				_, err := ie.ParseMultiIEs(c.serialized, ie.Strict())
+				if !errors.Is(err, c.strictErr) {
+					t.Errorf("got %v want %v", err, c.strictErr)
+				}
+				if err := ie.Validate(c.serialized); !errors.Is(err, c.strictErr) {
+					t.Errorf("got %v want %v", err, c.strictErr)
+				}
+			})
+
+			t.Run("Lenient", func(t *testing.T) {
+				var warnings []error
+				got, err := ie.ParseMultiIEs(c.serialized, ie.Lenient(&warnings))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(warnings) != c.warnings {
+					t.Errorf("got %d warnings want %d: %v", len(warnings), c.warnings, warnings)
+				}
+
+				want, err := ie.ParseMultiIEs(c.want)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Error(diff)
+				}
+				if diff := cmp.Diff(ie.Sanitize(c.serialized, nil), c.want); diff != "" {
+					t.Error(diff)
+				}
+			})
+		})
+	}
+
+	t.Run("Unrecoverable", func(t *testing.T) {
+		b := append(append([]byte{}, pdrID...), 0x00, 0x38, 0x00, 0x04, 0x00)
+		var warnings []error
+		got, err := ie.ParseMultiIEs(b, ie.Lenient(&warnings))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 1 || len(warnings) != 1 {
+			t.Errorf("got %d IEs and %v", len(got), warnings)
+		}
+	})
+
+	t.Run("ValidateNoAllocs", func(t *testing.T) {
+		b := append(append([]byte{}, pdrID...), goodPDR...)
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = ie.Validate(b)
+		})
+		if allocs != 0 {
+			t.Errorf("got %v allocs want 0", allocs)
+		}
+	})
+}
+
+func TestDecodeError(t *testing.T) {
+	fteid := ie.NewFTEID(0x01, 0x22222222, net.ParseIP("127.0.0.1"), nil, 0)
+	pdr := func(id uint16, fteid *ie.IE) *ie.IE {
+		return ie.NewCreatePDR(
+			ie.NewPDRID(id),
+			ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceAccess), fteid, ie.NewNetworkInstance("some.instance.example")),
+		)
+	}
+	b := marshalIEs(t,
+		pdr(1, ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0)),
+		pdr(2, fteid),
+	)
+
+	offset := bytes.Index(b, marshalIEs(t, fteid))
+	binary.BigEndian.PutUint16(b[offset+2:offset+4], 0xff00)
+
+	want := &ie.DecodeError{Offset: offset, Type: ie.FTEID, Path: "CreatePDR[1]/PDI/FTEID", Err: io.ErrUnexpectedEOF}
+	cases := []struct {
+		description string
+		decode      func() error
+	}{
+		{
+			"Default",
+			func() error {
+				_, err := ie.ParseMultiIEs(b)
+				return err
+			},
+		}, {
+			"Strict",
+			func() error {
+				_, err := ie.ParseMultiIEs(b, ie.Strict())
+				return err
+			},
+		}, {
+			"Lenient",
+			func() error {
+				var warnings []error
+				if _, err := ie.ParseMultiIEs(b, ie.Lenient(&warnings)); err != nil {
+					t.Fatal(err)
+				}
+				if len(warnings) != 1 {
+					t.Fatalf("got %v", warnings)
+				}
+				return warnings[0]
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			err := c.decode()
+			var de *ie.DecodeError
+			if !errors.As(err, &de) {
+				t.Fatalf("got %T want *ie.DecodeError", err)
+			}
+			if diff := cmp.Diff(*de, *want, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+			if !errors.Is(err, io.ErrUnexpectedEOF) {
+				t.Errorf("%v is not %v", err, io.ErrUnexpectedEOF)
+			}
+		})
+	}
+}
@@ -4,26 +4,55 @@
 
 package ie
 
+// DestinationInterfaceValue is the value of DestinationInterface IE.
+type DestinationInterfaceValue uint8
+
 // Interface definitions.
 const (
-	DstInterfaceAccess       uint8 = 0
-	DstInterfaceCore         uint8 = 1
-	DstInterfaceSGiLANN6LAN  uint8 = 2
-	DstInterfaceCPFunction   uint8 = 3
-	DstInterfaceLIFunction   uint8 = 4
-	DstInterface5GVNInternal uint8 = 5
+	DstInterfaceAccess       DestinationInterfaceValue = 0
+	DstInterfaceCore         DestinationInterfaceValue = 1
+	DstInterfaceSGiLANN6LAN  DestinationInterfaceValue = 2
+	DstInterfaceCPFunction   DestinationInterfaceValue = 3
+	DstInterfaceLIFunction   DestinationInterfaceValue = 4
+	DstInterface5GVNInternal DestinationInterfaceValue = 5
 )
 
+var destinationInterfaceValues = newEnum("DestinationInterfaceValue", map[DestinationInterfaceValue]string{
+	DstInterfaceAccess:       "DstInterfaceAccess",
+	DstInterfaceCore:         "DstInterfaceCore",
+	DstInterfaceSGiLANN6LAN:  "DstInterfaceSGiLANN6LAN",
+	DstInterfaceCPFunction:   "DstInterfaceCPFunction",
+	DstInterfaceLIFunction:   "DstInterfaceLIFunction",
+	DstInterface5GVNInternal: "DstInterface5GVNInternal",
+})
+
+// String returns the name of the DestinationInterfaceValue, e.g., "DstInterfaceAccess".
+func (v DestinationInterfaceValue) String() string {
+	return destinationInterfaceValues.name(v)
+}
+
+// IsValid reports whether the DestinationInterfaceValue is a defined value.
+func (v DestinationInterfaceValue) IsValid() bool {
+	return destinationInterfaceValues.valid(v)
+}
+
+// DestinationInterfaceValueByName returns the DestinationInterfaceValue of the given name, e.g., "DstInterfaceAccess".
+// The value in decimal is also accepted.
+func DestinationInterfaceValueByName(name string) (DestinationInterfaceValue, bool) {
+	return destinationInterfaceValues.byName(name)
+}
+
 // NewDestinationInterface creates a new DestinationInterface IE.
-func NewDestinationInterface(intf uint8) *IE {
-	return newUint8ValIE(DestinationInterface, intf)
+func NewDestinationInterface(intf DestinationInterfaceValue) *IE {
+	return newUint8ValIE(DestinationInterface, uint8(intf))
 }
 
-// DestinationInterface returns DestinationInterface in uint8 if the type of IE matches.
-func (i *IE) DestinationInterface() (uint8, error) {
+// DestinationInterface returns DestinationInterface in DestinationInterfaceValue if the type of IE matches.
+func (i *IE) DestinationInterface() (DestinationInterfaceValue, error) {
 	switch i.Type {
 	case DestinationInterface:
-		return i.ValueAsUint8()
+		v, err := i.ValueAsUint8()
+		return DestinationInterfaceValue(v), err
 	case ForwardingParameters:
 		ies, err := i.ForwardingParameters()
 		if err != nil {
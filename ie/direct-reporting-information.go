@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewDirectReportingInformation creates a new DirectReportingInformation IE.
+func NewDirectReportingInformation(ies ...*IE) *IE {
+	return newGroupedIE(DirectReportingInformation, 0, ies...)
+}
+
+// DirectReportingInformation returns the IEs above DirectReportingInformation if the type of IE matches.
+func (i *IE) DirectReportingInformation() ([]*IE, error) {
+	if i.Type != DirectReportingInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
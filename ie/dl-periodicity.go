@@ -0,0 +1,31 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewDLPeriodicity creates a new DLPeriodicity IE.
+func NewDLPeriodicity(v uint32) *IE {
+	return newUint32ValIE(DLPeriodicity, v)
+}
+
+// DLPeriodicity returns DLPeriodicity in uint32 if the type of IE matches.
+func (i *IE) DLPeriodicity() (uint32, error) {
+	switch i.Type {
+	case DLPeriodicity:
+		return i.ValueAsUint32()
+	case TrafficParameterMeasurementReport:
+		ies, err := i.TrafficParameterMeasurementReport()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == DLPeriodicity {
+				return x.DLPeriodicity()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
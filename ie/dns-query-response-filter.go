@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewDNSQueryResponseFilter creates a new DNSQueryResponseFilter IE.
+func NewDNSQueryResponseFilter(v string) *IE {
+	return newStringIE(DNSQueryResponseFilter, v)
+}
+
+// DNSQueryResponseFilter returns DNSQueryResponseFilter in string if the type of IE matches.
+func (i *IE) DNSQueryResponseFilter() (string, error) {
+	if i.Type != DNSQueryResponseFilter {
+		return "", &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.ValueAsString()
+}
@@ -0,0 +1,39 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"net"
+)
+
+// NewDNSServerAddress creates a new DNSServerAddress IE.
+func NewDNSServerAddress(v4 net.IP) *IE {
+	return New(DNSServerAddress, v4.To4())
+}
+
+// DNSServerAddress returns DNSServerAddress in net.IP if the type of IE matches.
+func (i *IE) DNSServerAddress() (net.IP, error) {
+	switch i.Type {
+	case DNSServerAddress:
+		if len(i.Payload) < net.IPv4len {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return net.IP(i.Payload[:net.IPv4len]), nil
+	case CreatedL2TPSession:
+		ies, err := i.CreatedL2TPSession()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == DNSServerAddress {
+				return x.DNSServerAddress()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
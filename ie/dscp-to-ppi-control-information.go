@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewDSCPToPPIControlInformation creates a new DSCPToPPIControlInformation IE.
+func NewDSCPToPPIControlInformation(ies ...*IE) *IE {
+	return newGroupedIE(DSCPToPPIControlInformation, 0, ies...)
+}
+
+// DSCPToPPIControlInformation returns the IEs above DSCPToPPIControlInformation if the type of IE matches.
+func (i *IE) DSCPToPPIControlInformation() ([]*IE, error) {
+	if i.Type != DSCPToPPIControlInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
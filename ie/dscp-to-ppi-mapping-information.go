@@ -0,0 +1,109 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewDSCPToPPIMappingInformation creates a new DSCPToPPIMappingInformation IE.
+func NewDSCPToPPIMappingInformation(ppi uint8, dscp ...uint8) *IE {
+	fields := NewDSCPToPPIMappingInformationFields(ppi, dscp...)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(DSCPToPPIMappingInformation, b)
+}
+
+// DSCPToPPIMappingInformation returns DSCPToPPIMappingInformation in structured format if the type of IE matches.
+func (i *IE) DSCPToPPIMappingInformation() (*DSCPToPPIMappingInformationFields, error) {
+	switch i.Type {
+	case DSCPToPPIMappingInformation:
+		fields, err := ParseDSCPToPPIMappingInformationFields(i.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	case DSCPToPPIControlInformation:
+		ies, err := i.DSCPToPPIControlInformation()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == DSCPToPPIMappingInformation {
+				return x.DSCPToPPIMappingInformation()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// DSCPToPPIMappingInformationFields represents a fields contained in DSCPToPPIMappingInformation IE.
+type DSCPToPPIMappingInformationFields struct {
+	PPI  uint8   // 3 bits
+	DSCP []uint8 // 6 bits each
+}
+
+// NewDSCPToPPIMappingInformationFields creates a new DSCPToPPIMappingInformationFields.
+func NewDSCPToPPIMappingInformationFields(ppi uint8, dscp ...uint8) *DSCPToPPIMappingInformationFields {
+	return &DSCPToPPIMappingInformationFields{
+		PPI:  ppi & 0x07,
+		DSCP: dscp,
+	}
+}
+
+// ParseDSCPToPPIMappingInformationFields parses b into DSCPToPPIMappingInformationFields.
+func ParseDSCPToPPIMappingInformationFields(b []byte) (*DSCPToPPIMappingInformationFields, error) {
+	f := &DSCPToPPIMappingInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *DSCPToPPIMappingInformationFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.PPI = b[0] & 0x07
+	f.DSCP = nil
+	for _, v := range b[1:] {
+		f.DSCP = append(f.DSCP, v&0x3f)
+	}
+	return nil
+}
+
+// Marshal returns the serialized bytes of DSCPToPPIMappingInformationFields.
+func (f *DSCPToPPIMappingInformationFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *DSCPToPPIMappingInformationFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.PPI & 0x07
+	for n, v := range f.DSCP {
+		b[n+1] = v & 0x3f
+	}
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *DSCPToPPIMappingInformationFields) MarshalLen() int {
+	return 1 + len(f.DSCP)
+}
@@ -13,7 +13,7 @@ func NewDuplicatingParameters(ies ...*IE) *IE {
 func (i *IE) DuplicatingParameters() ([]*IE, error) {
 	switch i.Type {
 	case DuplicatingParameters:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreateFAR:
 		ies, err := i.CreateFAR()
 		if err != nil {
@@ -0,0 +1,48 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "strconv"
+
+// enum holds the names of the values of an enumerated IE value type.
+type enum[T ~uint8] struct {
+	typeName string
+	names    map[T]string
+	values   map[string]T
+}
+
+func newEnum[T ~uint8](typeName string, names map[T]string) *enum[T] {
+	e := &enum[T]{typeName: typeName, names: names, values: make(map[string]T, len(names))}
+	for v, n := range names {
+		e.values[n] = v
+	}
+	return e
+}
+
+// name returns the name of v, or "<typeName>(<v>)" if v is not defined.
+func (e *enum[T]) name(v T) string {
+	if n, ok := e.names[v]; ok {
+		return n
+	}
+	return e.typeName + "(" + strconv.Itoa(int(v)) + ")"
+}
+
+// valid reports whether v is defined.
+func (e *enum[T]) valid(v T) bool {
+	_, ok := e.names[v]
+	return ok
+}
+
+// byName returns the value of the given name. The value in decimal is also
+// accepted if it is defined.
+func (e *enum[T]) byName(name string) (T, bool) {
+	if v, ok := e.values[name]; ok {
+		return v, true
+	}
+	if n, err := strconv.ParseUint(name, 10, 8); err == nil && e.valid(T(n)) {
+		return T(n), true
+	}
+	return 0, false
+}
@@ -0,0 +1,248 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+type enumValue interface {
+	fmt.Stringer
+	IsValid() bool
+}
+
+func TestEnums(t *testing.T) {
+	cases := []struct {
+		description string
+		value       enumValue
+		str         string
+		valid       bool
+		byName      func(string) (enumValue, bool)
+	}{
+		{
+			"Cause",
+			ie.CauseMandatoryIEMissing,
+			"CauseMandatoryIEMissing",
+			true,
+			func(s string) (enumValue, bool) { return ie.CauseValueByName(s) },
+		}, {
+			"Cause/Rel-17",
+			ie.CausePFCPSessionRestorationFailureDueToRequestedSEIDAlreadyInUse,
+			"CausePFCPSessionRestorationFailureDueToRequestedSEIDAlreadyInUse",
+			true,
+			func(s string) (enumValue, bool) { return ie.CauseValueByName(s) },
+		}, {
+			"Cause/Undefined",
+			ie.CauseValue(200),
+			"CauseValue(200)",
+			false,
+			func(s string) (enumValue, bool) { return ie.CauseValueByName(s) },
+		}, {
+			"SourceInterface",
+			ie.SrcInterfaceCPFunction,
+			"SrcInterfaceCPFunction",
+			true,
+			func(s string) (enumValue, bool) { return ie.SourceInterfaceValueByName(s) },
+		}, {
+			"DestinationInterface",
+			ie.DstInterfaceLIFunction,
+			"DstInterfaceLIFunction",
+			true,
+			func(s string) (enumValue, bool) { return ie.DestinationInterfaceValueByName(s) },
+		}, {
+			"TGPPInterfaceType",
+			ie.TGPPInterfaceTypeN9,
+			"TGPPInterfaceTypeN9",
+			true,
+			func(s string) (enumValue, bool) { return ie.TGPPInterfaceTypeValueByName(s) },
+		}, {
+			"OuterHeaderRemovalDescription",
+			ie.OuterHeaderRemovalGTPUUDPIPv4,
+			"OuterHeaderRemovalGTPUUDPIPv4",
+			true,
+			func(s string) (enumValue, bool) { return ie.OuterHeaderRemovalDescriptionValueByName(s) },
+		}, {
+			"PDNType",
+			ie.PDNTypeEthernet,
+			"PDNTypeEthernet",
+			true,
+			func(s string) (enumValue, bool) { return ie.PDNTypeValueByName(s) },
+		}, {
+			"PDNType/Undefined",
+			ie.PDNTypeValue(0),
+			"PDNTypeValue(0)",
+			false,
+			func(s string) (enumValue, bool) { return ie.PDNTypeValueByName(s) },
+		}, {
+			"GateStatus",
+			ie.GateStatusClosed,
+			"GateStatusClosed",
+			true,
+			func(s string) (enumValue, bool) { return ie.GateStatusValueByName(s) },
+		}, {
+			"NodeReportType",
+			ie.NodeReportTypeUPFR | ie.NodeReportTypeGPQR,
+			"NodeReportTypeUPFR|NodeReportTypeGPQR",
+			true,
+			func(s string) (enumValue, bool) { return ie.NodeReportTypeValueByName(s) },
+		}, {
+			"NodeReportType/Undefined",
+			ie.NodeReportTypeUPFR | ie.NodeReportTypeValue(0x80),
+			"NodeReportTypeUPFR|NodeReportTypeValue(128)",
+			false,
+			func(s string) (enumValue, bool) { return ie.NodeReportTypeValueByName(s) },
+		}, {
+			"SteeringMode",
+			ie.SteeringModePriorityBased,
+			"SteeringModePriorityBased",
+			true,
+			func(s string) (enumValue, bool) { return ie.SteeringModeValueByName(s) },
+		}, {
+			"RATType",
+			ie.RATTypeNR,
+			"RATTypeNR",
+			true,
+			func(s string) (enumValue, bool) { return ie.RATTypeValueByName(s) },
+		}, {
+			"RATType/Undefined",
+			ie.RATTypeValue(0),
+			"RATTypeValue(0)",
+			false,
+			func(s string) (enumValue, bool) { return ie.RATTypeValueByName(s) },
+		}, {
+			"MediaTransportProtocol",
+			ie.MediaTransportProtocolSRTP,
+			"MediaTransportProtocolSRTP",
+			true,
+			func(s string) (enumValue, bool) { return ie.MediaTransportProtocolValueByName(s) },
+		}, {
+			"TransportMode",
+			ie.TransportModeStreaming,
+			"TransportModeStreaming",
+			true,
+			func(s string) (enumValue, bool) { return ie.TransportModeValueByName(s) },
+		}, {
+			"TransportMode/Undefined",
+			ie.TransportModeValue(3),
+			"TransportModeValue(3)",
+			false,
+			func(s string) (enumValue, bool) { return ie.TransportModeValueByName(s) },
+		}, {
+			"RedirectAddressType",
+			ie.RedirectAddrSIPURI,
+			"RedirectAddrSIPURI",
+			true,
+			func(s string) (enumValue, bool) { return ie.RedirectAddressTypeValueByName(s) },
+		}, {
+			"SteeringFunctionality",
+			ie.SteeringFunctionalityMPTCP,
+			"SteeringFunctionalityMPTCP",
+			true,
+			func(s string) (enumValue, bool) { return ie.SteeringFunctionalityValueByName(s) },
+		}, {
+			"HeaderType",
+			ie.HeaderTypeHTTP,
+			"HeaderTypeHTTP",
+			true,
+			func(s string) (enumValue, bool) { return ie.HeaderTypeValueByName(s) },
+		}, {
+			"BaseTimeIntervalType",
+			ie.BTITDTP,
+			"BTITDTP",
+			true,
+			func(s string) (enumValue, bool) { return ie.BaseTimeIntervalTypeValueByName(s) },
+		}, {
+			"FlowDirection",
+			ie.FlowDirectionBidirectional,
+			"FlowDirectionBidirectional",
+			true,
+			func(s string) (enumValue, bool) { return ie.FlowDirectionValueByName(s) },
+		}, {
+			"AccessType",
+			ie.AccessTypeNon3GPP,
+			"AccessTypeNon3GPP",
+			true,
+			func(s string) (enumValue, bool) { return ie.AccessTypeValueByName(s) },
+		}, {
+			"AvailabilityStatus",
+			ie.AvailabilityStatusAccessHasBecomeAvaiable,
+			"AvailabilityStatusAccessHasBecomeAvaiable",
+			true,
+			func(s string) (enumValue, bool) { return ie.AvailabilityStatusValueByName(s) },
+		}, {
+			"RuleIDType",
+			ie.RuleIDTypeURR,
+			"RuleIDTypeURR",
+			true,
+			func(s string) (enumValue, bool) { return ie.RuleIDTypeValueByName(s) },
+		}, {
+			"Priority",
+			ie.PriorityNoStandby,
+			"PriorityNoStandby",
+			true,
+			func(s string) (enumValue, bool) { return ie.PriorityValueByName(s) },
+		}, {
+			"TimeUnit",
+			ie.TimeUnit6Minutes,
+			"TimeUnit6Minutes",
+			true,
+			func(s string) (enumValue, bool) { return ie.TimeUnitValueByName(s) },
+		}, {
+			"NodeIDType",
+			ie.NodeIDFQDN,
+			"NodeIDFQDN",
+			true,
+			func(s string) (enumValue, bool) { return ie.NodeIDTypeValueByName(s) },
+		}, {
+			"MPTCPProxyType/Undefined",
+			ie.MPTCPProxyTypeValue(0),
+			"MPTCPProxyTypeValue(0)",
+			false,
+			func(s string) (enumValue, bool) { return ie.MPTCPProxyTypeValueByName(s) },
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			if got := c.value.String(); got != c.str {
+				t.Errorf("got %q want %q", got, c.str)
+			}
+			if got := fmt.Sprint(c.value); got != c.str {
+				t.Errorf("got %q want %q", got, c.str)
+			}
+			if got := c.value.IsValid(); got != c.valid {
+				t.Errorf("got %v want %v", got, c.valid)
+			}
+
+			got, ok := c.byName(c.str)
+			if ok != c.valid {
+				t.Fatalf("got %v want %v", ok, c.valid)
+			}
+			if ok && got != c.value {
+				t.Errorf("got %v want %v", got, c.value)
+			}
+
+			if ok {
+				got, ok := c.byName(fmt.Sprintf("%d", c.value))
+				if !ok || got != c.value {
+					t.Errorf("got %v, %v want %v", got, ok, c.value)
+				}
+			}
+		})
+	}
+
+	t.Run("Accessor", func(t *testing.T) {
+		got, err := ie.NewCause(ie.CauseMandatoryIEMissing).Cause()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := fmt.Sprintf("cause=%v", got); s != "cause=CauseMandatoryIEMissing" {
+			t.Errorf("got %q", s)
+		}
+	})
+}
@@ -20,6 +20,9 @@ var (
 	ErrMalformed = errors.New("malformed IE")
 
 	ErrElementNotFound = errors.New("element not found")
+
+	ErrDuplicatedIE = errors.New("duplicated IE")
+	ErrUnexpectedIE = errors.New("unexpected IE")
 )
 
 // InvalidTypeError indicates the type of IE is invalid.
@@ -41,3 +44,53 @@ type InvalidNodeIDError struct {
 func (e *InvalidNodeIDError) Error() string {
 	return fmt.Sprintf("got invalid NodeID: %d", e.ID)
 }
+
+// InvalidQueryError indicates the query given to CompileQuery or Find is invalid.
+type InvalidQueryError struct {
+	Query  string
+	Reason string
+}
+
+// Error returns message with the invalid query and the reason.
+func (e *InvalidQueryError) Error() string {
+	return fmt.Sprintf("got invalid query %q: %s", e.Query, e.Reason)
+}
+
+// DecodeError indicates the failure in decoding the IEs, with the location of the
+// IE that failed. Err is the cause, e.g., io.ErrUnexpectedEOF or ErrInvalidLength,
+// so that errors.Is works with DecodeError as well.
+type DecodeError struct {
+	// Offset is the position of the IE that failed, from the beginning of the
+	// bytes given to the function.
+	Offset int
+
+	// Type is the type of the IE that failed. It is 0 if the type is unknown.
+	Type uint16
+
+	// Path is the location of the IE that failed, in the same format as Match.Path,
+	// e.g., "CreatePDR[1]/PDI/FTEID".
+	Path string
+
+	Err error
+}
+
+// Error returns message with the location and the cause.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("failed to decode %s at offset %d: %v", e.Path, e.Offset, e.Err)
+}
+
+// Unwrap returns the cause.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// FlowDescriptionError indicates the Flow Description (IPFilterRule) is invalid.
+type FlowDescriptionError struct {
+	Description string
+	Reason      string
+}
+
+// Error returns message with the invalid Flow Description and the reason.
+func (e *FlowDescriptionError) Error() string {
+	return fmt.Sprintf("got invalid flow description %q: %s", e.Description, e.Reason)
+}
@@ -0,0 +1,253 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"bytes"
+	"net"
+)
+
+// EthernetPacketFilterFields represents the IEs in EthernetPacketFilter IE in
+// structured format.
+type EthernetPacketFilterFields struct {
+	// EthernetFilterID is 0 if not present.
+	EthernetFilterID uint32
+
+	// Bidirectional is the BIDE flag in EthernetFilterProperties.
+	Bidirectional bool
+
+	MACAddresses []*MACAddressFields
+
+	// Ethertype is 0 if not present.
+	Ethertype uint16
+
+	// CTAG and STAG are nil if not present.
+	CTAG *CTAGFields
+	STAG *STAGFields
+
+	SDFFilters []*SDFFilterFields
+}
+
+// EthernetFrame is the values in an Ethernet frame to be matched with
+// EthernetPacketFilterFields.
+type EthernetFrame struct {
+	SrcMAC    net.HardwareAddr
+	DstMAC    net.HardwareAddr
+	Ethertype uint16
+
+	// CTAG and STAG are nil if the frame does not have them.
+	CTAG *VLANTag
+	STAG *VLANTag
+
+	// IP is the values in the IP packet in the frame, which are matched with the
+	// SDFFilters. It is nil if the frame does not contain an IP packet.
+	IP *FlowTuple
+}
+
+// VLANTag is the VLAN tag (C-TAG or S-TAG) in an Ethernet frame.
+type VLANTag struct {
+	PCP uint8
+	DEI bool
+	VID uint16
+}
+
+// EthernetPacketFilterFields returns the IEs in EthernetPacketFilter in structured
+// format if the type of IE matches.
+func (i *IE) EthernetPacketFilterFields() (*EthernetPacketFilterFields, error) {
+	if i.Type != EthernetPacketFilter {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	return ParseEthernetPacketFilterFields(i.Payload)
+}
+
+// ParseEthernetPacketFilterFields parses b, the payload of EthernetPacketFilter,
+// into EthernetPacketFilterFields. The unknown IEs are ignored.
+func ParseEthernetPacketFilterFields(b []byte) (*EthernetPacketFilterFields, error) {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &EthernetPacketFilterFields{}
+	for _, x := range ies {
+		switch x.Type {
+		case EthernetFilterID:
+			if f.EthernetFilterID, err = x.EthernetFilterID(); err != nil {
+				return nil, err
+			}
+		case EthernetFilterProperties:
+			v, err := x.EthernetFilterProperties()
+			if err != nil {
+				return nil, err
+			}
+			f.Bidirectional = has1stBit(v)
+		case MACAddress:
+			m, err := x.MACAddress()
+			if err != nil {
+				return nil, err
+			}
+			f.MACAddresses = append(f.MACAddresses, m)
+		case Ethertype:
+			if f.Ethertype, err = x.Ethertype(); err != nil {
+				return nil, err
+			}
+		case CTAG:
+			if f.CTAG, err = x.CTAG(); err != nil {
+				return nil, err
+			}
+		case STAG:
+			if f.STAG, err = x.STAG(); err != nil {
+				return nil, err
+			}
+		case SDFFilter:
+			s, err := x.SDFFilter()
+			if err != nil {
+				return nil, err
+			}
+			f.SDFFilters = append(f.SDFFilters, s)
+		}
+	}
+	return f, nil
+}
+
+// Match reports whether the frame matches all the components present in the
+// EthernetPacketFilterFields: any of the MACAddresses, the Ethertype, the CTAG and
+// STAG, and any of the SDFFilters. Only the fields of the VLAN tags whose flags
+// are set are compared, and the others are wildcards. If the filter is
+// bidirectional, the frame in the reverse direction also matches.
+//
+// The CTAG or STAG matches only the frame that has the tag, even if none of its
+// flags are set, as its presence requires the frame to be tagged.
+//
+// assigned is used for the "assigned" keyword in the SDFFilters, as in
+// SDFFilterFields.Match. The error is returned if the SDFFilter cannot be parsed.
+// To match many frames, parse them once with ParsedFlowDescriptions and use
+// MatchWith instead.
+func (f *EthernetPacketFilterFields) Match(fr *EthernetFrame, assigned func(net.IP) bool) (bool, error) {
+	fds, err := f.ParsedFlowDescriptions()
+	if err != nil {
+		return false, err
+	}
+	return f.MatchWith(fds, fr, assigned), nil
+}
+
+// ParsedFlowDescriptions returns the Flow Descriptions in the SDFFilters parsed
+// with ParseFlowDescription, in the same order as the SDFFilters. The one is nil
+// if the SDFFilter has no Flow Description.
+func (f *EthernetPacketFilterFields) ParsedFlowDescriptions() ([]*FlowDescription, error) {
+	fds := make([]*FlowDescription, len(f.SDFFilters))
+	for n, s := range f.SDFFilters {
+		if !s.HasFD() {
+			continue
+		}
+		fd, err := s.ParsedFlowDescription()
+		if err != nil {
+			return nil, err
+		}
+		fds[n] = fd
+	}
+	return fds, nil
+}
+
+// MatchWith is the same as Match, but uses fds as the Flow Descriptions of the
+// SDFFilters instead of parsing them. fds should be the ones returned by
+// ParsedFlowDescriptions.
+func (f *EthernetPacketFilterFields) MatchWith(fds []*FlowDescription, fr *EthernetFrame, assigned func(net.IP) bool) bool {
+	if f.match(fds, fr, assigned) {
+		return true
+	}
+	if !f.Bidirectional {
+		return false
+	}
+
+	rev := *fr
+	rev.SrcMAC, rev.DstMAC = fr.DstMAC, fr.SrcMAC
+	if fr.IP != nil {
+		ip := *fr.IP
+		ip.SrcIP, ip.DstIP, ip.SrcPort, ip.DstPort = ip.DstIP, ip.SrcIP, ip.DstPort, ip.SrcPort
+		rev.IP = &ip
+	}
+	return f.match(fds, &rev, assigned)
+}
+
+func (f *EthernetPacketFilterFields) match(fds []*FlowDescription, fr *EthernetFrame, assigned func(net.IP) bool) bool {
+	if f.Ethertype != 0 && f.Ethertype != fr.Ethertype {
+		return false
+	}
+	if c := f.CTAG; c != nil && !matchVLANTag(fr.CTAG, c.HasPCP(), c.HasDEI(), c.HasVID(), c.PCP, c.DEIFlag, c.CVID) {
+		return false
+	}
+	if s := f.STAG; s != nil && !matchVLANTag(fr.STAG, s.HasPCP(), s.HasDEI(), s.HasVID(), s.PCP, s.DEIFlag, s.CVID) {
+		return false
+	}
+
+	if len(f.MACAddresses) > 0 {
+		found := false
+		for _, m := range f.MACAddresses {
+			if m.Match(fr.SrcMAC, fr.DstMAC) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.SDFFilters) == 0 {
+		return true
+	}
+	if fr.IP == nil {
+		return false
+	}
+	for n, s := range f.SDFFilters {
+		var fd *FlowDescription
+		if n < len(fds) {
+			fd = fds[n]
+		}
+		if s.MatchWith(fd, fr.IP, assigned) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchVLANTag reports whether the tag matches the fields whose flags are set.
+// The frame without the tag never matches.
+func matchVLANTag(tag *VLANTag, hasPCP, hasDEI, hasVID bool, pcp, dei uint8, vid uint16) bool {
+	if tag == nil {
+		return false
+	}
+	if hasPCP && tag.PCP != pcp {
+		return false
+	}
+	if hasDEI && tag.DEI != (dei != 0) {
+		return false
+	}
+	if hasVID && tag.VID != vid {
+		return false
+	}
+	return true
+}
+
+// Match reports whether the source and destination MAC addresses match the ones
+// present in MACAddressFields. If the upper address is present, the address is
+// matched as the range between them, inclusive.
+func (f *MACAddressFields) Match(src, dst net.HardwareAddr) bool {
+	if f.HasSOUR() && !inMACRange(src, f.SourceMACAddress, f.UpperSourceMACAddress, f.HasUSOU()) {
+		return false
+	}
+	if f.HasDEST() && !inMACRange(dst, f.DestinationMACAddress, f.UpperDestinationMACAddress, f.HasUDES()) {
+		return false
+	}
+	return true
+}
+
+func inMACRange(mac, lower, upper net.HardwareAddr, isRange bool) bool {
+	if !isRange {
+		return bytes.Equal(mac, lower)
+	}
+	return bytes.Compare(lower, mac) <= 0 && bytes.Compare(mac, upper) <= 0
+}
@@ -0,0 +1,197 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEthernetPacketFilterFields(t *testing.T) {
+	mac1, _ := net.ParseMAC("00:00:5e:00:53:01")
+	mac2, _ := net.ParseMAC("00:00:5e:00:53:02")
+
+	i := ie.NewEthernetPacketFilter(
+		ie.NewEthernetFilterID(0xffffffff),
+		ie.NewEthernetFilterProperties(0x01),
+		ie.NewMACAddress(mac1, mac2, nil, nil),
+		ie.NewEthertype(0x0800),
+		ie.NewCTAG(0x07, 1, 1, 0x123),
+		ie.NewSTAG(0x04, 0, 0, 4095),
+		ie.NewSDFFilter("permit out ip from any to assigned", "", "", "", 0),
+	)
+
+	got, err := i.EthernetPacketFilterFields()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ie.EthernetPacketFilterFields{
+		EthernetFilterID: 0xffffffff,
+		Bidirectional:    true,
+		MACAddresses:     []*ie.MACAddressFields{ie.NewMACAddressFields(mac1, mac2, nil, nil)},
+		Ethertype:        0x0800,
+		CTAG:             ie.NewCTAGFields(0x07, 1, 1, 0x123),
+		STAG:             &ie.STAGFields{Flags: 0x04, CVID: 4095},
+		SDFFilters:       []*ie.SDFFilterFields{ie.NewSDFFilterFields("permit out ip from any to assigned", "", "", "", 0)},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err := ie.NewEthertype(0x0800).EthernetPacketFilterFields(); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestEthernetPacketFilterMatch(t *testing.T) {
+	mac1, _ := net.ParseMAC("00:00:5e:00:53:01")
+	mac2, _ := net.ParseMAC("00:00:5e:00:53:02")
+	mac3, _ := net.ParseMAC("00:00:5e:00:53:03")
+	mac9, _ := net.ParseMAC("00:00:5e:00:53:09")
+
+	frame := func(src, dst net.HardwareAddr) *ie.EthernetFrame {
+		return &ie.EthernetFrame{
+			SrcMAC:    src,
+			DstMAC:    dst,
+			Ethertype: 0x0800,
+			CTAG:      &ie.VLANTag{PCP: 5, DEI: false, VID: 100},
+			IP: &ie.FlowTuple{
+				SrcIP:    net.ParseIP("192.0.2.1"),
+				DstIP:    net.ParseIP("10.0.0.1"),
+				Protocol: 17,
+				SrcPort:  5000,
+				DstPort:  6000,
+			},
+		}
+	}
+
+	cases := []struct {
+		description string
+		filter      *ie.IE
+		frame       *ie.EthernetFrame
+		want        bool
+	}{
+		{
+			"Empty",
+			ie.NewEthernetPacketFilter(),
+			frame(mac1, mac2),
+			true,
+		}, {
+			"SourceMAC",
+			ie.NewEthernetPacketFilter(ie.NewMACAddress(mac1, nil, nil, nil)),
+			frame(mac1, mac2),
+			true,
+		}, {
+			"SourceMAC/Mismatch",
+			ie.NewEthernetPacketFilter(ie.NewMACAddress(mac1, nil, nil, nil)),
+			frame(mac2, mac1),
+			false,
+		}, {
+			"SourceMAC/Bidirectional",
+			ie.NewEthernetPacketFilter(ie.NewEthernetFilterProperties(0x01), ie.NewMACAddress(mac1, nil, nil, nil)),
+			frame(mac2, mac1),
+			true,
+		}, {
+			"MACRange",
+			ie.NewEthernetPacketFilter(ie.NewMACAddress(nil, mac1, nil, mac3)),
+			frame(mac9, mac2),
+			true,
+		}, {
+			"MACRange/OutOfRange",
+			ie.NewEthernetPacketFilter(ie.NewMACAddress(nil, mac1, nil, mac3)),
+			frame(mac2, mac9),
+			false,
+		}, {
+			"MultipleMACs",
+			ie.NewEthernetPacketFilter(ie.NewMACAddress(mac3, nil, nil, nil), ie.NewMACAddress(mac2, nil, nil, nil)),
+			frame(mac2, mac1),
+			true,
+		}, {
+			"Ethertype/Mismatch",
+			ie.NewEthernetPacketFilter(ie.NewEthertype(0x86dd)),
+			frame(mac1, mac2),
+			false,
+		}, {
+			"CTAG/VIDOnly",
+			ie.NewEthernetPacketFilter(ie.NewCTAG(0x04, 0, 0, 100)),
+			frame(mac1, mac2),
+			true,
+		}, {
+			"CTAG/PCPOnly",
+			ie.NewEthernetPacketFilter(ie.NewCTAG(0x01, 5, 0, 0)),
+			frame(mac1, mac2),
+			true,
+		}, {
+			"CTAG/DEI",
+			ie.NewEthernetPacketFilter(ie.NewCTAG(0x06, 0, 1, 100)),
+			frame(mac1, mac2),
+			false,
+		}, {
+			"CTAG/WrongVID",
+			ie.NewEthernetPacketFilter(ie.NewCTAG(0x07, 5, 0, 200)),
+			frame(mac1, mac2),
+			false,
+		}, {
+			"CTAG/Wildcard",
+			ie.NewEthernetPacketFilter(ie.NewCTAG(0x00, 0, 0, 0)),
+			frame(mac1, mac2),
+			true,
+		}, {
+			"CTAG/Wildcard/Untagged",
+			ie.NewEthernetPacketFilter(ie.NewCTAG(0x00, 0, 0, 0)),
+			func() *ie.EthernetFrame { fr := frame(mac1, mac2); fr.CTAG = nil; return fr }(),
+			false,
+		}, {
+			"STAG/Missing",
+			ie.NewEthernetPacketFilter(ie.NewSTAG(0x04, 0, 0, 100)),
+			frame(mac1, mac2),
+			false,
+		}, {
+			"SDFFilter",
+			ie.NewEthernetPacketFilter(ie.NewSDFFilter("permit out 17 from 192.0.2.0/24 to assigned 6000", "", "", "", 0)),
+			frame(mac1, mac2),
+			true,
+		}, {
+			"SDFFilter/Mismatch",
+			ie.NewEthernetPacketFilter(ie.NewSDFFilter("permit out 6 from 192.0.2.0/24 to assigned", "", "", "", 0)),
+			frame(mac1, mac2),
+			false,
+		}, {
+			"SDFFilter/NonIP",
+			ie.NewEthernetPacketFilter(ie.NewSDFFilter("permit out ip from any to assigned", "", "", "", 0)),
+			&ie.EthernetFrame{SrcMAC: mac1, DstMAC: mac2, Ethertype: 0x88f7},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			f, err := c.filter.EthernetPacketFilterFields()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := f.Match(c.frame, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != c.want {
+				t.Errorf("got: %v, want: %v", got, c.want)
+			}
+
+			fds, err := f.ParsedFlowDescriptions()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := f.MatchWith(fds, c.frame, nil); got != c.want {
+				t.Errorf("MatchWith: got: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
@@ -13,7 +13,7 @@ func NewEthernetPacketFilter(ies ...*IE) *IE {
 func (i *IE) EthernetPacketFilter() ([]*IE, error) {
 	switch i.Type {
 	case EthernetPacketFilter:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreatePDR:
 		ies, err := i.CreatePDR()
 		if err != nil {
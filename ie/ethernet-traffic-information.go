@@ -13,7 +13,7 @@ func NewEthernetTrafficInformation(ies ...*IE) *IE {
 func (i *IE) EthernetTrafficInformation() ([]*IE, error) {
 	switch i.Type {
 	case EthernetTrafficInformation:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case UsageReportWithinSessionModificationResponse,
 		UsageReportWithinSessionDeletionResponse,
 		UsageReportWithinSessionReportRequest:
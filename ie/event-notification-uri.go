@@ -0,0 +1,31 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewEventNotificationURI creates a new EventNotificationURI IE.
+func NewEventNotificationURI(v string) *IE {
+	return newStringIE(EventNotificationURI, v)
+}
+
+// EventNotificationURI returns EventNotificationURI in string if the type of IE matches.
+func (i *IE) EventNotificationURI() (string, error) {
+	switch i.Type {
+	case EventNotificationURI:
+		return i.ValueAsString()
+	case DirectReportingInformation:
+		ies, err := i.DirectReportingInformation()
+		if err != nil {
+			return "", err
+		}
+		for _, x := range ies {
+			if x.Type == EventNotificationURI {
+				return x.EventNotificationURI()
+			}
+		}
+		return "", ErrIENotFound
+	default:
+		return "", &InvalidTypeError{Type: i.Type}
+	}
+}
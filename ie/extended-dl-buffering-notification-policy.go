@@ -0,0 +1,29 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewExtendedDLBufferingNotificationPolicy creates a new ExtendedDLBufferingNotificationPolicy IE.
+func NewExtendedDLBufferingNotificationPolicy(flags uint8) *IE {
+	return newUint8ValIE(ExtendedDLBufferingNotificationPolicy, flags)
+}
+
+// ExtendedDLBufferingNotificationPolicy returns ExtendedDLBufferingNotificationPolicy in uint8 if the type of IE matches.
+func (i *IE) ExtendedDLBufferingNotificationPolicy() (uint8, error) {
+	if i.Type != ExtendedDLBufferingNotificationPolicy {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.ValueAsUint8()
+}
+
+// HasEDBN reports whether an IE has EDBN bit.
+func (i *IE) HasEDBN() bool {
+	v, err := i.ExtendedDLBufferingNotificationPolicy()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
@@ -9,42 +9,70 @@ import (
 	"io"
 )
 
+// RuleIDTypeValue is the Rule ID Type in FailedRuleID IE.
+type RuleIDTypeValue uint8
+
 // Rule ID Type definitions.
 const (
-	RuleIDTypePDR uint8 = 0 // 16
-	RuleIDTypeFAR uint8 = 1 // 32
-	RuleIDTypeQER uint8 = 2 // 32
-	RuleIDTypeURR uint8 = 3 // 32
-	RuleIDTypeBAR uint8 = 4 // 8
+	RuleIDTypePDR RuleIDTypeValue = 0 // 16
+	RuleIDTypeFAR RuleIDTypeValue = 1 // 32
+	RuleIDTypeQER RuleIDTypeValue = 2 // 32
+	RuleIDTypeURR RuleIDTypeValue = 3 // 32
+	RuleIDTypeBAR RuleIDTypeValue = 4 // 8
 )
 
+var ruleIDTypeValues = newEnum("RuleIDTypeValue", map[RuleIDTypeValue]string{
+	RuleIDTypePDR: "RuleIDTypePDR",
+	RuleIDTypeFAR: "RuleIDTypeFAR",
+	RuleIDTypeQER: "RuleIDTypeQER",
+	RuleIDTypeURR: "RuleIDTypeURR",
+	RuleIDTypeBAR: "RuleIDTypeBAR",
+})
+
+// String returns the name of the RuleIDTypeValue, e.g., "RuleIDTypePDR".
+func (v RuleIDTypeValue) String() string {
+	return ruleIDTypeValues.name(v)
+}
+
+// IsValid reports whether the RuleIDTypeValue is a defined value.
+func (v RuleIDTypeValue) IsValid() bool {
+	return ruleIDTypeValues.valid(v)
+}
+
+// RuleIDTypeValueByName returns the RuleIDTypeValue of the given name, e.g., "RuleIDTypePDR".
+// The value in decimal is also accepted.
+func RuleIDTypeValueByName(name string) (RuleIDTypeValue, bool) {
+	return ruleIDTypeValues.byName(name)
+}
+
 // NewFailedRuleID creates a new FailedRuleID IE.
-func NewFailedRuleID(typ uint8, id uint32) *IE {
+func NewFailedRuleID(typ RuleIDTypeValue, id uint32) *IE {
 	switch typ {
 	case RuleIDTypePDR:
 		b := make([]byte, 3)
-		b[0] = typ
+		b[0] = uint8(typ)
 		binary.BigEndian.PutUint16(b[1:3], uint16(id))
 		return New(FailedRuleID, b)
 	case RuleIDTypeFAR, RuleIDTypeQER, RuleIDTypeURR:
 		b := make([]byte, 5)
-		b[0] = typ
+		b[0] = uint8(typ)
 		binary.BigEndian.PutUint32(b[1:5], id)
 		return New(FailedRuleID, b)
 	case RuleIDTypeBAR:
-		return New(FailedRuleID, []byte{typ, uint8(id)})
+		return New(FailedRuleID, []byte{uint8(typ), uint8(id)})
 	default:
-		return New(FailedRuleID, []byte{typ})
+		return New(FailedRuleID, []byte{uint8(typ)})
 	}
 }
 
-// RuleIDType returns RuleIDType in uint8 if the type of IE matches.
-func (i *IE) RuleIDType() (uint8, error) {
+// RuleIDType returns RuleIDType in RuleIDTypeValue if the type of IE matches.
+func (i *IE) RuleIDType() (RuleIDTypeValue, error) {
 	if i.Type != FailedRuleID {
 		return 0, &InvalidTypeError{Type: i.Type}
 	}
 
-	return i.ValueAsUint8()
+	v, err := i.ValueAsUint8()
+	return RuleIDTypeValue(v), err
 }
 
 // FailedRuleID returns FailedRuleID in uint32 if the type of IE matches.
@@ -56,7 +84,7 @@ func (i *IE) FailedRuleID() (uint32, error) {
 		return 0, io.ErrUnexpectedEOF
 	}
 
-	switch i.Payload[0] {
+	switch RuleIDTypeValue(i.Payload[0]) {
 	case RuleIDTypePDR:
 		if len(i.Payload) < 3 {
 			return 0, io.ErrUnexpectedEOF
@@ -0,0 +1,66 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"strconv"
+	"strings"
+)
+
+// flagSet holds the names of the flags in a flag IE and how to encode them.
+//
+// The bits in the flag set are numbered from the 1st bit of the 1st octet, i.e.,
+// bit n of octet k (both starting from 1) is 1<<((k-1)*8+n-1) in the flag set.
+type flagSet struct {
+	names     []string
+	minOctets int
+}
+
+// string returns the names of the set flags joined with "|", e.g., "FORW|NOCP".
+// The undefined flags are shown as "BIT<n>".
+func (f *flagSet) string(v uint64) string {
+	if v == 0 {
+		return "0"
+	}
+
+	var names []string
+	for n := 0; n < 64; n++ {
+		if v&(1<<n) == 0 {
+			continue
+		}
+		if n < len(f.names) && f.names[n] != "" {
+			names = append(names, f.names[n])
+		} else {
+			names = append(names, "BIT"+strconv.Itoa(n))
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// octets returns the serialized flags in the minimum number of octets that can
+// contain all the set flags, which is not less than minOctets.
+func (f *flagSet) octets(v uint64) []byte {
+	n := f.minOctets
+	for k := n; k < 8; k++ {
+		if v>>(k*8) != 0 {
+			n = k + 1
+		}
+	}
+
+	b := make([]byte, n)
+	for k := range b {
+		b[k] = uint8(v >> (k * 8))
+	}
+	return b
+}
+
+// fromOctets returns the flags in the serialized octets.
+func (f *flagSet) fromOctets(b []byte) uint64 {
+	var v uint64
+	for k := 0; k < len(b) && k < 8; k++ {
+		v |= uint64(b[k]) << (k * 8)
+	}
+	return v
+}
@@ -0,0 +1,123 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFlagSets(t *testing.T) {
+	cases := []struct {
+		description string
+		structured  *ie.IE
+		payload     []byte
+		str         string
+		decoded     func(*ie.IE) (fmt.Stringer, error)
+		has         func(*ie.IE) bool
+	}{
+		{
+			"ApplyAction",
+			ie.NewApplyActionFlags(ie.ApplyActionFORW | ie.ApplyActionNOCP),
+			[]byte{0x0a},
+			"FORW|NOCP",
+			func(i *ie.IE) (fmt.Stringer, error) { return i.ApplyActionFlags() },
+			(*ie.IE).HasNOCP,
+		}, {
+			"ApplyAction/TwoOctets",
+			ie.NewApplyActionFlags(ie.ApplyActionFORW | ie.ApplyActionEDRT),
+			[]byte{0x02, 0x01},
+			"FORW|EDRT",
+			func(i *ie.IE) (fmt.Stringer, error) { return i.ApplyActionFlags() },
+			(*ie.IE).HasEDRT,
+		}, {
+			"ReportingTriggers",
+			ie.NewReportingTriggersFlags(ie.ReportingTriggersPERIO | ie.ReportingTriggersQUVTI),
+			[]byte{0x01, 0x80},
+			"PERIO|QUVTI",
+			func(i *ie.IE) (fmt.Stringer, error) { return i.ReportingTriggersFlags() },
+			(*ie.IE).HasQUVTI,
+		}, {
+			"ReportingTriggers/ThreeOctets",
+			ie.NewReportingTriggersFlags(ie.ReportingTriggersUPINT),
+			[]byte{0x00, 0x00, 0x02},
+			"UPINT",
+			func(i *ie.IE) (fmt.Stringer, error) { return i.ReportingTriggersFlags() },
+			(*ie.IE).HasUPINT,
+		}, {
+			"UsageReportTrigger",
+			ie.NewUsageReportTriggerFlags(ie.UsageReportTriggerIMMER | ie.UsageReportTriggerEMRRE),
+			[]byte{0x80, 0x00, 0x10},
+			"IMMER|EMRRE",
+			func(i *ie.IE) (fmt.Stringer, error) { return i.UsageReportTriggerFlags() },
+			(*ie.IE).HasEMRRE,
+		}, {
+			"MeasurementMethod",
+			ie.NewMeasurementMethodFlags(ie.MeasurementMethodDURAT | ie.MeasurementMethodVOLUM),
+			[]byte{0x03},
+			"DURAT|VOLUM",
+			func(i *ie.IE) (fmt.Stringer, error) { return i.MeasurementMethodFlags() },
+			(*ie.IE).HasVOLUM,
+		}, {
+			"PFCPSMReqFlags",
+			ie.NewPFCPSMReqFlagSet(ie.PFCPSMReqFlagsDROBU | ie.PFCPSMReqFlagsQAURR),
+			[]byte{0x05},
+			"DROBU|QAURR",
+			func(i *ie.IE) (fmt.Stringer, error) { return i.PFCPSMReqFlagSet() },
+			(*ie.IE).HasQAURR,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			if diff := cmp.Diff(c.structured.Payload, c.payload); diff != "" {
+				t.Error(diff)
+			}
+
+			b, err := c.structured.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := ie.Parse(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := c.decoded(parsed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s := got.String(); s != c.str {
+				t.Errorf("got %q want %q", s, c.str)
+			}
+			if !c.has(parsed) {
+				t.Error("flag is not set")
+			}
+		})
+	}
+
+	t.Run("SetClear", func(t *testing.T) {
+		var f ie.ApplyActionFlags
+		f.Set(ie.ApplyActionBUFF | ie.ApplyActionNOCP)
+		f.Clear(ie.ApplyActionNOCP)
+		f.Set(ie.ApplyActionMBSU)
+
+		if !f.Has(ie.ApplyActionBUFF|ie.ApplyActionMBSU) || f.Has(ie.ApplyActionNOCP) {
+			t.Errorf("got %v", f)
+		}
+		if diff := cmp.Diff(f.Octets(), []byte{0x04, 0x10}); diff != "" {
+			t.Error(diff)
+		}
+		if s := ie.ApplyActionFlags(0).String(); s != "0" {
+			t.Errorf("got %q", s)
+		}
+		if s := ie.ApplyActionFlags(1 << 15).String(); s != "BIT15" {
+			t.Errorf("got %q", s)
+		}
+	})
+}
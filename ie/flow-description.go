@@ -0,0 +1,373 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// FlowDescription is the IPFilterRule defined in RFC 6733, which is used as the
+// Flow Description in SDFFilter.
+//
+//	action dir proto from src [ports] to dst [ports] [options]
+//
+// Use ParseFlowDescription to parse it, and Validate to see if it meets the
+// restrictions in TS 29.212 clause 5.4.2.
+type FlowDescription struct {
+	Action    string // "permit" or "deny"
+	Direction string // "in" or "out"
+
+	// Protocol is the IP protocol number. It is ignored if AnyProtocol is true,
+	// which is given as "ip" in the rule.
+	Protocol    uint8
+	AnyProtocol bool
+
+	Src, Dst FlowAddress
+
+	// Options is the options at the end of the rule as they are, e.g., "frag".
+	Options []string
+}
+
+// FlowAddress is the source or destination of FlowDescription.
+type FlowAddress struct {
+	// Any is true if the address is "any", and Assigned is true if the address is
+	// "assigned", i.e., the address assigned to the UE. Otherwise Network is the
+	// address with the mask, which is /32 or /128 if it is not given.
+	Any      bool
+	Assigned bool
+	Network  *net.IPNet
+
+	// Ports is the list of the ports or port ranges. It is empty if any port matches.
+	Ports []PortRange
+}
+
+// PortRange is a port or a range of ports. Low and High are the same for a port.
+type PortRange struct {
+	Low, High uint16
+}
+
+// FlowTuple is the values in an IP packet to be matched with SDFFilter.
+//
+// TOS is the Type of Service in IPv4 or the Traffic Class in IPv6, and FlowLabel
+// is the IPv6 flow label in the lower 20 bits.
+type FlowTuple struct {
+	SrcIP     net.IP
+	DstIP     net.IP
+	Protocol  uint8
+	SrcPort   uint16
+	DstPort   uint16
+	TOS       uint8
+	SPI       uint32
+	FlowLabel uint32
+}
+
+// ParseFlowDescription parses s as the IPFilterRule defined in RFC 6733. The error
+// returned is *FlowDescriptionError.
+//
+// The keywords are case-sensitive as defined in RFC 6733, and the protocol is only
+// accepted as a number or "ip".
+func ParseFlowDescription(s string) (*FlowDescription, error) {
+	fail := func(reason string) (*FlowDescription, error) {
+		return nil, &FlowDescriptionError{Description: s, Reason: reason}
+	}
+
+	f := strings.Fields(s)
+	if len(f) < 7 {
+		return fail("too few fields")
+	}
+
+	fd := &FlowDescription{Action: f[0], Direction: f[1]}
+	if fd.Action != "permit" && fd.Action != "deny" {
+		return fail("unknown action " + strconv.Quote(fd.Action))
+	}
+	if fd.Direction != "in" && fd.Direction != "out" {
+		return fail("unknown direction " + strconv.Quote(fd.Direction))
+	}
+
+	if f[2] == "ip" {
+		fd.AnyProtocol = true
+	} else {
+		p, err := strconv.ParseUint(f[2], 10, 8)
+		if err != nil {
+			return fail("invalid protocol " + strconv.Quote(f[2]))
+		}
+		fd.Protocol = uint8(p)
+	}
+
+	if f[3] != "from" {
+		return fail(`missing "from"`)
+	}
+	rest, reason := fd.Src.parse(f[4:])
+	if reason != "" {
+		return fail(reason)
+	}
+	if len(rest) == 0 || rest[0] != "to" {
+		return fail(`missing "to"`)
+	}
+	if len(rest) < 2 {
+		return fail("missing destination")
+	}
+	rest, reason = fd.Dst.parse(rest[1:])
+	if reason != "" {
+		return fail(reason)
+	}
+
+	if len(rest) > 0 {
+		fd.Options = rest
+	}
+	return fd, nil
+}
+
+// parse parses the address and the optional ports, and returns the rest of f or
+// the reason of the failure.
+func (a *FlowAddress) parse(f []string) ([]string, string) {
+	switch addr := f[0]; addr {
+	case "any":
+		a.Any = true
+	case "assigned":
+		a.Assigned = true
+	default:
+		if !strings.Contains(addr, "/") {
+			ip := net.ParseIP(addr)
+			if ip == nil {
+				return nil, "invalid address " + strconv.Quote(addr)
+			}
+			if ip.To4() != nil {
+				addr += "/32"
+			} else {
+				addr += "/128"
+			}
+		}
+		_, n, err := net.ParseCIDR(addr)
+		if err != nil {
+			return nil, "invalid address " + strconv.Quote(addr)
+		}
+		a.Network = n
+	}
+	f = f[1:]
+
+	// the ports are the only field that starts with a digit after the address.
+	if len(f) == 0 || f[0] == "" || f[0][0] < '0' || f[0][0] > '9' {
+		return f, ""
+	}
+	for _, p := range strings.Split(f[0], ",") {
+		lo, hi, ok := strings.Cut(p, "-")
+		if !ok {
+			hi = lo
+		}
+		l, err1 := strconv.ParseUint(lo, 10, 16)
+		h, err2 := strconv.ParseUint(hi, 10, 16)
+		if err1 != nil || err2 != nil {
+			return nil, "invalid port " + strconv.Quote(p)
+		}
+		if l > h {
+			return nil, "invalid port range " + strconv.Quote(p)
+		}
+		a.Ports = append(a.Ports, PortRange{Low: uint16(l), High: uint16(h)})
+	}
+	return f[1:], ""
+}
+
+// String returns the FlowDescription in the form of IPFilterRule.
+func (f *FlowDescription) String() string {
+	var b strings.Builder
+	b.WriteString(f.Action + " " + f.Direction + " ")
+	if f.AnyProtocol {
+		b.WriteString("ip")
+	} else {
+		b.WriteString(strconv.Itoa(int(f.Protocol)))
+	}
+	b.WriteString(" from " + f.Src.String() + " to " + f.Dst.String())
+	for _, o := range f.Options {
+		b.WriteString(" " + o)
+	}
+	return b.String()
+}
+
+// String returns the FlowAddress in the form used in IPFilterRule.
+func (a *FlowAddress) String() string {
+	var s string
+	switch {
+	case a.Any:
+		s = "any"
+	case a.Assigned:
+		s = "assigned"
+	case a.Network != nil:
+		// the host address is written without the mask.
+		if ones, bits := a.Network.Mask.Size(); ones == bits {
+			s = a.Network.IP.String()
+		} else {
+			s = a.Network.String()
+		}
+	}
+
+	for n, p := range a.Ports {
+		if n == 0 {
+			s += " "
+		} else {
+			s += ","
+		}
+		s += strconv.Itoa(int(p.Low))
+		if p.High != p.Low {
+			s += "-" + strconv.Itoa(int(p.High))
+		}
+	}
+	return s
+}
+
+// Validate checks if the FlowDescription meets the restrictions in TS 29.212
+// clause 5.4.2: the action is "permit", the direction is "out", no options are
+// used, the source and destination are of the same IP version, and the ports are
+// only given for TCP, UDP, SCTP or any protocol. The error returned is
+// *FlowDescriptionError.
+func (f *FlowDescription) Validate() error {
+	fail := func(reason string) error {
+		return &FlowDescriptionError{Description: f.String(), Reason: reason}
+	}
+
+	if f.Action != "permit" {
+		return fail(`action must be "permit"`)
+	}
+	if f.Direction != "out" {
+		return fail(`direction must be "out"`)
+	}
+	if len(f.Options) > 0 {
+		return fail("options are not allowed")
+	}
+	if f.Src.Network != nil && f.Dst.Network != nil && (f.Src.Network.IP.To4() == nil) != (f.Dst.Network.IP.To4() == nil) {
+		return fail("source and destination are of different IP versions")
+	}
+	if (len(f.Src.Ports) > 0 || len(f.Dst.Ports) > 0) && !f.AnyProtocol && !hasPorts(f.Protocol) {
+		return fail("ports are given for protocol " + strconv.Itoa(int(f.Protocol)))
+	}
+	return nil
+}
+
+// hasPorts reports whether the IP protocol has the ports, i.e., TCP, UDP or SCTP.
+func hasPorts(proto uint8) bool {
+	return proto == 6 || proto == 17 || proto == 132
+}
+
+// Match reports whether the packet matches the FlowDescription, comparing the
+// source and destination as they are. assigned reports whether the address is the
+// one assigned to the UE, for the "assigned" keyword. If assigned is nil, "assigned"
+// matches any address.
+//
+// Note that the Flow Description in SDFFilter is written for the downlink
+// direction, so the source and destination of the uplink packets should be
+// swapped before calling Match. The action, direction and options are ignored.
+func (f *FlowDescription) Match(t *FlowTuple, assigned func(net.IP) bool) bool {
+	if !f.AnyProtocol && t.Protocol != f.Protocol {
+		return false
+	}
+	return f.Src.match(t.SrcIP, t.SrcPort, assigned) && f.Dst.match(t.DstIP, t.DstPort, assigned)
+}
+
+func (a *FlowAddress) match(ip net.IP, port uint16, assigned func(net.IP) bool) bool {
+	switch {
+	case a.Any:
+	case a.Assigned:
+		if assigned != nil && !assigned(ip) {
+			return false
+		}
+	default:
+		if ip == nil || a.Network == nil || !a.Network.Contains(ip) {
+			return false
+		}
+	}
+
+	if len(a.Ports) == 0 {
+		return true
+	}
+	for _, r := range a.Ports {
+		if r.Low <= port && port <= r.High {
+			return true
+		}
+	}
+	return false
+}
+
+// ParsedFlowDescription returns the Flow Description in SDFFilterFields parsed with
+// ParseFlowDescription. It returns ErrElementNotFound if the FD flag is not set.
+func (f *SDFFilterFields) ParsedFlowDescription() (*FlowDescription, error) {
+	if !f.HasFD() {
+		return nil, ErrElementNotFound
+	}
+	return ParseFlowDescription(f.FlowDescription)
+}
+
+// Validate checks if the fields in SDFFilterFields are valid: the Flow Description
+// is parsed and validated with FlowDescription.Validate, the ToS Traffic Class,
+// Security Parameter Index and Flow Label have the correct lengths, and the Flow
+// Label fits in 20 bits.
+func (f *SDFFilterFields) Validate() error {
+	if f.HasFD() {
+		fd, err := f.ParsedFlowDescription()
+		if err != nil {
+			return err
+		}
+		if err := fd.Validate(); err != nil {
+			return err
+		}
+	}
+	if f.HasTTC() && len(f.ToSTrafficClass) != 2 {
+		return ErrMalformed
+	}
+	if f.HasSPI() && len(f.SecurityParameterIndex) != 4 {
+		return ErrMalformed
+	}
+	if f.HasFL() && (len(f.FlowLabel) != 3 || f.FlowLabel[0]&0xf0 != 0) {
+		return ErrMalformed
+	}
+	return nil
+}
+
+// Match reports whether the packet matches all the components present in the
+// SDFFilterFields. The Flow Description is matched with FlowDescription.Match,
+// and the ToS Traffic Class is compared with the mask in its second octet.
+//
+// The error is returned if the Flow Description cannot be parsed. To match many
+// packets, parse it once with ParsedFlowDescription and use MatchWith instead.
+func (f *SDFFilterFields) Match(t *FlowTuple, assigned func(net.IP) bool) (bool, error) {
+	var fd *FlowDescription
+	if f.HasFD() {
+		var err error
+		fd, err = f.ParsedFlowDescription()
+		if err != nil {
+			return false, err
+		}
+	}
+	return f.MatchWith(fd, t, assigned), nil
+}
+
+// MatchWith is the same as Match, but uses fd as the Flow Description instead of
+// parsing the one in SDFFilterFields. fd should be the one returned by
+// ParsedFlowDescription, and is ignored if the FD flag is not set.
+func (f *SDFFilterFields) MatchWith(fd *FlowDescription, t *FlowTuple, assigned func(net.IP) bool) bool {
+	if f.HasFD() && (fd == nil || !fd.Match(t, assigned)) {
+		return false
+	}
+	if f.HasTTC() && len(f.ToSTrafficClass) == 2 {
+		tos, mask := f.ToSTrafficClass[0], f.ToSTrafficClass[1]
+		if t.TOS&mask != tos&mask {
+			return false
+		}
+	}
+	if f.HasSPI() && len(f.SecurityParameterIndex) == 4 {
+		if t.SPI != binary.BigEndian.Uint32([]byte(f.SecurityParameterIndex)) {
+			return false
+		}
+	}
+	if f.HasFL() && len(f.FlowLabel) == 3 {
+		fl := uint32(f.FlowLabel[0])<<16 | uint32(f.FlowLabel[1])<<8 | uint32(f.FlowLabel[2])
+		if t.FlowLabel&0xfffff != fl&0xfffff {
+			return false
+		}
+	}
+	return true
+}
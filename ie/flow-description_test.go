@@ -0,0 +1,278 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/google/go-cmp/cmp"
+)
+
+func mustCIDR(s string) *net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func TestParseFlowDescription(t *testing.T) {
+	cases := []struct {
+		description string
+		rule        string
+		want        *ie.FlowDescription
+		valid       bool
+	}{
+		{
+			"AnyToAssigned",
+			"permit out ip from any to assigned",
+			&ie.FlowDescription{
+				Action: "permit", Direction: "out", AnyProtocol: true,
+				Src: ie.FlowAddress{Any: true},
+				Dst: ie.FlowAddress{Assigned: true},
+			},
+			true,
+		}, {
+			"PortRange",
+			"permit out 17 from 10.0.0.0/8 1000-2000 to assigned",
+			&ie.FlowDescription{
+				Action: "permit", Direction: "out", Protocol: 17,
+				Src: ie.FlowAddress{Network: mustCIDR("10.0.0.0/8"), Ports: []ie.PortRange{{Low: 1000, High: 2000}}},
+				Dst: ie.FlowAddress{Assigned: true},
+			},
+			true,
+		}, {
+			"PortList/IPv6",
+			"permit out 6 from 2001:db8::1 80,443,8000-8080 to 2001:db8:1::/48",
+			&ie.FlowDescription{
+				Action: "permit", Direction: "out", Protocol: 6,
+				Src: ie.FlowAddress{
+					Network: mustCIDR("2001:db8::1/128"),
+					Ports:   []ie.PortRange{{Low: 80, High: 80}, {Low: 443, High: 443}, {Low: 8000, High: 8080}},
+				},
+				Dst: ie.FlowAddress{Network: mustCIDR("2001:db8:1::/48")},
+			},
+			true,
+		}, {
+			"Deny",
+			"deny in 1 from 192.0.2.1 to any",
+			&ie.FlowDescription{
+				Action: "deny", Direction: "in", Protocol: 1,
+				Src: ie.FlowAddress{Network: mustCIDR("192.0.2.1/32")},
+				Dst: ie.FlowAddress{Any: true},
+			},
+			false,
+		}, {
+			"Options",
+			"permit out 6 from any to assigned 80 established",
+			&ie.FlowDescription{
+				Action: "permit", Direction: "out", Protocol: 6,
+				Src:     ie.FlowAddress{Any: true},
+				Dst:     ie.FlowAddress{Assigned: true, Ports: []ie.PortRange{{Low: 80, High: 80}}},
+				Options: []string{"established"},
+			},
+			false,
+		}, {
+			"MixedVersions",
+			"permit out ip from 192.0.2.0/24 to 2001:db8::/32",
+			&ie.FlowDescription{
+				Action: "permit", Direction: "out", AnyProtocol: true,
+				Src: ie.FlowAddress{Network: mustCIDR("192.0.2.0/24")},
+				Dst: ie.FlowAddress{Network: mustCIDR("2001:db8::/32")},
+			},
+			false,
+		}, {
+			"PortsForICMP",
+			"permit out 1 from any 80 to assigned",
+			&ie.FlowDescription{
+				Action: "permit", Direction: "out", Protocol: 1,
+				Src: ie.FlowAddress{Any: true, Ports: []ie.PortRange{{Low: 80, High: 80}}},
+				Dst: ie.FlowAddress{Assigned: true},
+			},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			got, err := ie.ParseFlowDescription(c.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Error(diff)
+			}
+			if got.String() != c.rule {
+				t.Errorf("wrong String, got: %s, want: %s", got.String(), c.rule)
+			}
+
+			err = got.Validate()
+			if c.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			var fe *ie.FlowDescriptionError
+			if !c.valid && !errors.As(err, &fe) {
+				t.Errorf("got %v, want *FlowDescriptionError", err)
+			}
+		})
+	}
+}
+
+func TestParseFlowDescriptionError(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"permit out ip from any",
+		"allow out ip from any to assigned",
+		"permit up ip from any to assigned",
+		"permit out tcp from any to assigned",
+		"permit out 256 from any to assigned",
+		"permit out ip any to assigned",
+		"permit out ip from any assigned",
+		"permit out ip from 10.0.0.256 to assigned",
+		"permit out ip from 10.0.0.0/33 to assigned",
+		"permit out 6 from any 2000-1000 to assigned",
+		"permit out 6 from any 70000 to assigned",
+		"permit out 6 from any to",
+	} {
+		t.Run(rule, func(t *testing.T) {
+			_, err := ie.ParseFlowDescription(rule)
+			var fe *ie.FlowDescriptionError
+			if !errors.As(err, &fe) {
+				t.Errorf("got %v, want *FlowDescriptionError", err)
+			}
+		})
+	}
+}
+
+func TestSDFFilterMatch(t *testing.T) {
+	ue := net.ParseIP("10.0.0.1")
+	assigned := func(ip net.IP) bool { return ip.Equal(ue) }
+	tuple := func(src string, sport uint16, proto uint8) *ie.FlowTuple {
+		return &ie.FlowTuple{
+			SrcIP:     net.ParseIP(src),
+			DstIP:     ue,
+			Protocol:  proto,
+			SrcPort:   sport,
+			DstPort:   40000,
+			TOS:       0xb9,
+			SPI:       0x01020304,
+			FlowLabel: 0x0abcde,
+		}
+	}
+
+	cases := []struct {
+		description string
+		filter      *ie.IE
+		tuple       *ie.FlowTuple
+		want        bool
+	}{
+		{
+			"FD",
+			ie.NewSDFFilter("permit out 17 from 10.0.0.0/8 1000-2000 to assigned", "", "", "", 0),
+			tuple("10.1.2.3", 1500, 17),
+			true,
+		}, {
+			"FD/OutOfRange",
+			ie.NewSDFFilter("permit out 17 from 10.0.0.0/8 1000-2000 to assigned", "", "", "", 0),
+			tuple("10.1.2.3", 2001, 17),
+			false,
+		}, {
+			"FD/OtherNetwork",
+			ie.NewSDFFilter("permit out 17 from 10.0.0.0/8 1000-2000 to assigned", "", "", "", 0),
+			tuple("192.0.2.1", 1500, 17),
+			false,
+		}, {
+			"FD/OtherProtocol",
+			ie.NewSDFFilter("permit out 17 from 10.0.0.0/8 1000-2000 to assigned", "", "", "", 0),
+			tuple("10.1.2.3", 1500, 6),
+			false,
+		}, {
+			"FD/NotAssigned",
+			ie.NewSDFFilter("permit out ip from any to assigned", "", "", "", 0),
+			func() *ie.FlowTuple { t := tuple("10.1.2.3", 1500, 6); t.DstIP = net.ParseIP("10.0.0.2"); return t }(),
+			false,
+		}, {
+			"TTC",
+			ie.NewSDFFilter("", string([]byte{0xb8, 0xfc}), "", "", 0),
+			tuple("10.1.2.3", 1500, 17),
+			true,
+		}, {
+			"TTC/Mismatch",
+			ie.NewSDFFilter("", string([]byte{0x28, 0xfc}), "", "", 0),
+			tuple("10.1.2.3", 1500, 17),
+			false,
+		}, {
+			"SPI",
+			ie.NewSDFFilter("", "", string([]byte{0x01, 0x02, 0x03, 0x04}), "", 0),
+			tuple("10.1.2.3", 1500, 50),
+			true,
+		}, {
+			"SPI/Mismatch",
+			ie.NewSDFFilter("", "", string([]byte{0x01, 0x02, 0x03, 0x05}), "", 0),
+			tuple("10.1.2.3", 1500, 50),
+			false,
+		}, {
+			"FL",
+			ie.NewSDFFilter("", "", "", string([]byte{0x0a, 0xbc, 0xde}), 0),
+			tuple("10.1.2.3", 1500, 17),
+			true,
+		}, {
+			"FL/Mismatch",
+			ie.NewSDFFilter("", "", "", string([]byte{0x0a, 0xbc, 0xdf}), 0),
+			tuple("10.1.2.3", 1500, 17),
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			f, err := c.filter.SDFFilter()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := f.Validate(); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := f.Match(c.tuple, assigned)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != c.want {
+				t.Errorf("got: %v, want: %v", got, c.want)
+			}
+
+			var fd *ie.FlowDescription
+			if f.HasFD() {
+				if fd, err = f.ParsedFlowDescription(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got := f.MatchWith(fd, c.tuple, assigned); got != c.want {
+				t.Errorf("MatchWith: got: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestSDFFilterValidate(t *testing.T) {
+	for _, c := range []struct {
+		description string
+		fields      *ie.SDFFilterFields
+	}{
+		{"InvalidFD", ie.NewSDFFilterFields("permit out ip from any", "", "", "", 0)},
+		{"RestrictedFD", ie.NewSDFFilterFields("permit in ip from any to assigned", "", "", "", 0)},
+		{"FLTooLarge", ie.NewSDFFilterFields("", "", "", string([]byte{0x1a, 0xbc, 0xde}), 0)},
+	} {
+		t.Run(c.description, func(t *testing.T) {
+			if err := c.fields.Validate(); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -9,21 +9,47 @@ import (
 	"io"
 )
 
+// FlowDirectionValue is the Flow Direction in FlowInformation IE.
+type FlowDirectionValue uint8
+
 // FlowDirection definitions.
 const (
-	FlowDirectionUnspecified   uint8 = 0
-	FlowDirectionDownlink      uint8 = 1
-	FlowDirectionUplink        uint8 = 2
-	FlowDirectionBidirectional uint8 = 3
+	FlowDirectionUnspecified   FlowDirectionValue = 0
+	FlowDirectionDownlink      FlowDirectionValue = 1
+	FlowDirectionUplink        FlowDirectionValue = 2
+	FlowDirectionBidirectional FlowDirectionValue = 3
 )
 
+var flowDirectionValues = newEnum("FlowDirectionValue", map[FlowDirectionValue]string{
+	FlowDirectionUnspecified:   "FlowDirectionUnspecified",
+	FlowDirectionDownlink:      "FlowDirectionDownlink",
+	FlowDirectionUplink:        "FlowDirectionUplink",
+	FlowDirectionBidirectional: "FlowDirectionBidirectional",
+})
+
+// String returns the name of the FlowDirectionValue, e.g., "FlowDirectionDownlink".
+func (v FlowDirectionValue) String() string {
+	return flowDirectionValues.name(v)
+}
+
+// IsValid reports whether the FlowDirectionValue is a defined value.
+func (v FlowDirectionValue) IsValid() bool {
+	return flowDirectionValues.valid(v)
+}
+
+// FlowDirectionValueByName returns the FlowDirectionValue of the given name, e.g., "FlowDirectionDownlink".
+// The value in decimal is also accepted.
+func FlowDirectionValueByName(name string) (FlowDirectionValue, bool) {
+	return flowDirectionValues.byName(name)
+}
+
 // NewFlowInformation creates a new FlowInformation IE.
-func NewFlowInformation(dir uint8, desc string) *IE {
+func NewFlowInformation(dir FlowDirectionValue, desc string) *IE {
 	d := []byte(desc)
 	l := len(d)
 
 	i := New(FlowInformation, make([]byte, 3+l))
-	i.Payload[0] = dir
+	i.Payload[0] = uint8(dir)
 	binary.BigEndian.PutUint16(i.Payload[1:3], uint16(l))
 	copy(i.Payload[3:], d)
 
@@ -62,14 +88,14 @@ func (i *IE) FlowInformation() ([]byte, error) {
 	}
 }
 
-// FlowDirection returns FlowDirection in uint8 if the type of IE matches.
-func (i *IE) FlowDirection() (uint8, error) {
+// FlowDirection returns FlowDirection in FlowDirectionValue if the type of IE matches.
+func (i *IE) FlowDirection() (FlowDirectionValue, error) {
 	switch i.Type {
 	case FlowInformation:
 		if len(i.Payload) < 1 {
 			return 0, io.ErrUnexpectedEOF
 		}
-		return i.Payload[0] & 0x07, nil
+		return FlowDirectionValue(i.Payload[0] & 0x07), nil
 	case ApplicationDetectionInformation:
 		ies, err := i.ApplicationDetectionInformation()
 		if err != nil {
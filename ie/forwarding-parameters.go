@@ -13,7 +13,7 @@ func NewForwardingParameters(ies ...*IE) *IE {
 func (i *IE) ForwardingParameters() ([]*IE, error) {
 	switch i.Type {
 	case ForwardingParameters:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreateFAR:
 		ies, err := i.CreateFAR()
 		if err != nil {
@@ -4,15 +4,39 @@
 
 package ie
 
+// GateStatusValue is the value of GateStatus IE.
+type GateStatusValue uint8
+
 // GateStatus definitions.
 const (
-	GateStatusOpen   uint8 = 0
-	GateStatusClosed uint8 = 1
+	GateStatusOpen   GateStatusValue = 0
+	GateStatusClosed GateStatusValue = 1
 )
 
+var gateStatusValues = newEnum("GateStatusValue", map[GateStatusValue]string{
+	GateStatusOpen:   "GateStatusOpen",
+	GateStatusClosed: "GateStatusClosed",
+})
+
+// String returns the name of the GateStatusValue, e.g., "GateStatusOpen".
+func (v GateStatusValue) String() string {
+	return gateStatusValues.name(v)
+}
+
+// IsValid reports whether the GateStatusValue is a defined value.
+func (v GateStatusValue) IsValid() bool {
+	return gateStatusValues.valid(v)
+}
+
+// GateStatusValueByName returns the GateStatusValue of the given name, e.g., "GateStatusOpen".
+// The value in decimal is also accepted.
+func GateStatusValueByName(name string) (GateStatusValue, bool) {
+	return gateStatusValues.byName(name)
+}
+
 // NewGateStatus creates a new GateStatus IE.
-func NewGateStatus(ul, dl uint8) *IE {
-	return newUint8ValIE(GateStatus, (ul<<2)|dl)
+func NewGateStatus(ul, dl GateStatusValue) *IE {
+	return newUint8ValIE(GateStatus, uint8(ul<<2|dl))
 }
 
 // GateStatus returns GateStatus in uint8 if the type of IE matches.
@@ -47,32 +71,32 @@ func (i *IE) GateStatus() (uint8, error) {
 	}
 }
 
-// GateStatusUL returns GateStatusUL in uint8 if the type of IE matches.
-func (i *IE) GateStatusUL() (uint8, error) {
+// GateStatusUL returns GateStatusUL in GateStatusValue if the type of IE matches.
+func (i *IE) GateStatusUL() (GateStatusValue, error) {
 	v, err := i.GateStatus()
 	if err != nil {
 		return 0, err
 	}
 
-	return (v >> 2) & 0x03, nil
+	return GateStatusValue(v>>2) & 0x03, nil
 }
 
-// GateStatusDL returns GateStatusDL in uint8 if the type of IE matches.
-func (i *IE) GateStatusDL() (uint8, error) {
+// GateStatusDL returns GateStatusDL in GateStatusValue if the type of IE matches.
+func (i *IE) GateStatusDL() (GateStatusValue, error) {
 	v, err := i.GateStatus()
 	if err != nil {
 		return 0, err
 	}
 
-	return v & 0x03, nil
+	return GateStatusValue(v) & 0x03, nil
 }
 
-// GateStatusULDL returns GateStatusUL and GateStatusDL in uint8 if the type of IE matches.
-func (i *IE) GateStatusULDL() (uint8, uint8, error) {
+// GateStatusULDL returns GateStatusUL and GateStatusDL in GateStatusValue if the type of IE matches.
+func (i *IE) GateStatusULDL() (GateStatusValue, GateStatusValue, error) {
 	v, err := i.GateStatus()
 	if err != nil {
 		return 0, 0, err
 	}
 
-	return (v >> 2) & 0x03, v & 0x03, nil
+	return GateStatusValue(v>>2) & 0x03, GateStatusValue(v) & 0x03, nil
 }
@@ -0,0 +1,31 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewGroupID creates a new GroupID IE.
+func NewGroupID(id string) *IE {
+	return newStringIE(GroupID, id)
+}
+
+// GroupID returns GroupID in string if the type of IE matches.
+func (i *IE) GroupID() (string, error) {
+	switch i.Type {
+	case GroupID:
+		return i.ValueAsString()
+	case PFCPSessionChangeInfo:
+		ies, err := i.PFCPSessionChangeInfo()
+		if err != nil {
+			return "", err
+		}
+		for _, x := range ies {
+			if x.Type == GroupID {
+				return x.GroupID()
+			}
+		}
+		return "", ErrIENotFound
+	default:
+		return "", &InvalidTypeError{Type: i.Type}
+	}
+}
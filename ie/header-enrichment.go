@@ -8,13 +8,36 @@ import (
 	"io"
 )
 
+// HeaderTypeValue is the Header Type in HeaderEnrichment IE.
+type HeaderTypeValue uint8
+
 // HeaderType definitions.
 const (
-	HeaderTypeHTTP uint8 = 0
+	HeaderTypeHTTP HeaderTypeValue = 0
 )
 
+var headerTypeValues = newEnum("HeaderTypeValue", map[HeaderTypeValue]string{
+	HeaderTypeHTTP: "HeaderTypeHTTP",
+})
+
+// String returns the name of the HeaderTypeValue, e.g., "HeaderTypeHTTP".
+func (v HeaderTypeValue) String() string {
+	return headerTypeValues.name(v)
+}
+
+// IsValid reports whether the HeaderTypeValue is a defined value.
+func (v HeaderTypeValue) IsValid() bool {
+	return headerTypeValues.valid(v)
+}
+
+// HeaderTypeValueByName returns the HeaderTypeValue of the given name, e.g., "HeaderTypeHTTP".
+// The value in decimal is also accepted.
+func HeaderTypeValueByName(name string) (HeaderTypeValue, bool) {
+	return headerTypeValues.byName(name)
+}
+
 // NewHeaderEnrichment creates a new HeaderEnrichment IE.
-func NewHeaderEnrichment(typ uint8, name, value string) *IE {
+func NewHeaderEnrichment(typ HeaderTypeValue, name, value string) *IE {
 	fields := NewHeaderEnrichmentFields(typ, name, value)
 	b, err := fields.Marshal()
 	if err != nil {
@@ -63,7 +86,7 @@ func (i *IE) HeaderEnrichment() (*HeaderEnrichmentFields, error) {
 // HeaderEnrichmentFields represents a fields contained in HeaderEnrichment IE.
 type HeaderEnrichmentFields struct {
 	Flags            uint8
-	HeaderType       uint8
+	HeaderType       HeaderTypeValue
 	NameLength       uint8
 	HeaderFieldName  string
 	ValueLength      uint8
@@ -71,7 +94,7 @@ type HeaderEnrichmentFields struct {
 }
 
 // NewHeaderEnrichmentFields creates a new HeaderEnrichmentFields.
-func NewHeaderEnrichmentFields(typ uint8, name, value string) *HeaderEnrichmentFields {
+func NewHeaderEnrichmentFields(typ HeaderTypeValue, name, value string) *HeaderEnrichmentFields {
 	return &HeaderEnrichmentFields{
 		HeaderType:       typ,
 		NameLength:       uint8(len([]byte(name))),
@@ -97,7 +120,7 @@ func (f *HeaderEnrichmentFields) UnmarshalBinary(b []byte) error {
 		return io.ErrUnexpectedEOF
 	}
 
-	f.HeaderType = b[0]
+	f.HeaderType = HeaderTypeValue(b[0])
 	f.NameLength = b[1]
 	offset := 2
 
@@ -137,7 +160,7 @@ func (f *HeaderEnrichmentFields) MarshalTo(b []byte) error {
 		return io.ErrUnexpectedEOF
 	}
 
-	b[0] = f.HeaderType
+	b[0] = uint8(f.HeaderType)
 	b[1] = f.NameLength
 	offset := 2
 
@@ -0,0 +1,33 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+
+	"github.com/aalayanahmad/go-pfcp/internal/utils"
+)
+
+// NewHPLMNSNSSAI creates a new HPLMNSNSSAI IE.
+func NewHPLMNSNSSAI(sst uint8, sd uint32) *IE {
+	i := New(HPLMNSNSSAI, make([]byte, 4))
+	i.Payload[0] = sst
+	copy(i.Payload[1:4], utils.Uint32To24(sd))
+	return i
+}
+
+// HPLMNSNSSAI returns HPLMNSNSSAI in []byte if the type of IE matches.
+//
+// SST() and SD() can also be used to retrieve the values in HPLMNSNSSAI IE.
+func (i *IE) HPLMNSNSSAI() ([]byte, error) {
+	if i.Type != HPLMNSNSSAI {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 4 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0:4], nil
+}
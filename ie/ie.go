@@ -290,6 +290,85 @@ const (
 	ValidityTimer                                                    uint16 = 269
 	RedundantTransmissionForwardingParameters                        uint16 = 270
 	TransportDelayReporting                                          uint16 = 271
+	PartialFailureInformation                                        uint16 = 272
+	OffendingIEInformation                                           uint16 = 274
+	RATType                                                          uint16 = 275
+	L2TPTunnelInformation                                            uint16 = 276
+	L2TPSessionInformation                                           uint16 = 277
+	L2TPUserAuthentication                                           uint16 = 278
+	CreatedL2TPSession                                               uint16 = 279
+	LNSAddress                                                       uint16 = 280
+	TunnelPreference                                                 uint16 = 281
+	CallingNumber                                                    uint16 = 282
+	CalledNumber                                                     uint16 = 283
+	L2TPSessionIndications                                           uint16 = 284
+	DNSServerAddress                                                 uint16 = 285
+	NBNSServerAddress                                                uint16 = 286
+	MaximumReceiveUnit                                               uint16 = 287
+	Thresholds                                                       uint16 = 288
+	SteeringModeIndicator                                            uint16 = 289
+	PFCPSessionChangeInfo                                            uint16 = 290
+	GroupID                                                          uint16 = 291
+	CPIPAddress                                                      uint16 = 292
+	IPAddressAndPortNumberReplacement                                uint16 = 293
+	DNSQueryResponseFilter                                           uint16 = 294
+	DirectReportingInformation                                       uint16 = 295
+	EventNotificationURI                                             uint16 = 296
+	NotificationCorrelationID                                        uint16 = 297
+	ReportingFlags                                                   uint16 = 298
+	PredefinedRulesName                                              uint16 = 299
+	MBSSessionN4mbControlInformation                                 uint16 = 300
+	MBSMulticastParameters                                           uint16 = 301
+	AddMBSUnicastParameters                                          uint16 = 302
+	MBSSessionN4mbInformation                                        uint16 = 303
+	RemoveMBSUnicastParameters                                       uint16 = 304
+	MBSSessionIdentifier                                             uint16 = 305
+	MulticastTransportInformation                                    uint16 = 306
+	MBSN4mbReqFlags                                                  uint16 = 307
+	LocalIngressTunnel                                               uint16 = 308
+	MBSUnicastParametersID                                           uint16 = 309
+	MBSSessionN4ControlInformation                                   uint16 = 310
+	MBSSessionN4Information                                          uint16 = 311
+	MBSN4RespFlags                                                   uint16 = 312
+	TunnelPassword                                                   uint16 = 313
+	AreaSessionID                                                    uint16 = 314
+	PeerUPRestartReport                                              uint16 = 315
+	DSCPToPPIControlInformation                                      uint16 = 316
+	DSCPToPPIMappingInformation                                      uint16 = 317
+	PFCPSDRspFlags                                                   uint16 = 318
+	QERIndications                                                   uint16 = 319
+	VendorSpecificNodeReportType                                     uint16 = 320
+	ConfiguredTimeDomain                                             uint16 = 321
+	Metadata                                                         uint16 = 322
+	TrafficParameterMeasurementControlInformation                    uint16 = 323
+	TrafficParameterMeasurementReport                                uint16 = 324
+	TrafficParameterThreshold                                        uint16 = 325
+	DLPeriodicity                                                    uint16 = 326
+	N6JitterMeasurement                                              uint16 = 327
+	TrafficParameterMeasurementIndication                            uint16 = 328
+	ULPeriodicity                                                    uint16 = 329
+	MPQUICControlInformation                                         uint16 = 330
+	MPQUICParameters                                                 uint16 = 331
+	MPQUICAddressInformation                                         uint16 = 332
+	TransportMode                                                    uint16 = 333
+	ProtocolDescription                                              uint16 = 334
+	ReportingSuggestionInfo                                          uint16 = 335
+	TLContainer                                                      uint16 = 336
+	MeasurementIndication                                            uint16 = 337
+	HPLMNSNSSAI                                                      uint16 = 338
+	MediaTransportProtocol                                           uint16 = 339
+	RTPHeaderExtensionInformation                                    uint16 = 340
+	RTPPayloadInformation                                            uint16 = 341
+	RTPHeaderExtensionType                                           uint16 = 342
+	RTPHeaderExtensionID                                             uint16 = 343
+	RTPPayloadType                                                   uint16 = 344
+	RTPPayloadFormat                                                 uint16 = 345
+	ExtendedDLBufferingNotificationPolicy                            uint16 = 346
+	MTSDTControlInformation                                          uint16 = 347
+	ReportingThresholds                                              uint16 = 348
+	RTPHeaderExtensionAdditionalInformation                          uint16 = 349
+	MappedN6IPAddress                                                uint16 = 350
+	N6RoutingInformation                                             uint16 = 351
 )
 
 // IE represents an Information Element of PFCP messages.
@@ -433,11 +512,9 @@ func (i *IE) ValueAsFQDN() (string, error) {
 // ValueAsGrouped returns the value of IE as grouped IE.
 //
 // This method returns the ChildIEs field if it is already parsed.
-// Otherwise, it parses the Payload field and returns the result.
-//
-// It is recommended to access the ChildIEs field directly if you know the payload is
-// already parsed and not modified. If you need to parse the payload anyway, use the
-// `<IE-Name>()` method instead, which disregards the ChildIEs field.
+// Otherwise, it parses the Payload field and returns the result. The `<IE-Name>()`
+// methods of grouped IEs behave the same, so that they reflect the changes made
+// with ReplaceAt, InsertAt, RemoveAt and the like.
 //
 // For vendor-specific IE, this method tries to parse as grouped IE. If it fails, it
 // returns error.
@@ -446,6 +523,12 @@ func (i *IE) ValueAsGrouped() ([]*IE, error) {
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
 
+	return i.childIEs()
+}
+
+// childIEs returns the ChildIEs if they are populated, or the IEs parsed from the
+// Payload otherwise.
+func (i *IE) childIEs() ([]*IE, error) {
 	if len(i.ChildIEs) < 1 {
 		return ParseMultiIEs(i.Payload)
 	}
@@ -473,7 +556,7 @@ func (i *IE) valueAs3GPPTimestamp() (time.Time, error) {
 //
 // Note that this function uses the given bytes directly, so not safe to use
 // the buffer after calling this function. When you use the buffer somewhere
-// else, copy it before calling this function.
+// else, copy it before calling this function, or use Clone on the returned IE.
 func Parse(b []byte) (*IE, error) {
 	i := &IE{}
 	if err := i.UnmarshalBinary(b); err != nil {
@@ -490,19 +573,32 @@ func Parse(b []byte) (*IE, error) {
 // Note that this function uses the given bytes directly, so not safe to use
 // the buffer after calling this function. When you use the buffer somewhere
 // else, copy it before calling this function.
-func ParseMultiIEs(b []byte) ([]*IE, error) {
-	var ies []*IE
-	for {
-		if len(b) == 0 {
-			break
+//
+// Strict or Lenient can be given to change how the malformed IEs are handled.
+// Note that Lenient copies the bytes when anything is dropped.
+//
+// The error returned is *DecodeError, which tells where the decoding failed.
+func ParseMultiIEs(b []byte, opts ...ParseOption) ([]*IE, error) {
+	cfg := newDecodeConfig(opts)
+	switch {
+	case cfg.strict:
+		if err := Validate(b); err != nil {
+			return nil, err
+		}
+	case cfg.lenient:
+		if Validate(b) != nil {
+			b = cfg.sanitize(make([]byte, 0, len(b)), b, 0, "")
 		}
+	}
 
-		i, err := Parse(b)
+	var ies []*IE
+	for offset := 0; offset < len(b); {
+		i, err := Parse(b[offset:])
 		if err != nil {
-			return nil, err
+			return nil, newDecodeError(err, b, offset)
 		}
 		ies = append(ies, i)
-		b = b[i.MarshalLen():]
+		offset += i.MarshalLen()
 	}
 	return ies, nil
 }
@@ -552,6 +648,33 @@ func (i *IE) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+// Clone returns a deep copy of the IE.
+//
+// The Payload and ChildIEs of the returned IE do not share the underlying memory with
+// the original, so it is safe to use even after the buffer given to Parse is reused.
+func (i *IE) Clone() *IE {
+	if i == nil {
+		return nil
+	}
+
+	c := &IE{
+		Type:         i.Type,
+		Length:       i.Length,
+		EnterpriseID: i.EnterpriseID,
+	}
+	if i.Payload != nil {
+		c.Payload = make([]byte, len(i.Payload))
+		copy(c.Payload, i.Payload)
+	}
+	if i.ChildIEs != nil {
+		c.ChildIEs = make([]*IE, len(i.ChildIEs))
+		for n, ie := range i.ChildIEs {
+			c.ChildIEs[n] = ie.Clone()
+		}
+	}
+	return c
+}
+
 // Marshal returns the byte sequence generated from an IE instance.
 func (i *IE) Marshal() ([]byte, error) {
 	b := make([]byte, i.MarshalLen())
@@ -561,11 +684,44 @@ func (i *IE) Marshal() ([]byte, error) {
 	return b, nil
 }
 
+// AppendBinary appends the byte sequence generated from an IE instance to b
+// and returns the extended buffer.
+func (i *IE) AppendBinary(b []byte) ([]byte, error) {
+	n := len(b)
+	l := n + i.MarshalLen()
+	if cap(b) < l {
+		nb := make([]byte, n, l)
+		copy(nb, b)
+		b = nb
+	}
+
+	b = b[:l]
+	if err := i.MarshalTo(b[n:]); err != nil {
+		return b[:n], err
+	}
+	return b, nil
+}
+
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (i *IE) MarshalTo(b []byte) error {
+	_, err := i.marshalTo(b)
+	return err
+}
+
+// MarshalToN puts the byte sequence in the byte array given as b and returns the
+// number of bytes written, which is the same as MarshalLen without calculating
+// the length of the grouped IEs again.
+func (i *IE) MarshalToN(b []byte) (int, error) {
+	return i.marshalTo(b)
+}
+
+// marshalTo puts the byte sequence in b and returns the number of bytes written,
+// so that the grouped IEs can be serialized in a single pass without calculating
+// the length of each child IE again.
+func (i *IE) marshalTo(b []byte) (int, error) {
 	l := len(b)
 	if l < 4 {
-		return ErrInvalidLength
+		return 0, ErrInvalidLength
 	}
 
 	binary.BigEndian.PutUint16(b[:2], i.Type)
@@ -579,16 +735,23 @@ func (i *IE) MarshalTo(b []byte) error {
 
 	if i.IsGrouped() {
 		for _, ie := range i.ChildIEs {
-			if err := ie.MarshalTo(b[offset:]); err != nil {
-				return err
+			n, err := ie.marshalTo(b[offset:])
+			if err != nil {
+				return 0, err
 			}
-			offset += ie.MarshalLen()
+			offset += n
 		}
-		return nil
+
+		// The Length is determined by the child IEs actually written, so that
+		// the output is consistent even if the ChildIEs are modified directly.
+		binary.BigEndian.PutUint16(b[2:4], uint16(offset-4))
+		return offset, nil
 	}
 
-	copy(b[offset:i.MarshalLen()], i.Payload)
-	return nil
+	if l < offset+len(i.Payload) {
+		return 0, ErrInvalidLength
+	}
+	return offset + copy(b[offset:], i.Payload), nil
 }
 
 // MarshalLen returns field length in integer.
@@ -1,6 +1,10 @@
 package ie
 
-import "sync"
+import (
+	"sync"
+
+	"github.com/aalayanahmad/go-pfcp/internal/logger"
+)
 
 // We're using map to avoid iterating over a list.
 // The value `true` is not actually used.
@@ -95,6 +99,26 @@ var (
 		UEIPAddressUsageInformation:                               true,
 		RedundantTransmissionForwardingParameters:                 true,
 		TransportDelayReporting:                                   true,
+		PartialFailureInformation:                                 true,
+		L2TPTunnelInformation:                                     true,
+		L2TPSessionInformation:                                    true,
+		CreatedL2TPSession:                                        true,
+		PFCPSessionChangeInfo:                                     true,
+		DirectReportingInformation:                                true,
+		MBSSessionN4mbControlInformation:                          true,
+		MBSMulticastParameters:                                    true,
+		AddMBSUnicastParameters:                                   true,
+		MBSSessionN4mbInformation:                                 true,
+		RemoveMBSUnicastParameters:                                true,
+		MBSSessionN4ControlInformation:                            true,
+		MBSSessionN4Information:                                   true,
+		PeerUPRestartReport:                                       true,
+		DSCPToPPIControlInformation:                               true,
+		TrafficParameterMeasurementControlInformation:             true,
+		TrafficParameterMeasurementReport:                         true,
+		MPQUICParameters:                                          true,
+		RTPHeaderExtensionInformation:                             true,
+		RTPPayloadInformation:                                     true,
 	}
 	isGroupedFun = func(t uint16) bool {
 		mu.RLock()
@@ -128,8 +152,20 @@ func AddGroupedIEType(ts ...uint16) {
 // if the IE type is in the defaultGroupedIEMap.
 // You can change this entire behavior by calling SetIsGroupedFun(), or you can add
 // new IE types to the defaultGroupedIEMap by calling AddGroupedIEType().
+//
+// For vendor-specific IEs registered with RegisterVendorIE(), the registered
+// definition for the pair of EnterpriseID and Type is used instead.
 func (i *IE) IsGrouped() bool {
-	return isGroupedFun(i.Type)
+	return isGrouped(i.Type, i.EnterpriseID)
+}
+
+func isGrouped(itype, eid uint16) bool {
+	if itype&0x8000 != 0 {
+		if def, ok := LookupVendorIE(eid, itype); ok {
+			return def.Grouped
+		}
+	}
+	return isGroupedFun(itype)
 }
 
 // Add adds variable number of IEs to a IE if the IE is grouped type and update length.
@@ -139,16 +175,8 @@ func (i *IE) Add(ies ...*IE) {
 		return
 	}
 
-	i.Payload = nil
 	i.ChildIEs = append(i.ChildIEs, ies...)
-	for _, ie := range i.ChildIEs {
-		serialized, err := ie.Marshal()
-		if err != nil {
-			continue
-		}
-		i.Payload = append(i.Payload, serialized...)
-	}
-	i.SetLength()
+	i.rebuild()
 }
 
 // Remove removes an IE looked up by type.
@@ -157,25 +185,15 @@ func (i *IE) Remove(typ uint16) {
 		return
 	}
 
-	i.Payload = nil
-	newChildren := make([]*IE, len(i.ChildIEs))
-	idx := 0
+	newChildren := make([]*IE, 0, len(i.ChildIEs))
 	for _, ie := range i.ChildIEs {
 		if ie.Type == typ {
-			newChildren = newChildren[:len(newChildren)-1]
-			continue
-		}
-		newChildren[idx] = ie
-		idx++
-
-		serialized, err := ie.Marshal()
-		if err != nil {
 			continue
 		}
-		i.Payload = append(i.Payload, serialized...)
+		newChildren = append(newChildren, ie)
 	}
 	i.ChildIEs = newChildren
-	i.SetLength()
+	i.rebuild()
 }
 
 // FindByType returns IE looked up by type.
@@ -194,3 +212,209 @@ func (i *IE) FindByType(typ uint16) (*IE, error) {
 	}
 	return nil, ErrIENotFound
 }
+
+// Replace replaces the first child IE of the same type as ie, and updates the
+// Length and Payload.
+//
+// This returns ErrIENotFound if no child IE has the same type. To replace the
+// IE at a specific position or in a deeper level, use ReplaceAt.
+func (i *IE) Replace(ie *IE) error {
+	if !i.IsGrouped() {
+		return &InvalidTypeError{Type: i.Type}
+	}
+	if err := i.ensureChildIEs(); err != nil {
+		return err
+	}
+
+	for idx, c := range i.ChildIEs {
+		if c.Type == ie.Type {
+			return i.ReplaceAt(ie, idx)
+		}
+	}
+	return ErrIENotFound
+}
+
+// ChildAt returns the descendant IE at the position specified by path, which is
+// a list of indexes in ChildIEs at each depth.
+//
+// For example, in a CreatePDR IE that has PDRID, Precedence and PDI in order,
+// ChildAt(2, 0) returns the first child IE of the PDI.
+func (i *IE) ChildAt(path ...int) (*IE, error) {
+	parents, err := i.parentsOf(path)
+	if err != nil {
+		return nil, err
+	}
+
+	p, idx := parents[len(parents)-1], path[len(path)-1]
+	if idx < 0 || idx >= len(p.ChildIEs) {
+		return nil, ErrIENotFound
+	}
+	return p.ChildIEs[idx], nil
+}
+
+// ReplaceAt replaces the descendant IE at path with ie. See ChildAt for path.
+//
+// The Length and Payload of all the grouped IEs on the path, including i itself,
+// are updated so that the tree stays consistent.
+func (i *IE) ReplaceAt(ie *IE, path ...int) error {
+	parents, err := i.parentsOf(path)
+	if err != nil {
+		return err
+	}
+
+	p, idx := parents[len(parents)-1], path[len(path)-1]
+	if idx < 0 || idx >= len(p.ChildIEs) {
+		return ErrIENotFound
+	}
+
+	p.ChildIEs[idx] = ie
+	rebuildAll(parents)
+	return nil
+}
+
+// InsertAt inserts ie into the position specified by path. See ChildAt for path.
+//
+// The last index in path can be the number of the child IEs in the parent, in which
+// case ie is appended to the end. The Length and Payload of all the grouped IEs on
+// the path, including i itself, are updated so that the tree stays consistent.
+func (i *IE) InsertAt(ie *IE, path ...int) error {
+	parents, err := i.parentsOf(path)
+	if err != nil {
+		return err
+	}
+
+	p, idx := parents[len(parents)-1], path[len(path)-1]
+	if idx < 0 || idx > len(p.ChildIEs) {
+		return ErrIENotFound
+	}
+
+	children := make([]*IE, 0, len(p.ChildIEs)+1)
+	children = append(children, p.ChildIEs[:idx]...)
+	children = append(children, ie)
+	p.ChildIEs = append(children, p.ChildIEs[idx:]...)
+	rebuildAll(parents)
+	return nil
+}
+
+// RemoveAt removes the descendant IE at path. See ChildAt for path.
+//
+// The Length and Payload of all the grouped IEs on the path, including i itself,
+// are updated so that the tree stays consistent.
+func (i *IE) RemoveAt(path ...int) error {
+	parents, err := i.parentsOf(path)
+	if err != nil {
+		return err
+	}
+
+	p, idx := parents[len(parents)-1], path[len(path)-1]
+	if idx < 0 || idx >= len(p.ChildIEs) {
+		return ErrIENotFound
+	}
+
+	children := make([]*IE, 0, len(p.ChildIEs)-1)
+	children = append(children, p.ChildIEs[:idx]...)
+	p.ChildIEs = append(children, p.ChildIEs[idx+1:]...)
+	rebuildAll(parents)
+	return nil
+}
+
+// Refresh updates the Length and Payload of the grouped IE and all the grouped IEs
+// in it from their ChildIEs.
+//
+// This is needed only when the ChildIEs are modified directly. The methods that
+// modify the child IEs, such as Add, Remove and ReplaceAt, update them automatically.
+func (i *IE) Refresh() {
+	if !i.IsGrouped() {
+		i.SetLength()
+		return
+	}
+
+	for _, c := range i.ChildIEs {
+		if c != nil {
+			c.Refresh()
+		}
+	}
+	i.rebuild()
+}
+
+// parentsOf returns the grouped IEs from i to the parent of the IE at path.
+func (i *IE) parentsOf(path []int) ([]*IE, error) {
+	if len(path) == 0 {
+		return nil, ErrIENotFound
+	}
+
+	parents := make([]*IE, 0, len(path))
+	cur := i
+	for depth, idx := range path {
+		if !cur.IsGrouped() {
+			return nil, &InvalidTypeError{Type: cur.Type}
+		}
+		if err := cur.ensureChildIEs(); err != nil {
+			return nil, err
+		}
+		parents = append(parents, cur)
+
+		if depth == len(path)-1 {
+			break
+		}
+		if idx < 0 || idx >= len(cur.ChildIEs) {
+			return nil, ErrIENotFound
+		}
+		cur = cur.ChildIEs[idx]
+	}
+	return parents, nil
+}
+
+// ensureChildIEs parses the Payload into ChildIEs if the grouped IE is created
+// without them, e.g., with New().
+func (i *IE) ensureChildIEs() error {
+	if len(i.ChildIEs) > 0 || len(i.Payload) == 0 {
+		return nil
+	}
+
+	ies, err := ParseMultiIEs(i.Payload)
+	if err != nil {
+		return err
+	}
+	i.ChildIEs = ies
+	return nil
+}
+
+// rebuildAll rebuilds the given grouped IEs from the deepest one, so that the
+// changes in the children are reflected to the ancestors.
+func rebuildAll(ies []*IE) {
+	for n := len(ies) - 1; n >= 0; n-- {
+		ies[n].rebuild()
+	}
+}
+
+// rebuild regenerates the Payload from ChildIEs and updates the Length.
+//
+// The Payload is always allocated newly, as the old one may refer to the buffer
+// given to Parse, which should not be overwritten.
+func (i *IE) rebuild() {
+	l := 0
+	for _, c := range i.ChildIEs {
+		if c == nil {
+			continue
+		}
+		l += c.MarshalLen()
+	}
+
+	b := make([]byte, l)
+	offset := 0
+	for _, c := range i.ChildIEs {
+		if c == nil {
+			continue
+		}
+		n, err := c.marshalTo(b[offset:])
+		if err != nil {
+			logger.Logf("rebuild() failed to marshal an IE(Type=%d): %v", c.Type, err)
+			continue
+		}
+		offset += n
+	}
+
+	i.Payload = b[:offset]
+	i.SetLength()
+}
@@ -97,6 +97,11 @@ func TestStringIEs(t *testing.T) {
 			structured:  ie.NewFramedRoute("go-pfcp"),
 			decoded:     "go-pfcp",
 			decoderFunc: func(i *ie.IE) (string, error) { return i.FramedRoute() },
+		}, {
+			description: "GroupID",
+			structured:  ie.NewGroupID("go-pfcp"),
+			decoded:     "go-pfcp",
+			decoderFunc: func(i *ie.IE) (string, error) { return i.GroupID() },
 		}, {
 			description: "NetworkInstance",
 			structured:  ie.NewNetworkInstance("go-pfcp"),
@@ -186,6 +191,64 @@ func TestStringIEs(t *testing.T) {
 			structured:  ie.NewUEIPAddressPoolIdentity("go-pfcp"),
 			decoded:     "go-pfcp",
 			decoderFunc: func(i *ie.IE) (string, error) { return i.UEIPAddressPoolIdentityString() },
+		}, {
+			description: "CallingNumber",
+			structured:  ie.NewCallingNumber("go-pfcp"),
+			decoded:     "go-pfcp",
+			decoderFunc: func(i *ie.IE) (string, error) { return i.CallingNumber() },
+		}, {
+			description: "CallingNumber/L2TPSessionInformation",
+			structured: ie.NewL2TPSessionInformation(
+				ie.NewCallingNumber("go-pfcp"),
+			),
+			decoded:     "go-pfcp",
+			decoderFunc: func(i *ie.IE) (string, error) { return i.CallingNumber() },
+		}, {
+			description: "CalledNumber",
+			structured:  ie.NewCalledNumber("go-pfcp"),
+			decoded:     "go-pfcp",
+			decoderFunc: func(i *ie.IE) (string, error) { return i.CalledNumber() },
+		}, {
+			description: "CalledNumber/L2TPSessionInformation",
+			structured: ie.NewL2TPSessionInformation(
+				ie.NewCalledNumber("go-pfcp"),
+			),
+			decoded:     "go-pfcp",
+			decoderFunc: func(i *ie.IE) (string, error) { return i.CalledNumber() },
+		}, {
+			description: "DNSQueryResponseFilter",
+			structured:  ie.NewDNSQueryResponseFilter("go-pfcp"),
+			decoded:     "go-pfcp",
+			decoderFunc: func(i *ie.IE) (string, error) { return i.DNSQueryResponseFilter() },
+		}, {
+			description: "EventNotificationURI",
+			structured:  ie.NewEventNotificationURI("go-pfcp"),
+			decoded:     "go-pfcp",
+			decoderFunc: func(i *ie.IE) (string, error) { return i.EventNotificationURI() },
+		}, {
+			description: "EventNotificationURI/DirectReportingInformation",
+			structured: ie.NewDirectReportingInformation(
+				ie.NewEventNotificationURI("go-pfcp"),
+			),
+			decoded:     "go-pfcp",
+			decoderFunc: func(i *ie.IE) (string, error) { return i.EventNotificationURI() },
+		}, {
+			description: "PredefinedRulesName",
+			structured:  ie.NewPredefinedRulesName("go-pfcp"),
+			decoded:     "go-pfcp",
+			decoderFunc: func(i *ie.IE) (string, error) { return i.PredefinedRulesName() },
+		}, {
+			description: "TunnelPassword",
+			structured:  ie.NewTunnelPassword("go-pfcp"),
+			decoded:     "go-pfcp",
+			decoderFunc: func(i *ie.IE) (string, error) { return i.TunnelPassword() },
+		}, {
+			description: "TunnelPassword/L2TPTunnelInformation",
+			structured: ie.NewL2TPTunnelInformation(
+				ie.NewTunnelPassword("go-pfcp"),
+			),
+			decoded:     "go-pfcp",
+			decoderFunc: func(i *ie.IE) (string, error) { return i.TunnelPassword() },
 		},
 	}
 
@@ -5,6 +5,7 @@
 package ie_test
 
 import (
+	"errors"
 	"io"
 	"net"
 	"testing"
@@ -3583,10 +3584,179 @@ func TestIEs(t *testing.T) {
 				0x00, 0x15, 0x73, 0x6f, 0x6d, 0x65, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
 				0x00, 0x1e, 0x00, 0x02, 0x11, 0x11,
 			},
+		}, {
+			"CPIPAddress/IPv4",
+			ie.NewCPIPAddress(net.ParseIP("127.0.0.1"), nil),
+			[]byte{0x01, 0x24, 0x00, 0x05, 0x02, 0x7f, 0x00, 0x00, 0x01},
+		}, {
+			"CPIPAddress/Both",
+			ie.NewCPIPAddress(net.ParseIP("127.0.0.1"), net.ParseIP("2001::1")),
+			[]byte{0x01, 0x24, 0x00, 0x15, 0x03, 0x7f, 0x00, 0x00, 0x01, 0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
 		}, {
 			"VendorSpecific",
 			ie.NewVendorSpecificIE(0xffff, 10415, []byte{0xde, 0xad, 0xbe, 0xef}),
 			[]byte{0xff, 0xff, 0x00, 0x06, 0x28, 0xaf, 0xde, 0xad, 0xbe, 0xef},
+		}, {
+			"PartialFailureInformation",
+			ie.NewPartialFailureInformation(
+				ie.NewFailedRuleID(ie.RuleIDTypePDR, 0xffff),
+				ie.NewCause(ie.CauseRuleCreationModificationFailure),
+				ie.NewOffendingIEInformation(ie.PDRID, []byte{0xff, 0xff}),
+			),
+			[]byte{0x01, 0x10, 0x00, 0x14, 0x00, 0x72, 0x00, 0x03, 0x00, 0xff, 0xff, 0x00, 0x13, 0x00, 0x01, 0x49, 0x01, 0x12, 0x00, 0x04, 0x00, 0x38, 0xff, 0xff},
+		}, {
+			"L2TPTunnelInformation",
+			ie.NewL2TPTunnelInformation(
+				ie.NewLNSAddress(net.ParseIP("127.0.0.1")),
+				ie.NewTunnelPassword("go-pfcp"),
+				ie.NewTunnelPreference(1),
+			),
+			[]byte{0x01, 0x14, 0x00, 0x1b, 0x01, 0x18, 0x00, 0x04, 0x7f, 0x00, 0x00, 0x01, 0x01, 0x39, 0x00, 0x07, 0x67, 0x6f, 0x2d, 0x70, 0x66, 0x63, 0x70, 0x01, 0x19, 0x00, 0x04, 0x00, 0x00, 0x00, 0x01},
+		}, {
+			"CreatedL2TPSession",
+			ie.NewCreatedL2TPSession(
+				ie.NewDNSServerAddress(net.ParseIP("127.0.0.1")),
+				ie.NewNBNSServerAddress(net.ParseIP("127.0.0.1")),
+				ie.NewLNSAddress(net.ParseIP("2001::1")),
+			),
+			[]byte{0x01, 0x17, 0x00, 0x24, 0x01, 0x1d, 0x00, 0x04, 0x7f, 0x00, 0x00, 0x01, 0x01, 0x1e, 0x00, 0x04, 0x7f, 0x00, 0x00, 0x01, 0x01, 0x18, 0x00, 0x10, 0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+		}, {
+			"Thresholds/RTT",
+			ie.NewThresholds(0x01, 0x0102, 0),
+			[]byte{0x01, 0x20, 0x00, 0x03, 0x01, 0x01, 0x02},
+		}, {
+			"Thresholds/Both",
+			ie.NewThresholds(0x03, 0x0102, 50),
+			[]byte{0x01, 0x20, 0x00, 0x04, 0x03, 0x01, 0x02, 0x32},
+		}, {
+			"IPAddressAndPortNumberReplacement/Destination",
+			ie.NewIPAddressAndPortNumberReplacement(net.ParseIP("127.0.0.1"), 8080, nil, 0),
+			[]byte{0x01, 0x25, 0x00, 0x07, 0x05, 0x7f, 0x00, 0x00, 0x01, 0x1f, 0x90},
+		}, {
+			"IPAddressAndPortNumberReplacement/Both",
+			ie.NewIPAddressAndPortNumberReplacement(net.ParseIP("127.0.0.1"), 8080, net.ParseIP("2001::1"), 2152),
+			[]byte{0x01, 0x25, 0x00, 0x19, 0x35, 0x7f, 0x00, 0x00, 0x01, 0x1f, 0x90, 0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x08, 0x68},
+		}, {
+			"PFCPSessionChangeInfo",
+			ie.NewPFCPSessionChangeInfo(
+				ie.NewGroupID("go-pfcp"),
+				ie.NewCPIPAddress(net.ParseIP("127.0.0.1"), nil),
+			),
+			[]byte{0x01, 0x22, 0x00, 0x14, 0x01, 0x23, 0x00, 0x07, 0x67, 0x6f, 0x2d, 0x70, 0x66, 0x63, 0x70, 0x01, 0x24, 0x00, 0x05, 0x02, 0x7f, 0x00, 0x00, 0x01},
+		}, {
+			"DirectReportingInformation",
+			ie.NewDirectReportingInformation(
+				ie.NewEventNotificationURI("https://example.com/notify"),
+				ie.NewNotificationCorrelationID("n1"),
+				ie.NewReportingFlags(0x01),
+			),
+			[]byte{0x01, 0x27, 0x00, 0x29, 0x01, 0x28, 0x00, 0x1a, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x01, 0x29, 0x00, 0x02, 0x6e, 0x31, 0x01, 0x2a, 0x00, 0x01, 0x01},
+		}, {
+			"MBSSessionN4ControlInformation",
+			ie.NewMBSSessionN4ControlInformation(
+				ie.NewMBSSessionIdentifier([]byte{0x11, 0x22, 0x33, 0x44, 0x55, 0x66}, nil, nil, nil),
+				ie.NewAreaSessionID(1),
+			),
+			[]byte{0x01, 0x36, 0x00, 0x11, 0x01, 0x31, 0x00, 0x07, 0x01, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x01, 0x3a, 0x00, 0x02, 0x00, 0x01},
+		}, {
+			"MBSSessionIdentifier/All",
+			ie.NewMBSSessionIdentifier([]byte{0x11, 0x22, 0x33, 0x44, 0x55, 0x66}, net.ParseIP("127.0.0.1"), net.ParseIP("232.0.0.1"), []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}),
+			[]byte{0x01, 0x31, 0x00, 0x17, 0x07, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x04, 0x7f, 0x00, 0x00, 0x01, 0x04, 0xe8, 0x00, 0x00, 0x01, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06},
+		}, {
+			"MulticastTransportInformation/IPv4",
+			ie.NewMulticastTransportInformation(0x11111111, net.ParseIP("232.0.0.1"), net.ParseIP("127.0.0.1")),
+			[]byte{0x01, 0x32, 0x00, 0x0f, 0x00, 0x11, 0x11, 0x11, 0x11, 0x04, 0xe8, 0x00, 0x00, 0x01, 0x04, 0x7f, 0x00, 0x00, 0x01},
+		}, {
+			"MulticastTransportInformation/IPv6",
+			ie.NewMulticastTransportInformation(0x11111111, net.ParseIP("ff3e::1"), net.ParseIP("2001::1")),
+			[]byte{0x01, 0x32, 0x00, 0x27, 0x00, 0x11, 0x11, 0x11, 0x11, 0x50, 0xff, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x50, 0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+		}, {
+			"MPQUICAddressInformation",
+			ie.NewMPQUICAddressInformation(8443, net.ParseIP("127.0.0.1"), net.ParseIP("2001::1")),
+			[]byte{0x01, 0x4c, 0x00, 0x17, 0x03, 0x20, 0xfb, 0x7f, 0x00, 0x00, 0x01, 0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+		}, {
+			"MappedN6IPAddress/V4",
+			ie.NewMappedN6IPAddress(0x01, net.ParseIP("127.0.0.1")),
+			[]byte{0x01, 0x5e, 0x00, 0x05, 0x01, 0x7f, 0x00, 0x00, 0x01},
+		}, {
+			"MappedN6IPAddress/CHV4",
+			ie.NewMappedN6IPAddress(0x02, nil),
+			[]byte{0x01, 0x5e, 0x00, 0x01, 0x02},
+		}, {
+			"N6RoutingInformation",
+			ie.NewN6RoutingInformation(net.ParseIP("127.0.0.1"), 8080, net.ParseIP("2001::1"), 443),
+			[]byte{0x01, 0x5f, 0x00, 0x19, 0x35, 0x7f, 0x00, 0x00, 0x01, 0x1f, 0x90, 0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x01, 0xbb},
+		}, {
+			"L2TPUserAuthentication/All",
+			ie.NewL2TPUserAuthentication(0x0f, 0x02, "go", []byte{0x01, 0x02}, []byte{0x03, 0x04}, 0x05),
+			[]byte{0x01, 0x16, 0x00, 0x0c, 0x0f, 0x02, 0x02, 0x67, 0x6f, 0x02, 0x01, 0x02, 0x02, 0x03, 0x04, 0x05},
+		}, {
+			"L2TPUserAuthentication/TypeOnly",
+			ie.NewL2TPUserAuthentication(0x00, 0x04, "", nil, nil, 0),
+			[]byte{0x01, 0x16, 0x00, 0x02, 0x00, 0x04},
+		}, {
+			"NotificationCorrelationID",
+			ie.NewNotificationCorrelationID("corr-1"),
+			[]byte{0x01, 0x29, 0x00, 0x06, 0x63, 0x6f, 0x72, 0x72, 0x2d, 0x31},
+		}, {
+			"Metadata",
+			ie.NewMetadata("bWV0YQ=="),
+			[]byte{0x01, 0x42, 0x00, 0x08, 0x62, 0x57, 0x56, 0x30, 0x59, 0x51, 0x3d, 0x3d},
+		}, {
+			"TrafficParameterThreshold",
+			ie.NewTrafficParameterThreshold(0x01, 30),
+			[]byte{0x01, 0x45, 0x00, 0x05, 0x01, 0x00, 0x00, 0x00, 0x1e},
+		}, {
+			"N6JitterMeasurement",
+			ie.NewN6JitterMeasurement(20, -2, 3),
+			[]byte{0x01, 0x47, 0x00, 0x0c, 0x00, 0x00, 0x00, 0x14, 0xff, 0xff, 0xff, 0xfe, 0x00, 0x00, 0x00, 0x03},
+		}, {
+			"ProtocolDescription",
+			ie.NewProtocolDescription(0x07, ie.MediaTransportProtocolRTP, 1, 2, []uint8{96, 97}),
+			[]byte{0x01, 0x4e, 0x00, 0x07, 0x07, 0x01, 0x01, 0x02, 0x02, 0x60, 0x61},
+		}, {
+			"ReportingSuggestionInfo/DelayTolerant",
+			ie.NewReportingSuggestionInfo(0, 60),
+			[]byte{0x01, 0x4f, 0x00, 0x05, 0x00, 0x00, 0x00, 0x00, 0x3c},
+		}, {
+			"ReportingSuggestionInfo/NonDelayTolerant",
+			ie.NewReportingSuggestionInfo(1, 0),
+			[]byte{0x01, 0x4f, 0x00, 0x01, 0x01},
+		}, {
+			"TLContainer",
+			ie.NewTLContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+			[]byte{0x01, 0x50, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef},
+		}, {
+			"ReportingThresholds",
+			ie.NewReportingThresholds(0x0f, 1000, 2000, 50, 60),
+			[]byte{0x01, 0x5c, 0x00, 0x0d, 0x0f, 0x00, 0x00, 0x00, 0x03, 0xe8, 0x00, 0x00, 0x00, 0x07, 0xd0, 0x32, 0x3c},
+		}, {
+			"RTPHeaderExtensionAdditionalInformation",
+			ie.NewRTPHeaderExtensionAdditionalInformation(0x01),
+			[]byte{0x01, 0x5d, 0x00, 0x01, 0x01},
+		}, {
+			"LocalIngressTunnel/IPv4",
+			ie.NewLocalIngressTunnel(0x01, 2152, net.ParseIP("127.0.0.1"), nil),
+			[]byte{0x01, 0x34, 0x00, 0x07, 0x01, 0x08, 0x68, 0x7f, 0x00, 0x00, 0x01},
+		}, {
+			"LocalIngressTunnel/CH",
+			ie.NewLocalIngressTunnel(0x04, 0, nil, nil),
+			[]byte{0x01, 0x34, 0x00, 0x01, 0x04},
+		}, {
+			"DSCPToPPIControlInformation",
+			ie.NewDSCPToPPIControlInformation(
+				ie.NewDSCPToPPIMappingInformation(0x05, 0x2e, 0x0a),
+				ie.NewQFI(0x09),
+			),
+			[]byte{0x01, 0x3c, 0x00, 0x0c, 0x01, 0x3d, 0x00, 0x03, 0x05, 0x2e, 0x0a, 0x00, 0x7c, 0x00, 0x01, 0x09},
+		}, {
+			"VendorSpecificNodeReportType",
+			ie.NewVendorSpecificNodeReportType(10415, 0x01),
+			[]byte{0x01, 0x40, 0x00, 0x03, 0x28, 0xaf, 0x01},
+		}, {
+			"HPLMNSNSSAI",
+			ie.NewHPLMNSNSSAI(1, 0x010203),
+			[]byte{0x01, 0x52, 0x00, 0x04, 0x01, 0x01, 0x02, 0x03},
 		},
 	}
 
@@ -3647,6 +3817,154 @@ func TestIEAddRemove(t *testing.T) {
 	}
 }
 
+func TestIEMutation(t *testing.T) {
+	newPDR := func(fteid *ie.IE, extra ...*ie.IE) *ie.IE {
+		pdi := ie.NewPDI(append([]*ie.IE{ie.NewSourceInterface(ie.SrcInterfaceAccess), fteid}, extra...)...)
+		return ie.NewCreatePDR(ie.NewPDRID(1), ie.NewPrecedence(100), pdi)
+	}
+	v4 := ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0)
+	dual := ie.NewFTEID(0x03, 0x11111111, net.ParseIP("127.0.0.1"), net.ParseIP("2001::1"), 0)
+
+	serialized, err := newPDR(v4).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	original := append([]byte(nil), serialized...)
+
+	i, err := ie.Parse(serialized)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(t *testing.T, want *ie.IE) {
+		t.Helper()
+
+		opt := cmp.AllowUnexported(*i, *want)
+		if diff := cmp.Diff(i, want, opt); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err := i.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantBytes, err := want.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, wantBytes); diff != "" {
+			t.Error(diff)
+		}
+	}
+
+	t.Run("ReplaceAt", func(t *testing.T) {
+		if err := i.ReplaceAt(dual, 2, 1); err != nil {
+			t.Fatal(err)
+		}
+		check(t, newPDR(dual))
+
+		pdi, err := i.PDI()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(pdi[1], dual); diff != "" {
+			t.Error(diff)
+		}
+		if pdi[1] != dual {
+			t.Error("PDI should return the ChildIEs instead of parsing the Payload again")
+		}
+		if diff := cmp.Diff(serialized, original); diff != "" {
+			t.Errorf("the buffer given to Parse should not be modified: %s", diff)
+		}
+	})
+
+	t.Run("InsertAt", func(t *testing.T) {
+		if err := i.InsertAt(ie.NewNetworkInstance("some.instance.example"), 2, 2); err != nil {
+			t.Fatal(err)
+		}
+		check(t, newPDR(dual, ie.NewNetworkInstance("some.instance.example")))
+	})
+
+	t.Run("RemoveAt", func(t *testing.T) {
+		if err := i.RemoveAt(2, 2); err != nil {
+			t.Fatal(err)
+		}
+		check(t, newPDR(dual))
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		if err := i.Replace(ie.NewPrecedence(100)); err != nil {
+			t.Fatal(err)
+		}
+		check(t, newPDR(dual))
+
+		if err := i.Replace(ie.NewFARID(1)); !errors.Is(err, ie.ErrIENotFound) {
+			t.Errorf("got %v want %v", err, ie.ErrIENotFound)
+		}
+	})
+
+	t.Run("Refresh", func(t *testing.T) {
+		pdi, err := i.ChildAt(2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pdi.ChildIEs[1] = v4
+		i.Refresh()
+		check(t, newPDR(v4))
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		if _, err := i.ChildAt(); !errors.Is(err, ie.ErrIENotFound) {
+			t.Errorf("got %v want %v", err, ie.ErrIENotFound)
+		}
+		if _, err := i.ChildAt(3); !errors.Is(err, ie.ErrIENotFound) {
+			t.Errorf("got %v want %v", err, ie.ErrIENotFound)
+		}
+		if err := i.InsertAt(ie.NewFARID(1), 0, 0); err == nil {
+			t.Error("expected error for inserting into non-grouped IE")
+		}
+		if err := i.RemoveAt(2, 5); !errors.Is(err, ie.ErrIENotFound) {
+			t.Errorf("got %v want %v", err, ie.ErrIENotFound)
+		}
+	})
+}
+
+func TestIEClone(t *testing.T) {
+	serialized, err := ie.NewCreatePDR(
+		ie.NewPDRID(1),
+		ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceAccess)),
+	).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := append([]byte(nil), serialized...)
+
+	i, err := ie.Parse(serialized)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := i.Clone()
+
+	opt := cmp.AllowUnexported(*i, *c)
+	if diff := cmp.Diff(c, i, opt); diff != "" {
+		t.Error(diff)
+	}
+
+	for n := range serialized {
+		serialized[n] = 0
+	}
+	got, err := c.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+	if pdi, err := c.PDI(); err != nil || len(pdi) != 1 {
+		t.Errorf("unexpected PDI in clone: %v, %v", pdi, err)
+	}
+}
+
 func TestMalformedIEs(t *testing.T) {
 	serialized := []byte{0x00, 0x00, 0x00, 0x02, 0x00}
 	got, err := ie.Parse(serialized)
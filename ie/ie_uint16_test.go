@@ -269,6 +269,63 @@ func TestUint16IEs(t *testing.T) {
 			),
 			decoded:     0x1111,
 			decoderFunc: func(i *ie.IE) (uint16, error) { return i.TransportLevelMarking() },
+		}, {
+			description: "MaximumReceiveUnit",
+			structured:  ie.NewMaximumReceiveUnit(0xffff),
+			decoded:     0xffff,
+			decoderFunc: func(i *ie.IE) (uint16, error) { return i.MaximumReceiveUnit() },
+		}, {
+			description: "MaximumReceiveUnit/L2TPSessionInformation",
+			structured: ie.NewL2TPSessionInformation(
+				ie.NewMaximumReceiveUnit(0xffff),
+			),
+			decoded:     0xffff,
+			decoderFunc: func(i *ie.IE) (uint16, error) { return i.MaximumReceiveUnit() },
+		}, {
+			description: "MBSUnicastParametersID",
+			structured:  ie.NewMBSUnicastParametersID(0xffff),
+			decoded:     0xffff,
+			decoderFunc: func(i *ie.IE) (uint16, error) { return i.MBSUnicastParametersID() },
+		}, {
+			description: "MBSUnicastParametersID/AddMBSUnicastParameters",
+			structured: ie.NewAddMBSUnicastParameters(
+				ie.NewMBSUnicastParametersID(0xffff),
+			),
+			decoded:     0xffff,
+			decoderFunc: func(i *ie.IE) (uint16, error) { return i.MBSUnicastParametersID() },
+		}, {
+			description: "MBSUnicastParametersID/RemoveMBSUnicastParameters",
+			structured: ie.NewRemoveMBSUnicastParameters(
+				ie.NewMBSUnicastParametersID(0xffff),
+			),
+			decoded:     0xffff,
+			decoderFunc: func(i *ie.IE) (uint16, error) { return i.MBSUnicastParametersID() },
+		}, {
+			description: "AreaSessionID",
+			structured:  ie.NewAreaSessionID(0xffff),
+			decoded:     0xffff,
+			decoderFunc: func(i *ie.IE) (uint16, error) { return i.AreaSessionID() },
+		}, {
+			description: "AreaSessionID/MBSSessionN4mbControlInformation",
+			structured: ie.NewMBSSessionN4mbControlInformation(
+				ie.NewAreaSessionID(0xffff),
+			),
+			decoded:     0xffff,
+			decoderFunc: func(i *ie.IE) (uint16, error) { return i.AreaSessionID() },
+		}, {
+			description: "AreaSessionID/MBSSessionN4ControlInformation",
+			structured: ie.NewMBSSessionN4ControlInformation(
+				ie.NewAreaSessionID(0xffff),
+			),
+			decoded:     0xffff,
+			decoderFunc: func(i *ie.IE) (uint16, error) { return i.AreaSessionID() },
+		}, {
+			description: "AreaSessionID/MBSSessionN4Information",
+			structured: ie.NewMBSSessionN4Information(
+				ie.NewAreaSessionID(0xffff),
+			),
+			decoded:     0xffff,
+			decoderFunc: func(i *ie.IE) (uint16, error) { return i.AreaSessionID() },
 		},
 	}
 
@@ -393,6 +393,42 @@ func TestUint32IEs(t *testing.T) {
 			),
 			decoded:     0x223344,
 			decoderFunc: func(i *ie.IE) (uint32, error) { return i.SD() },
+		}, {
+			description: "TunnelPreference",
+			structured:  ie.NewTunnelPreference(0xffffffff),
+			decoded:     0xffffffff,
+			decoderFunc: func(i *ie.IE) (uint32, error) { return i.TunnelPreference() },
+		}, {
+			description: "TunnelPreference/L2TPTunnelInformation",
+			structured: ie.NewL2TPTunnelInformation(
+				ie.NewTunnelPreference(0xffffffff),
+			),
+			decoded:     0xffffffff,
+			decoderFunc: func(i *ie.IE) (uint32, error) { return i.TunnelPreference() },
+		}, {
+			description: "DLPeriodicity",
+			structured:  ie.NewDLPeriodicity(0xffffffff),
+			decoded:     0xffffffff,
+			decoderFunc: func(i *ie.IE) (uint32, error) { return i.DLPeriodicity() },
+		}, {
+			description: "DLPeriodicity/TrafficParameterMeasurementReport",
+			structured: ie.NewTrafficParameterMeasurementReport(
+				ie.NewDLPeriodicity(0xffffffff),
+			),
+			decoded:     0xffffffff,
+			decoderFunc: func(i *ie.IE) (uint32, error) { return i.DLPeriodicity() },
+		}, {
+			description: "ULPeriodicity",
+			structured:  ie.NewULPeriodicity(0xffffffff),
+			decoded:     0xffffffff,
+			decoderFunc: func(i *ie.IE) (uint32, error) { return i.ULPeriodicity() },
+		}, {
+			description: "ULPeriodicity/TrafficParameterMeasurementReport",
+			structured: ie.NewTrafficParameterMeasurementReport(
+				ie.NewULPeriodicity(0xffffffff),
+			),
+			decoded:     0xffffffff,
+			decoderFunc: func(i *ie.IE) (uint32, error) { return i.ULPeriodicity() },
 		},
 	}
 
@@ -29,12 +29,12 @@ func TestUint8IEs(t *testing.T) {
 			description: "AccessAvailabilityInformation/AvailabilityStatus",
 			structured:  ie.NewAccessAvailabilityInformation(3, 3),
 			decoded:     3,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.AvailabilityStatus() },
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.AvailabilityStatus(); return uint8(v), err },
 		}, {
 			description: "AccessAvailabilityInformation/AccessType",
 			structured:  ie.NewAccessAvailabilityInformation(3, 3),
 			decoded:     3,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.AccessType() },
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.AccessType(); return uint8(v), err },
 		}, {
 			description: "AccessAvailabilityInformation/AccessAvailabilityReport",
 			structured: ie.NewAccessAvailabilityReport(
@@ -138,8 +138,8 @@ func TestUint8IEs(t *testing.T) {
 		}, {
 			description: "Cause",
 			structured:  ie.NewCause(ie.CauseRequestAccepted),
-			decoded:     ie.CauseRequestAccepted,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.Cause() },
+			decoded:     uint8(ie.CauseRequestAccepted),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.Cause(); return uint8(v), err },
 		}, {
 			description: "CreateBridgeInfoForTSC",
 			structured:  ie.NewCreateBridgeInfoForTSC(1),
@@ -148,8 +148,8 @@ func TestUint8IEs(t *testing.T) {
 		}, {
 			description: "DestinationInterface",
 			structured:  ie.NewDestinationInterface(ie.DstInterfaceAccess),
-			decoded:     ie.DstInterfaceAccess,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.DestinationInterface() },
+			decoded:     uint8(ie.DstInterfaceAccess),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.DestinationInterface(); return uint8(v), err },
 		}, {
 			description: "DataStatus",
 			structured:  ie.NewDataStatus(0x03),
@@ -170,7 +170,7 @@ func TestUint8IEs(t *testing.T) {
 				ie.NewNetworkInstance("some.instance.example"),
 			),
 			decoded:     0xff,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.DestinationInterface() },
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.DestinationInterface(); return uint8(v), err },
 		}, {
 			description: "DestinationInterface/UpdateForwardingParameters",
 			structured: ie.NewUpdateForwardingParameters(
@@ -178,7 +178,7 @@ func TestUint8IEs(t *testing.T) {
 				ie.NewNetworkInstance("some.instance.example"),
 			),
 			decoded:     0xff,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.DestinationInterface() },
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.DestinationInterface(); return uint8(v), err },
 		}, {
 			description: "DestinationInterface/DuplicatingParameters",
 			structured: ie.NewDuplicatingParameters(
@@ -186,7 +186,7 @@ func TestUint8IEs(t *testing.T) {
 				ie.NewNetworkInstance("some.instance.example"),
 			),
 			decoded:     0xff,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.DestinationInterface() },
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.DestinationInterface(); return uint8(v), err },
 		}, {
 			description: "DestinationInterface/UpdateDuplicatingParameters",
 			structured: ie.NewUpdateDuplicatingParameters(
@@ -194,7 +194,7 @@ func TestUint8IEs(t *testing.T) {
 				ie.NewNetworkInstance("some.instance.example"),
 			),
 			decoded:     0xff,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.DestinationInterface() },
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.DestinationInterface(); return uint8(v), err },
 		}, {
 			description: "DLBufferingSuggestedPacketCount",
 			structured:  ie.NewDLBufferingSuggestedPacketCount(0xff),
@@ -258,30 +258,30 @@ func TestUint8IEs(t *testing.T) {
 		}, {
 			description: "FailedRuleID/RuleIDType",
 			structured:  ie.NewFailedRuleID(ie.RuleIDTypePDR, 0xffff),
-			decoded:     ie.RuleIDTypePDR,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.RuleIDType() },
+			decoded:     uint8(ie.RuleIDTypePDR),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.RuleIDType(); return uint8(v), err },
 		}, {
 			description: "FlowInformation/FlowDirection",
 			structured:  ie.NewFlowInformation(ie.FlowDirectionDownlink, "go-pfcp"),
-			decoded:     ie.FlowDirectionDownlink,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.FlowDirection() },
+			decoded:     uint8(ie.FlowDirectionDownlink),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.FlowDirection(); return uint8(v), err },
 		}, {
 			description: "ApplicationDetectionInformation/FlowDirection",
 			structured: ie.NewApplicationDetectionInformation(
 				ie.NewPDRID(0xffff),
 				ie.NewFlowInformation(ie.FlowDirectionDownlink, "go-pfcp"),
 			),
-			decoded:     ie.FlowDirectionDownlink,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.FlowDirection() },
+			decoded:     uint8(ie.FlowDirectionDownlink),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.FlowDirection(); return uint8(v), err },
 		}, {
 			description: "FQCSID/NodeIDType/IPv4",
 			structured:  ie.NewFQCSID("127.0.0.1", 1),
-			decoded:     ie.NodeIDIPv4Address,
+			decoded:     uint8(ie.NodeIDIPv4Address),
 			decoderFunc: func(i *ie.IE) (uint8, error) { return i.NodeIDType() },
 		}, {
 			description: "FQCSID/NodeIDType/IPv6",
 			structured:  ie.NewFQCSID("2001::1", 1),
-			decoded:     ie.NodeIDIPv6Address,
+			decoded:     uint8(ie.NodeIDIPv6Address),
 			decoderFunc: func(i *ie.IE) (uint8, error) { return i.NodeIDType() },
 		}, {
 			description: "GateStatus/OpenOpen",
@@ -306,43 +306,43 @@ func TestUint8IEs(t *testing.T) {
 		}, {
 			description: "GateStatus/OpenOpen/GateStatusUL",
 			structured:  ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen),
-			decoded:     ie.GateStatusOpen,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.GateStatusUL() },
+			decoded:     uint8(ie.GateStatusOpen),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.GateStatusUL(); return uint8(v), err },
 		}, {
 			description: "GateStatus/OpenOpen/GateStatusDL",
 			structured:  ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen),
-			decoded:     ie.GateStatusOpen,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.GateStatusDL() },
+			decoded:     uint8(ie.GateStatusOpen),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.GateStatusDL(); return uint8(v), err },
 		}, {
 			description: "GateStatus/OpenClosed/GateStatusUL",
 			structured:  ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusClosed),
-			decoded:     ie.GateStatusOpen,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.GateStatusUL() },
+			decoded:     uint8(ie.GateStatusOpen),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.GateStatusUL(); return uint8(v), err },
 		}, {
 			description: "GateStatus/OpenClosed/GateStatusDL",
 			structured:  ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusClosed),
-			decoded:     ie.GateStatusClosed,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.GateStatusDL() },
+			decoded:     uint8(ie.GateStatusClosed),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.GateStatusDL(); return uint8(v), err },
 		}, {
 			description: "GateStatus/ClosedOpen/GateStatusUL",
 			structured:  ie.NewGateStatus(ie.GateStatusClosed, ie.GateStatusOpen),
-			decoded:     ie.GateStatusClosed,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.GateStatusUL() },
+			decoded:     uint8(ie.GateStatusClosed),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.GateStatusUL(); return uint8(v), err },
 		}, {
 			description: "GateStatus/ClosedOpen/GateStatusDL",
 			structured:  ie.NewGateStatus(ie.GateStatusClosed, ie.GateStatusOpen),
-			decoded:     ie.GateStatusOpen,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.GateStatusDL() },
+			decoded:     uint8(ie.GateStatusOpen),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.GateStatusDL(); return uint8(v), err },
 		}, {
 			description: "GateStatus/ClosedClosed/GateStatusUL",
 			structured:  ie.NewGateStatus(ie.GateStatusClosed, ie.GateStatusClosed),
-			decoded:     ie.GateStatusClosed,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.GateStatusUL() },
+			decoded:     uint8(ie.GateStatusClosed),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.GateStatusUL(); return uint8(v), err },
 		}, {
 			description: "GateStatus/ClosedClosed/GateStatusDL",
 			structured:  ie.NewGateStatus(ie.GateStatusClosed, ie.GateStatusClosed),
-			decoded:     ie.GateStatusClosed,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.GateStatusDL() },
+			decoded:     uint8(ie.GateStatusClosed),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.GateStatusDL(); return uint8(v), err },
 		}, {
 			description: "GateStatus/OpenOpen/CreateQER",
 			structured: ie.NewCreateQER(
@@ -482,7 +482,7 @@ func TestUint8IEs(t *testing.T) {
 			description: "NodeReportType",
 			structured:  ie.NewNodeReportType(0x01),
 			decoded:     0x01,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.NodeReportType() },
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.NodeReportType(); return uint8(v), err },
 		}, {
 			description: "OCIFlags",
 			structured:  ie.NewOCIFlags(0x01),
@@ -500,7 +500,7 @@ func TestUint8IEs(t *testing.T) {
 			description: "OuterHeaderRemoval/OuterHeaderRemovalDescription",
 			structured:  ie.NewOuterHeaderRemoval(0x01, 0x02),
 			decoded:     0x01,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.OuterHeaderRemovalDescription() },
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.OuterHeaderRemovalDescription(); return uint8(v), err },
 		}, {
 			description: "OuterHeaderRemoval/GTPUExtensionHeaderDeletion",
 			structured:  ie.NewOuterHeaderRemoval(0x01, 0x02),
@@ -543,8 +543,8 @@ func TestUint8IEs(t *testing.T) {
 		}, {
 			description: "PDNType",
 			structured:  ie.NewPDNType(ie.PDNTypeIPv4),
-			decoded:     ie.PDNTypeIPv4,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.PDNType() },
+			decoded:     uint8(ie.PDNTypeIPv4),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.PDNType(); return uint8(v), err },
 		}, {
 			description: "PFCPAssociationReleaseRequest",
 			structured:  ie.NewPFCPAssociationReleaseRequest(1, 1),
@@ -591,8 +591,8 @@ func TestUint8IEs(t *testing.T) {
 		}, {
 			description: "Priority",
 			structured:  ie.NewPriority(ie.PriorityActive),
-			decoded:     ie.PriorityActive,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.Priority() },
+			decoded:     uint8(ie.PriorityActive),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.Priority(); return uint8(v), err },
 		}, {
 			description: "Priority/CreateMAR",
 			structured: ie.NewCreateMAR(
@@ -604,8 +604,8 @@ func TestUint8IEs(t *testing.T) {
 					ie.NewURRID(0xffffffff),
 				),
 			),
-			decoded:     ie.PriorityActive,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.Priority() },
+			decoded:     uint8(ie.PriorityActive),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.Priority(); return uint8(v), err },
 		}, {
 			description: "Priority/UpdateMAR",
 			structured: ie.NewUpdateMAR(
@@ -617,40 +617,40 @@ func TestUint8IEs(t *testing.T) {
 					ie.NewURRID(0xffffffff),
 				),
 			),
-			decoded:     ie.PriorityActive,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.Priority() },
+			decoded:     uint8(ie.PriorityActive),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.Priority(); return uint8(v), err },
 		}, {
 			description: "Priority/TGPPAccessForwardingActionInformation",
 			structured: ie.NewTGPPAccessForwardingActionInformation(
 				ie.NewFARID(0xffffffff),
 				ie.NewPriority(ie.PriorityActive),
 			),
-			decoded:     ie.PriorityActive,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.Priority() },
+			decoded:     uint8(ie.PriorityActive),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.Priority(); return uint8(v), err },
 		}, {
 			description: "Priority/NonTGPPAccessForwardingActionInformation",
 			structured: ie.NewNonTGPPAccessForwardingActionInformation(
 				ie.NewFARID(0xffffffff),
 				ie.NewPriority(ie.PriorityActive),
 			),
-			decoded:     ie.PriorityActive,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.Priority() },
+			decoded:     uint8(ie.PriorityActive),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.Priority(); return uint8(v), err },
 		}, {
 			description: "Priority/UpdateTGPPAccessForwardingActionInformation",
 			structured: ie.NewUpdateTGPPAccessForwardingActionInformation(
 				ie.NewFARID(0xffffffff),
 				ie.NewPriority(ie.PriorityActive),
 			),
-			decoded:     ie.PriorityActive,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.Priority() },
+			decoded:     uint8(ie.PriorityActive),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.Priority(); return uint8(v), err },
 		}, {
 			description: "Priority/UpdateNonTGPPAccessForwardingActionInformation",
 			structured: ie.NewUpdateNonTGPPAccessForwardingActionInformation(
 				ie.NewFARID(0xffffffff),
 				ie.NewPriority(ie.PriorityActive),
 			),
-			decoded:     ie.PriorityActive,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.Priority() },
+			decoded:     uint8(ie.PriorityActive),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.Priority(); return uint8(v), err },
 		}, {
 			description: "Proxying",
 			structured:  ie.NewProxying(1, 1),
@@ -858,8 +858,8 @@ func TestUint8IEs(t *testing.T) {
 		}, {
 			description: "SourceInterface",
 			structured:  ie.NewSourceInterface(ie.SrcInterfaceAccess),
-			decoded:     ie.SrcInterfaceAccess,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.SourceInterface() },
+			decoded:     uint8(ie.SrcInterfaceAccess),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.SourceInterface(); return uint8(v), err },
 		}, {
 			description: "SourceInterface/CreatePDR",
 			structured: ie.NewCreatePDR(
@@ -868,8 +868,8 @@ func TestUint8IEs(t *testing.T) {
 					ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
 				),
 			),
-			decoded:     ie.SrcInterfaceAccess,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.SourceInterface() },
+			decoded:     uint8(ie.SrcInterfaceAccess),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.SourceInterface(); return uint8(v), err },
 		}, {
 			description: "SourceInterface/UpdatePDR",
 			structured: ie.NewUpdatePDR(
@@ -878,16 +878,16 @@ func TestUint8IEs(t *testing.T) {
 					ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
 				),
 			),
-			decoded:     ie.SrcInterfaceAccess,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.SourceInterface() },
+			decoded:     uint8(ie.SrcInterfaceAccess),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.SourceInterface(); return uint8(v), err },
 		}, {
 			description: "SourceInterface/PDI",
 			structured: ie.NewPDI(
 				ie.NewSourceInterface(ie.SrcInterfaceAccess),
 				ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
 			),
-			decoded:     ie.SrcInterfaceAccess,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.SourceInterface() },
+			decoded:     uint8(ie.SrcInterfaceAccess),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.SourceInterface(); return uint8(v), err },
 		}, {
 			description: "SRRID",
 			structured:  ie.NewSRRID(255),
@@ -933,45 +933,45 @@ func TestUint8IEs(t *testing.T) {
 		}, {
 			description: "SteeringFunctionality",
 			structured:  ie.NewSteeringFunctionality(ie.SteeringFunctionalityATSSSLL),
-			decoded:     ie.SteeringFunctionalityATSSSLL,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.SteeringFunctionality() },
+			decoded:     uint8(ie.SteeringFunctionalityATSSSLL),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.SteeringFunctionality(); return uint8(v), err },
 		}, {
 			description: "SteeringFunctionality/CreateMAR",
 			structured: ie.NewCreateMAR(
 				ie.NewMARID(0x1111),
 				ie.NewSteeringFunctionality(ie.SteeringFunctionalityATSSSLL),
 			),
-			decoded:     ie.SteeringFunctionalityATSSSLL,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.SteeringFunctionality() },
+			decoded:     uint8(ie.SteeringFunctionalityATSSSLL),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.SteeringFunctionality(); return uint8(v), err },
 		}, {
 			description: "SteeringFunctionality/UpdateMAR",
 			structured: ie.NewUpdateMAR(
 				ie.NewMARID(0x1111),
 				ie.NewSteeringFunctionality(ie.SteeringFunctionalityATSSSLL),
 			),
-			decoded:     ie.SteeringFunctionalityATSSSLL,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.SteeringFunctionality() },
+			decoded:     uint8(ie.SteeringFunctionalityATSSSLL),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.SteeringFunctionality(); return uint8(v), err },
 		}, {
 			description: "SteeringMode",
 			structured:  ie.NewSteeringMode(ie.SteeringModeActiveStandby),
-			decoded:     ie.SteeringModeActiveStandby,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.SteeringMode() },
+			decoded:     uint8(ie.SteeringModeActiveStandby),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.SteeringMode(); return uint8(v), err },
 		}, {
 			description: "SteeringMode/CreateMAR",
 			structured: ie.NewCreateMAR(
 				ie.NewMARID(0x1111),
 				ie.NewSteeringMode(ie.SteeringModeActiveStandby),
 			),
-			decoded:     ie.SteeringModeActiveStandby,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.SteeringMode() },
+			decoded:     uint8(ie.SteeringModeActiveStandby),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.SteeringMode(); return uint8(v), err },
 		}, {
 			description: "SteeringMode/UpdateMAR",
 			structured: ie.NewUpdateMAR(
 				ie.NewMARID(0x1111),
 				ie.NewSteeringMode(ie.SteeringModeActiveStandby),
 			),
-			decoded:     ie.SteeringModeActiveStandby,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.SteeringMode() },
+			decoded:     uint8(ie.SteeringModeActiveStandby),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.SteeringMode(); return uint8(v), err },
 		}, {
 			description: "SuggestedBufferingPacketsCount",
 			structured:  ie.NewSuggestedBufferingPacketsCount(0x01),
@@ -1007,24 +1007,24 @@ func TestUint8IEs(t *testing.T) {
 		}, {
 			description: "TGPPInterfaceType",
 			structured:  ie.NewTGPPInterfaceType(ie.TGPPInterfaceTypeS1U),
-			decoded:     ie.TGPPInterfaceTypeS1U,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.TGPPInterfaceType() },
+			decoded:     uint8(ie.TGPPInterfaceTypeS1U),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.TGPPInterfaceType(); return uint8(v), err },
 		}, {
 			description: "TGPPInterfaceType/ForwardingParameters",
 			structured: ie.NewForwardingParameters(
 				ie.NewDestinationInterface(ie.DstInterfaceAccess),
 				ie.NewTGPPInterfaceType(ie.TGPPInterfaceTypeS1U),
 			),
-			decoded:     ie.TGPPInterfaceTypeS1U,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.TGPPInterfaceType() },
+			decoded:     uint8(ie.TGPPInterfaceTypeS1U),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.TGPPInterfaceType(); return uint8(v), err },
 		}, {
 			description: "TGPPInterfaceType/UpdateForwardingParameters",
 			structured: ie.NewUpdateForwardingParameters(
 				ie.NewDestinationInterface(ie.DstInterfaceAccess),
 				ie.NewTGPPInterfaceType(ie.TGPPInterfaceTypeS1U),
 			),
-			decoded:     ie.TGPPInterfaceTypeS1U,
-			decoderFunc: func(i *ie.IE) (uint8, error) { return i.TGPPInterfaceType() },
+			decoded:     uint8(ie.TGPPInterfaceTypeS1U),
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.TGPPInterfaceType(); return uint8(v), err },
 		}, {
 			description: "TrafficEndpointID",
 			structured:  ie.NewTrafficEndpointID(0x01),
@@ -1205,6 +1205,162 @@ func TestUint8IEs(t *testing.T) {
 			),
 			decoded:     0x01,
 			decoderFunc: func(i *ie.IE) (uint8, error) { return i.Weight() },
+		}, {
+			description: "RATType",
+			structured:  ie.NewRATType(ie.RATTypeUTRAN),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.RATType(); return uint8(v), err },
+		}, {
+			description: "L2TPSessionIndications",
+			structured:  ie.NewL2TPSessionIndications(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.L2TPSessionIndications() },
+		}, {
+			description: "L2TPSessionIndications/L2TPSessionInformation",
+			structured: ie.NewL2TPSessionInformation(
+				ie.NewL2TPSessionIndications(0x01),
+			),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.L2TPSessionIndications() },
+		}, {
+			description: "SteeringModeIndicator",
+			structured:  ie.NewSteeringModeIndicator(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.SteeringModeIndicator() },
+		}, {
+			description: "ReportingFlags",
+			structured:  ie.NewReportingFlags(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.ReportingFlags() },
+		}, {
+			description: "ReportingFlags/DirectReportingInformation",
+			structured: ie.NewDirectReportingInformation(
+				ie.NewReportingFlags(0x01),
+			),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.ReportingFlags() },
+		}, {
+			description: "MBSN4mbReqFlags",
+			structured:  ie.NewMBSN4mbReqFlags(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.MBSN4mbReqFlags() },
+		}, {
+			description: "MBSN4RespFlags",
+			structured:  ie.NewMBSN4RespFlags(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.MBSN4RespFlags() },
+		}, {
+			description: "MBSN4RespFlags/MBSSessionN4Information",
+			structured: ie.NewMBSSessionN4Information(
+				ie.NewMBSN4RespFlags(0x01),
+			),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.MBSN4RespFlags() },
+		}, {
+			description: "PFCPSDRspFlags",
+			structured:  ie.NewPFCPSDRspFlags(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.PFCPSDRspFlags() },
+		}, {
+			description: "QERIndications",
+			structured:  ie.NewQERIndications(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.QERIndications() },
+		}, {
+			description: "ConfiguredTimeDomain",
+			structured:  ie.NewConfiguredTimeDomain(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.ConfiguredTimeDomain() },
+		}, {
+			description: "TrafficParameterMeasurementIndication",
+			structured:  ie.NewTrafficParameterMeasurementIndication(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.TrafficParameterMeasurementIndication() },
+		}, {
+			description: "TrafficParameterMeasurementIndication/TrafficParameterMeasurementControlInformation",
+			structured: ie.NewTrafficParameterMeasurementControlInformation(
+				ie.NewTrafficParameterMeasurementIndication(0x01),
+			),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.TrafficParameterMeasurementIndication() },
+		}, {
+			description: "MPQUICControlInformation",
+			structured:  ie.NewMPQUICControlInformation(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.MPQUICControlInformation() },
+		}, {
+			description: "TransportMode",
+			structured:  ie.NewTransportMode(ie.TransportModeDatagram2),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.TransportMode(); return uint8(v), err },
+		}, {
+			description: "MeasurementIndication",
+			structured:  ie.NewMeasurementIndication(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.MeasurementIndication() },
+		}, {
+			description: "MediaTransportProtocol",
+			structured:  ie.NewMediaTransportProtocol(ie.MediaTransportProtocolRTP),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { v, err := i.MediaTransportProtocol(); return uint8(v), err },
+		}, {
+			description: "RTPHeaderExtensionType",
+			structured:  ie.NewRTPHeaderExtensionType(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.RTPHeaderExtensionType() },
+		}, {
+			description: "RTPHeaderExtensionType/RTPHeaderExtensionInformation",
+			structured: ie.NewRTPHeaderExtensionInformation(
+				ie.NewRTPHeaderExtensionType(0x01),
+			),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.RTPHeaderExtensionType() },
+		}, {
+			description: "RTPHeaderExtensionID",
+			structured:  ie.NewRTPHeaderExtensionID(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.RTPHeaderExtensionID() },
+		}, {
+			description: "RTPHeaderExtensionID/RTPHeaderExtensionInformation",
+			structured: ie.NewRTPHeaderExtensionInformation(
+				ie.NewRTPHeaderExtensionID(0x01),
+			),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.RTPHeaderExtensionID() },
+		}, {
+			description: "RTPPayloadType",
+			structured:  ie.NewRTPPayloadType(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.RTPPayloadType() },
+		}, {
+			description: "RTPPayloadType/RTPPayloadInformation",
+			structured: ie.NewRTPPayloadInformation(
+				ie.NewRTPPayloadType(0x01),
+			),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.RTPPayloadType() },
+		}, {
+			description: "RTPPayloadFormat",
+			structured:  ie.NewRTPPayloadFormat(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.RTPPayloadFormat() },
+		}, {
+			description: "RTPPayloadFormat/RTPPayloadInformation",
+			structured: ie.NewRTPPayloadInformation(
+				ie.NewRTPPayloadFormat(0x01),
+			),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.RTPPayloadFormat() },
+		}, {
+			description: "ExtendedDLBufferingNotificationPolicy",
+			structured:  ie.NewExtendedDLBufferingNotificationPolicy(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.ExtendedDLBufferingNotificationPolicy() },
+		}, {
+			description: "MTSDTControlInformation",
+			structured:  ie.NewMTSDTControlInformation(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.MTSDTControlInformation() },
 		},
 	}
 
@@ -0,0 +1,272 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+)
+
+// NewIPAddressAndPortNumberReplacement creates a new IPAddressAndPortNumberReplacement IE.
+//
+// Each address and port is encoded only when it is non-nil or non-zero, and the
+// corresponding flags are set automatically.
+func NewIPAddressAndPortNumberReplacement(dstIP net.IP, dstPort uint16, srcIP net.IP, srcPort uint16) *IE {
+	fields := NewIPAddressAndPortNumberReplacementFields(dstIP, dstPort, srcIP, srcPort)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(IPAddressAndPortNumberReplacement, b)
+}
+
+// IPAddressAndPortNumberReplacement returns IPAddressAndPortNumberReplacement in structured format if the type of IE matches.
+func (i *IE) IPAddressAndPortNumberReplacement() (*IPAddressAndPortNumberReplacementFields, error) {
+	switch i.Type {
+	case IPAddressAndPortNumberReplacement:
+		fields, err := ParseIPAddressAndPortNumberReplacementFields(i.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	case ForwardingParameters:
+		ies, err := i.ForwardingParameters()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == IPAddressAndPortNumberReplacement {
+				return x.IPAddressAndPortNumberReplacement()
+			}
+		}
+		return nil, ErrIENotFound
+	case UpdateForwardingParameters:
+		ies, err := i.UpdateForwardingParameters()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == IPAddressAndPortNumberReplacement {
+				return x.IPAddressAndPortNumberReplacement()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// IPAddressAndPortNumberReplacementFields represents a fields contained in IPAddressAndPortNumberReplacement IE.
+type IPAddressAndPortNumberReplacementFields struct {
+	Flags                  uint8
+	DestinationIPv4Address net.IP
+	DestinationIPv6Address net.IP
+	DestinationPortNumber  uint16
+	SourceIPv4Address      net.IP
+	SourceIPv6Address      net.IP
+	SourcePortNumber       uint16
+}
+
+// NewIPAddressAndPortNumberReplacementFields creates a new IPAddressAndPortNumberReplacementFields.
+func NewIPAddressAndPortNumberReplacementFields(dstIP net.IP, dstPort uint16, srcIP net.IP, srcPort uint16) *IPAddressAndPortNumberReplacementFields {
+	f := &IPAddressAndPortNumberReplacementFields{}
+
+	if dstIP != nil {
+		if v4 := dstIP.To4(); v4 != nil {
+			f.DestinationIPv4Address = v4
+			f.Flags |= 0x01
+		} else {
+			f.DestinationIPv6Address = dstIP
+			f.Flags |= 0x02
+		}
+	}
+	if dstPort != 0 {
+		f.DestinationPortNumber = dstPort
+		f.Flags |= 0x04
+	}
+	if srcIP != nil {
+		if v4 := srcIP.To4(); v4 != nil {
+			f.SourceIPv4Address = v4
+			f.Flags |= 0x08
+		} else {
+			f.SourceIPv6Address = srcIP
+			f.Flags |= 0x10
+		}
+	}
+	if srcPort != 0 {
+		f.SourcePortNumber = srcPort
+		f.Flags |= 0x20
+	}
+
+	return f
+}
+
+// HasV4 reports whether V4 flag is set.
+func (f *IPAddressAndPortNumberReplacementFields) HasV4() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasV6 reports whether V6 flag is set.
+func (f *IPAddressAndPortNumberReplacementFields) HasV6() bool {
+	return has2ndBit(f.Flags)
+}
+
+// HasDPN reports whether DPN flag is set.
+func (f *IPAddressAndPortNumberReplacementFields) HasDPN() bool {
+	return has3rdBit(f.Flags)
+}
+
+// HasSIPV4 reports whether SIPV4 flag is set.
+func (f *IPAddressAndPortNumberReplacementFields) HasSIPV4() bool {
+	return has4thBit(f.Flags)
+}
+
+// HasSIPV6 reports whether SIPV6 flag is set.
+func (f *IPAddressAndPortNumberReplacementFields) HasSIPV6() bool {
+	return has5thBit(f.Flags)
+}
+
+// HasSPN reports whether SPN flag is set.
+func (f *IPAddressAndPortNumberReplacementFields) HasSPN() bool {
+	return has6thBit(f.Flags)
+}
+
+// ParseIPAddressAndPortNumberReplacementFields parses b into IPAddressAndPortNumberReplacementFields.
+func ParseIPAddressAndPortNumberReplacementFields(b []byte) (*IPAddressAndPortNumberReplacementFields, error) {
+	f := &IPAddressAndPortNumberReplacementFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *IPAddressAndPortNumberReplacementFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	offset := 1
+
+	if f.HasV4() {
+		if l < offset+4 {
+			return io.ErrUnexpectedEOF
+		}
+		f.DestinationIPv4Address = net.IP(b[offset : offset+4])
+		offset += 4
+	}
+	if f.HasV6() {
+		if l < offset+16 {
+			return io.ErrUnexpectedEOF
+		}
+		f.DestinationIPv6Address = net.IP(b[offset : offset+16])
+		offset += 16
+	}
+	if f.HasDPN() {
+		if l < offset+2 {
+			return io.ErrUnexpectedEOF
+		}
+		f.DestinationPortNumber = binary.BigEndian.Uint16(b[offset : offset+2])
+		offset += 2
+	}
+	if f.HasSIPV4() {
+		if l < offset+4 {
+			return io.ErrUnexpectedEOF
+		}
+		f.SourceIPv4Address = net.IP(b[offset : offset+4])
+		offset += 4
+	}
+	if f.HasSIPV6() {
+		if l < offset+16 {
+			return io.ErrUnexpectedEOF
+		}
+		f.SourceIPv6Address = net.IP(b[offset : offset+16])
+		offset += 16
+	}
+	if f.HasSPN() {
+		if l < offset+2 {
+			return io.ErrUnexpectedEOF
+		}
+		f.SourcePortNumber = binary.BigEndian.Uint16(b[offset : offset+2])
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of IPAddressAndPortNumberReplacementFields.
+func (f *IPAddressAndPortNumberReplacementFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *IPAddressAndPortNumberReplacementFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	offset := 1
+
+	if f.HasV4() {
+		copy(b[offset:offset+4], f.DestinationIPv4Address.To4())
+		offset += 4
+	}
+	if f.HasV6() {
+		copy(b[offset:offset+16], f.DestinationIPv6Address.To16())
+		offset += 16
+	}
+	if f.HasDPN() {
+		binary.BigEndian.PutUint16(b[offset:offset+2], f.DestinationPortNumber)
+		offset += 2
+	}
+	if f.HasSIPV4() {
+		copy(b[offset:offset+4], f.SourceIPv4Address.To4())
+		offset += 4
+	}
+	if f.HasSIPV6() {
+		copy(b[offset:offset+16], f.SourceIPv6Address.To16())
+		offset += 16
+	}
+	if f.HasSPN() {
+		binary.BigEndian.PutUint16(b[offset:offset+2], f.SourcePortNumber)
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *IPAddressAndPortNumberReplacementFields) MarshalLen() int {
+	l := 1
+	if f.HasV4() {
+		l += 4
+	}
+	if f.HasV6() {
+		l += 16
+	}
+	if f.HasDPN() {
+		l += 2
+	}
+	if f.HasSIPV4() {
+		l += 4
+	}
+	if f.HasSIPV6() {
+		l += 16
+	}
+	if f.HasSPN() {
+		l += 2
+	}
+
+	return l
+}
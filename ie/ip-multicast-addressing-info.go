@@ -13,7 +13,7 @@ func NewIPMulticastAddressingInfo(ies ...*IE) *IE {
 func (i *IE) IPMulticastAddressingInfo() ([]*IE, error) {
 	switch i.Type {
 	case IPMulticastAddressingInfo:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreatePDR:
 		ies, err := i.CreatePDR()
 		if err != nil {
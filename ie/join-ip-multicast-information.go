@@ -13,7 +13,7 @@ func NewJoinIPMulticastInformationWithinUsageReport(ies ...*IE) *IE {
 func (i *IE) JoinIPMulticastInformationWithinUsageReport() ([]*IE, error) {
 	switch i.Type {
 	case JoinIPMulticastInformationWithinUsageReport:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case UsageReportWithinSessionReportRequest:
 		ies, err := i.UsageReport()
 		if err != nil {
@@ -0,0 +1,61 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewL2TPSessionIndications creates a new L2TPSessionIndications IE.
+func NewL2TPSessionIndications(flags uint8) *IE {
+	return newUint8ValIE(L2TPSessionIndications, flags)
+}
+
+// L2TPSessionIndications returns L2TPSessionIndications in uint8 if the type of IE matches.
+func (i *IE) L2TPSessionIndications() (uint8, error) {
+	switch i.Type {
+	case L2TPSessionIndications:
+		return i.ValueAsUint8()
+	case L2TPSessionInformation:
+		ies, err := i.L2TPSessionInformation()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == L2TPSessionIndications {
+				return x.L2TPSessionIndications()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// HasREUIA reports whether an IE has REUIA bit.
+func (i *IE) HasREUIA() bool {
+	v, err := i.L2TPSessionIndications()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
+
+// HasREDSA reports whether an IE has REDSA bit.
+func (i *IE) HasREDSA() bool {
+	v, err := i.L2TPSessionIndications()
+	if err != nil {
+		return false
+	}
+
+	return has2ndBit(v)
+}
+
+// HasRENSA reports whether an IE has RENSA bit.
+func (i *IE) HasRENSA() bool {
+	v, err := i.L2TPSessionIndications()
+	if err != nil {
+		return false
+	}
+
+	return has3rdBit(v)
+}
@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewL2TPSessionInformation creates a new L2TPSessionInformation IE.
+func NewL2TPSessionInformation(ies ...*IE) *IE {
+	return newGroupedIE(L2TPSessionInformation, 0, ies...)
+}
+
+// L2TPSessionInformation returns the IEs above L2TPSessionInformation if the type of IE matches.
+func (i *IE) L2TPSessionInformation() ([]*IE, error) {
+	if i.Type != L2TPSessionInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
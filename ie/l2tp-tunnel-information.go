@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewL2TPTunnelInformation creates a new L2TPTunnelInformation IE.
+func NewL2TPTunnelInformation(ies ...*IE) *IE {
+	return newGroupedIE(L2TPTunnelInformation, 0, ies...)
+}
+
+// L2TPTunnelInformation returns the IEs above L2TPTunnelInformation if the type of IE matches.
+func (i *IE) L2TPTunnelInformation() ([]*IE, error) {
+	if i.Type != L2TPTunnelInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
@@ -0,0 +1,226 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewL2TPUserAuthentication creates a new L2TPUserAuthentication IE.
+//
+// The values are forwarded by the UP function to the LNS as the Proxy Authen AVPs
+// defined in RFC 2661. The name, challenge, response and ID are encoded only when
+// the PAN (0x01), PAC (0x02), PAR (0x04) and PAI (0x08) flags are set respectively.
+func NewL2TPUserAuthentication(flags, typ uint8, name string, challenge, response []byte, id uint8) *IE {
+	fields := NewL2TPUserAuthenticationFields(flags, typ, name, challenge, response, id)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(L2TPUserAuthentication, b)
+}
+
+// L2TPUserAuthentication returns L2TPUserAuthentication in structured format if the type of IE matches.
+func (i *IE) L2TPUserAuthentication() (*L2TPUserAuthenticationFields, error) {
+	switch i.Type {
+	case L2TPUserAuthentication:
+		fields, err := ParseL2TPUserAuthenticationFields(i.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	case L2TPSessionInformation:
+		ies, err := i.L2TPSessionInformation()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == L2TPUserAuthentication {
+				return x.L2TPUserAuthentication()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// L2TPUserAuthenticationFields represents a fields contained in L2TPUserAuthentication IE.
+type L2TPUserAuthenticationFields struct {
+	Flags                uint8
+	ProxyAuthenType      uint8
+	ProxyAuthenName      string
+	ProxyAuthenChallenge []byte
+	ProxyAuthenResponse  []byte
+	ProxyAuthenID        uint8
+}
+
+// NewL2TPUserAuthenticationFields creates a new L2TPUserAuthenticationFields.
+func NewL2TPUserAuthenticationFields(flags, typ uint8, name string, challenge, response []byte, id uint8) *L2TPUserAuthenticationFields {
+	f := &L2TPUserAuthenticationFields{Flags: flags, ProxyAuthenType: typ}
+
+	if f.HasPAN() {
+		f.ProxyAuthenName = name
+	}
+	if f.HasPAC() {
+		f.ProxyAuthenChallenge = challenge
+	}
+	if f.HasPAR() {
+		f.ProxyAuthenResponse = response
+	}
+	if f.HasPAI() {
+		f.ProxyAuthenID = id
+	}
+
+	return f
+}
+
+// HasPAN reports whether PAN flag is set.
+func (f *L2TPUserAuthenticationFields) HasPAN() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasPAC reports whether PAC flag is set.
+func (f *L2TPUserAuthenticationFields) HasPAC() bool {
+	return has2ndBit(f.Flags)
+}
+
+// HasPAR reports whether PAR flag is set.
+func (f *L2TPUserAuthenticationFields) HasPAR() bool {
+	return has3rdBit(f.Flags)
+}
+
+// HasPAI reports whether PAI flag is set.
+func (f *L2TPUserAuthenticationFields) HasPAI() bool {
+	return has4thBit(f.Flags)
+}
+
+// ParseL2TPUserAuthenticationFields parses b into L2TPUserAuthenticationFields.
+func ParseL2TPUserAuthenticationFields(b []byte) (*L2TPUserAuthenticationFields, error) {
+	f := &L2TPUserAuthenticationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *L2TPUserAuthenticationFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 2 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	f.ProxyAuthenType = b[1]
+	offset := 2
+
+	// the name, challenge and response are prefixed by their lengths in one octet.
+	next := func() ([]byte, error) {
+		if l < offset+1 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		n := int(b[offset])
+		offset++
+		if l < offset+n {
+			return nil, io.ErrUnexpectedEOF
+		}
+		v := b[offset : offset+n]
+		offset += n
+		return v, nil
+	}
+
+	if f.HasPAN() {
+		v, err := next()
+		if err != nil {
+			return err
+		}
+		f.ProxyAuthenName = string(v)
+	}
+	if f.HasPAC() {
+		v, err := next()
+		if err != nil {
+			return err
+		}
+		f.ProxyAuthenChallenge = v
+	}
+	if f.HasPAR() {
+		v, err := next()
+		if err != nil {
+			return err
+		}
+		f.ProxyAuthenResponse = v
+	}
+
+	if f.HasPAI() {
+		if l < offset+1 {
+			return io.ErrUnexpectedEOF
+		}
+		f.ProxyAuthenID = b[offset]
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of L2TPUserAuthenticationFields.
+func (f *L2TPUserAuthenticationFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *L2TPUserAuthenticationFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	b[1] = f.ProxyAuthenType
+	offset := 2
+
+	put := func(v []byte) {
+		b[offset] = uint8(len(v))
+		copy(b[offset+1:], v)
+		offset += 1 + len(v)
+	}
+
+	if f.HasPAN() {
+		put([]byte(f.ProxyAuthenName))
+	}
+	if f.HasPAC() {
+		put(f.ProxyAuthenChallenge)
+	}
+	if f.HasPAR() {
+		put(f.ProxyAuthenResponse)
+	}
+	if f.HasPAI() {
+		b[offset] = f.ProxyAuthenID
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *L2TPUserAuthenticationFields) MarshalLen() int {
+	l := 2
+	if f.HasPAN() {
+		l += 1 + len(f.ProxyAuthenName)
+	}
+	if f.HasPAC() {
+		l += 1 + len(f.ProxyAuthenChallenge)
+	}
+	if f.HasPAR() {
+		l += 1 + len(f.ProxyAuthenResponse)
+	}
+	if f.HasPAI() {
+		l++
+	}
+
+	return l
+}
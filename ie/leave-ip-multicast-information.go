@@ -13,7 +13,7 @@ func NewLeaveIPMulticastInformationWithinUsageReport(ies ...*IE) *IE {
 func (i *IE) LeaveIPMulticastInformationWithinUsageReport() ([]*IE, error) {
 	switch i.Type {
 	case LeaveIPMulticastInformationWithinUsageReport:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case UsageReportWithinSessionReportRequest:
 		ies, err := i.UsageReport()
 		if err != nil {
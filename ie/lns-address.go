@@ -0,0 +1,57 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"net"
+)
+
+// NewLNSAddress creates a new LNSAddress IE.
+//
+// The address is encoded in 4 octets if it is IPv4, or in 16 octets otherwise.
+func NewLNSAddress(ip net.IP) *IE {
+	if v4 := ip.To4(); v4 != nil {
+		return New(LNSAddress, v4)
+	}
+	return New(LNSAddress, ip.To16())
+}
+
+// LNSAddress returns LNSAddress in net.IP if the type of IE matches.
+func (i *IE) LNSAddress() (net.IP, error) {
+	switch i.Type {
+	case LNSAddress:
+		switch len(i.Payload) {
+		case net.IPv4len, net.IPv6len:
+			return net.IP(i.Payload), nil
+		default:
+			return nil, io.ErrUnexpectedEOF
+		}
+	case L2TPTunnelInformation:
+		ies, err := i.L2TPTunnelInformation()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == LNSAddress {
+				return x.LNSAddress()
+			}
+		}
+		return nil, ErrIENotFound
+	case CreatedL2TPSession:
+		ies, err := i.CreatedL2TPSession()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == LNSAddress {
+				return x.LNSAddress()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+)
+
+// NewLocalIngressTunnel creates a new LocalIngressTunnel IE.
+func NewLocalIngressTunnel(flags uint8, port uint16, v4, v6 net.IP) *IE {
+	fields := NewLocalIngressTunnelFields(flags, port, v4, v6)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(LocalIngressTunnel, b)
+}
+
+// LocalIngressTunnel returns LocalIngressTunnel in structured format if the type of IE matches.
+func (i *IE) LocalIngressTunnel() (*LocalIngressTunnelFields, error) {
+	switch i.Type {
+	case LocalIngressTunnel:
+		fields, err := ParseLocalIngressTunnelFields(i.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	case PDI:
+		ies, err := i.PDI()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == LocalIngressTunnel {
+				return x.LocalIngressTunnel()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// LocalIngressTunnelFields represents a fields contained in LocalIngressTunnel IE.
+type LocalIngressTunnelFields struct {
+	Flags       uint8
+	UDPPort     uint16
+	IPv4Address net.IP
+	IPv6Address net.IP
+}
+
+// NewLocalIngressTunnelFields creates a new LocalIngressTunnelFields.
+func NewLocalIngressTunnelFields(flags uint8, port uint16, v4, v6 net.IP) *LocalIngressTunnelFields {
+	f := &LocalIngressTunnelFields{Flags: flags}
+
+	if f.HasCH() {
+		return f
+	}
+
+	f.UDPPort = port
+	if f.HasV4() {
+		f.IPv4Address = v4
+	}
+	if f.HasV6() {
+		f.IPv6Address = v6
+	}
+
+	return f
+}
+
+// HasV4 reports whether V4 flag is set.
+func (f *LocalIngressTunnelFields) HasV4() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasV6 reports whether V6 flag is set.
+func (f *LocalIngressTunnelFields) HasV6() bool {
+	return has2ndBit(f.Flags)
+}
+
+// HasCH reports whether CH flag is set.
+func (f *LocalIngressTunnelFields) HasCH() bool {
+	return has3rdBit(f.Flags)
+}
+
+// ParseLocalIngressTunnelFields parses b into LocalIngressTunnelFields.
+func ParseLocalIngressTunnelFields(b []byte) (*LocalIngressTunnelFields, error) {
+	f := &LocalIngressTunnelFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *LocalIngressTunnelFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	if f.HasCH() {
+		return nil
+	}
+
+	offset := 1
+	if l < offset+2 {
+		return io.ErrUnexpectedEOF
+	}
+	f.UDPPort = binary.BigEndian.Uint16(b[offset : offset+2])
+	offset += 2
+
+	if f.HasV4() {
+		if l < offset+4 {
+			return io.ErrUnexpectedEOF
+		}
+		f.IPv4Address = net.IP(b[offset : offset+4])
+		offset += 4
+	}
+	if f.HasV6() {
+		if l < offset+16 {
+			return io.ErrUnexpectedEOF
+		}
+		f.IPv6Address = net.IP(b[offset : offset+16])
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of LocalIngressTunnelFields.
+func (f *LocalIngressTunnelFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *LocalIngressTunnelFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	if f.HasCH() {
+		return nil
+	}
+
+	offset := 1
+	binary.BigEndian.PutUint16(b[offset:offset+2], f.UDPPort)
+	offset += 2
+
+	if f.HasV4() {
+		copy(b[offset:offset+4], f.IPv4Address.To4())
+		offset += 4
+	}
+	if f.HasV6() {
+		copy(b[offset:offset+16], f.IPv6Address.To16())
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *LocalIngressTunnelFields) MarshalLen() int {
+	if f.HasCH() {
+		return 1
+	}
+
+	l := 3
+	if f.HasV4() {
+		l += 4
+	}
+	if f.HasV6() {
+		l += 16
+	}
+
+	return l
+}
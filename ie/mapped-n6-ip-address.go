@@ -0,0 +1,124 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"net"
+)
+
+// NewMappedN6IPAddress creates a new MappedN6IPAddress IE.
+//
+// The IPv4 address is encoded only when the V4 flag (0x01) is set. Set the CHV4
+// flag (0x02) instead to request the UP function to allocate the address.
+func NewMappedN6IPAddress(flags uint8, v4 net.IP) *IE {
+	fields := NewMappedN6IPAddressFields(flags, v4)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(MappedN6IPAddress, b)
+}
+
+// MappedN6IPAddress returns MappedN6IPAddress in structured format if the type of IE matches.
+func (i *IE) MappedN6IPAddress() (*MappedN6IPAddressFields, error) {
+	if i.Type != MappedN6IPAddress {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	fields, err := ParseMappedN6IPAddressFields(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// MappedN6IPAddressFields represents a fields contained in MappedN6IPAddress IE.
+type MappedN6IPAddressFields struct {
+	Flags       uint8
+	IPv4Address net.IP
+}
+
+// NewMappedN6IPAddressFields creates a new MappedN6IPAddressFields.
+func NewMappedN6IPAddressFields(flags uint8, v4 net.IP) *MappedN6IPAddressFields {
+	f := &MappedN6IPAddressFields{Flags: flags}
+
+	if f.HasV4() {
+		f.IPv4Address = v4
+	}
+
+	return f
+}
+
+// HasV4 reports whether V4 flag is set.
+func (f *MappedN6IPAddressFields) HasV4() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasCHV4 reports whether CHV4 flag is set.
+func (f *MappedN6IPAddressFields) HasCHV4() bool {
+	return has2ndBit(f.Flags)
+}
+
+// ParseMappedN6IPAddressFields parses b into MappedN6IPAddressFields.
+func ParseMappedN6IPAddressFields(b []byte) (*MappedN6IPAddressFields, error) {
+	f := &MappedN6IPAddressFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *MappedN6IPAddressFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	if f.HasV4() {
+		if l < 5 {
+			return io.ErrUnexpectedEOF
+		}
+		f.IPv4Address = net.IP(b[1:5])
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of MappedN6IPAddressFields.
+func (f *MappedN6IPAddressFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *MappedN6IPAddressFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	if f.HasV4() {
+		copy(b[1:5], f.IPv4Address.To4())
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *MappedN6IPAddressFields) MarshalLen() int {
+	if f.HasV4() {
+		return 5
+	}
+	return 1
+}
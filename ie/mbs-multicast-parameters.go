@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMBSMulticastParameters creates a new MBSMulticastParameters IE.
+func NewMBSMulticastParameters(ies ...*IE) *IE {
+	return newGroupedIE(MBSMulticastParameters, 0, ies...)
+}
+
+// MBSMulticastParameters returns the IEs above MBSMulticastParameters if the type of IE matches.
+func (i *IE) MBSMulticastParameters() ([]*IE, error) {
+	if i.Type != MBSMulticastParameters {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
@@ -0,0 +1,222 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"net"
+)
+
+// NewMBSSessionIdentifier creates a new MBSSessionIdentifier IE.
+//
+// TMGI and NID are 6 octets each, and src and dst are the source address and the
+// multicast address of the Source Specific IP Multicast Address. The parameters
+// that are nil are not encoded, and the corresponding flags are set automatically.
+func NewMBSSessionIdentifier(tmgi []byte, src, dst net.IP, nid []byte) *IE {
+	fields := NewMBSSessionIdentifierFields(tmgi, src, dst, nid)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(MBSSessionIdentifier, b)
+}
+
+// MBSSessionIdentifier returns MBSSessionIdentifier in structured format if the type of IE matches.
+func (i *IE) MBSSessionIdentifier() (*MBSSessionIdentifierFields, error) {
+	switch i.Type {
+	case MBSSessionIdentifier:
+		fields, err := ParseMBSSessionIdentifierFields(i.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	case MBSSessionN4mbControlInformation:
+		ies, err := i.MBSSessionN4mbControlInformation()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == MBSSessionIdentifier {
+				return x.MBSSessionIdentifier()
+			}
+		}
+		return nil, ErrIENotFound
+	case MBSSessionN4ControlInformation:
+		ies, err := i.MBSSessionN4ControlInformation()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == MBSSessionIdentifier {
+				return x.MBSSessionIdentifier()
+			}
+		}
+		return nil, ErrIENotFound
+	case MBSSessionN4Information:
+		ies, err := i.MBSSessionN4Information()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == MBSSessionIdentifier {
+				return x.MBSSessionIdentifier()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MBSSessionIdentifierFields represents a fields contained in MBSSessionIdentifier IE.
+type MBSSessionIdentifierFields struct {
+	Flags uint8
+	TMGI  []byte
+
+	// SourceAddress and MulticastAddress are the Source Specific IP Multicast Address.
+	SourceAddress    net.IP
+	MulticastAddress net.IP
+
+	NID []byte
+}
+
+// NewMBSSessionIdentifierFields creates a new MBSSessionIdentifierFields.
+func NewMBSSessionIdentifierFields(tmgi []byte, src, dst net.IP, nid []byte) *MBSSessionIdentifierFields {
+	f := &MBSSessionIdentifierFields{}
+
+	if tmgi != nil {
+		f.Flags |= 0x01
+		f.TMGI = tmgi
+	}
+	if src != nil && dst != nil {
+		f.Flags |= 0x02
+		f.SourceAddress = src
+		f.MulticastAddress = dst
+	}
+	if nid != nil {
+		f.Flags |= 0x04
+		f.NID = nid
+	}
+
+	return f
+}
+
+// HasTMGI reports whether TMGI flag is set.
+func (f *MBSSessionIdentifierFields) HasTMGI() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasSSMI reports whether SSMI flag is set.
+func (f *MBSSessionIdentifierFields) HasSSMI() bool {
+	return has2ndBit(f.Flags)
+}
+
+// HasNIDI reports whether NIDI flag is set.
+func (f *MBSSessionIdentifierFields) HasNIDI() bool {
+	return has3rdBit(f.Flags)
+}
+
+// ParseMBSSessionIdentifierFields parses b into MBSSessionIdentifierFields.
+func ParseMBSSessionIdentifierFields(b []byte) (*MBSSessionIdentifierFields, error) {
+	f := &MBSSessionIdentifierFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *MBSSessionIdentifierFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	offset := 1
+
+	if f.HasTMGI() {
+		if l < offset+6 {
+			return io.ErrUnexpectedEOF
+		}
+		f.TMGI = b[offset : offset+6]
+		offset += 6
+	}
+
+	if f.HasSSMI() {
+		var err error
+		f.SourceAddress, offset, err = decodeTypedAddress(b, offset)
+		if err != nil {
+			return err
+		}
+		f.MulticastAddress, offset, err = decodeTypedAddress(b, offset)
+		if err != nil {
+			return err
+		}
+	}
+
+	if f.HasNIDI() {
+		if l < offset+6 {
+			return io.ErrUnexpectedEOF
+		}
+		f.NID = b[offset : offset+6]
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of MBSSessionIdentifierFields.
+func (f *MBSSessionIdentifierFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *MBSSessionIdentifierFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	offset := 1
+
+	if f.HasTMGI() {
+		copy(b[offset:offset+6], f.TMGI)
+		offset += 6
+	}
+
+	if f.HasSSMI() {
+		offset = encodeTypedAddress(b, offset, f.SourceAddress)
+		offset = encodeTypedAddress(b, offset, f.MulticastAddress)
+	}
+
+	if f.HasNIDI() {
+		copy(b[offset:offset+6], f.NID)
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *MBSSessionIdentifierFields) MarshalLen() int {
+	l := 1
+	if f.HasTMGI() {
+		l += 6
+	}
+	if f.HasSSMI() {
+		l += typedAddressLen(f.SourceAddress) + typedAddressLen(f.MulticastAddress)
+	}
+	if f.HasNIDI() {
+		l += 6
+	}
+
+	return l
+}
@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMBSSessionN4ControlInformation creates a new MBSSessionN4ControlInformation IE.
+func NewMBSSessionN4ControlInformation(ies ...*IE) *IE {
+	return newGroupedIE(MBSSessionN4ControlInformation, 0, ies...)
+}
+
+// MBSSessionN4ControlInformation returns the IEs above MBSSessionN4ControlInformation if the type of IE matches.
+func (i *IE) MBSSessionN4ControlInformation() ([]*IE, error) {
+	if i.Type != MBSSessionN4ControlInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMBSSessionN4Information creates a new MBSSessionN4Information IE.
+func NewMBSSessionN4Information(ies ...*IE) *IE {
+	return newGroupedIE(MBSSessionN4Information, 0, ies...)
+}
+
+// MBSSessionN4Information returns the IEs above MBSSessionN4Information if the type of IE matches.
+func (i *IE) MBSSessionN4Information() ([]*IE, error) {
+	if i.Type != MBSSessionN4Information {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
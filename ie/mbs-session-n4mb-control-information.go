@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMBSSessionN4mbControlInformation creates a new MBSSessionN4mbControlInformation IE.
+func NewMBSSessionN4mbControlInformation(ies ...*IE) *IE {
+	return newGroupedIE(MBSSessionN4mbControlInformation, 0, ies...)
+}
+
+// MBSSessionN4mbControlInformation returns the IEs above MBSSessionN4mbControlInformation if the type of IE matches.
+func (i *IE) MBSSessionN4mbControlInformation() ([]*IE, error) {
+	if i.Type != MBSSessionN4mbControlInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMBSSessionN4mbInformation creates a new MBSSessionN4mbInformation IE.
+func NewMBSSessionN4mbInformation(ies ...*IE) *IE {
+	return newGroupedIE(MBSSessionN4mbInformation, 0, ies...)
+}
+
+// MBSSessionN4mbInformation returns the IEs above MBSSessionN4mbInformation if the type of IE matches.
+func (i *IE) MBSSessionN4mbInformation() ([]*IE, error) {
+	if i.Type != MBSSessionN4mbInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
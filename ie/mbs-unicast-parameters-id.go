@@ -0,0 +1,42 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMBSUnicastParametersID creates a new MBSUnicastParametersID IE.
+func NewMBSUnicastParametersID(v uint16) *IE {
+	return newUint16ValIE(MBSUnicastParametersID, v)
+}
+
+// MBSUnicastParametersID returns MBSUnicastParametersID in uint16 if the type of IE matches.
+func (i *IE) MBSUnicastParametersID() (uint16, error) {
+	switch i.Type {
+	case MBSUnicastParametersID:
+		return i.ValueAsUint16()
+	case AddMBSUnicastParameters:
+		ies, err := i.AddMBSUnicastParameters()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == MBSUnicastParametersID {
+				return x.MBSUnicastParametersID()
+			}
+		}
+		return 0, ErrIENotFound
+	case RemoveMBSUnicastParameters:
+		ies, err := i.RemoveMBSUnicastParameters()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == MBSUnicastParametersID {
+				return x.MBSUnicastParametersID()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
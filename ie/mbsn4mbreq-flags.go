@@ -0,0 +1,49 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMBSN4mbReqFlags creates a new MBSN4mbReqFlags IE.
+func NewMBSN4mbReqFlags(flags uint8) *IE {
+	return newUint8ValIE(MBSN4mbReqFlags, flags)
+}
+
+// MBSN4mbReqFlags returns MBSN4mbReqFlags in uint8 if the type of IE matches.
+func (i *IE) MBSN4mbReqFlags() (uint8, error) {
+	if i.Type != MBSN4mbReqFlags {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.ValueAsUint8()
+}
+
+// HasPLLSSM reports whether an IE has PLLSSM bit.
+func (i *IE) HasPLLSSM() bool {
+	v, err := i.MBSN4mbReqFlags()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
+
+// HasJMBSSM reports whether an IE has JMBSSM bit.
+func (i *IE) HasJMBSSM() bool {
+	v, err := i.MBSN4mbReqFlags()
+	if err != nil {
+		return false
+	}
+
+	return has2ndBit(v)
+}
+
+// HasMBSRESTI reports whether an IE has MBSRESTI bit.
+func (i *IE) HasMBSRESTI() bool {
+	v, err := i.MBSN4mbReqFlags()
+	if err != nil {
+		return false
+	}
+
+	return has3rdBit(v)
+}
@@ -0,0 +1,61 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMBSN4RespFlags creates a new MBSN4RespFlags IE.
+func NewMBSN4RespFlags(flags uint8) *IE {
+	return newUint8ValIE(MBSN4RespFlags, flags)
+}
+
+// MBSN4RespFlags returns MBSN4RespFlags in uint8 if the type of IE matches.
+func (i *IE) MBSN4RespFlags() (uint8, error) {
+	switch i.Type {
+	case MBSN4RespFlags:
+		return i.ValueAsUint8()
+	case MBSSessionN4Information:
+		ies, err := i.MBSSessionN4Information()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == MBSN4RespFlags {
+				return x.MBSN4RespFlags()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// HasNN19DT reports whether an IE has NN19DT bit.
+func (i *IE) HasNN19DT() bool {
+	v, err := i.MBSN4RespFlags()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
+
+// HasJMTI reports whether an IE has JMTI bit.
+func (i *IE) HasJMTI() bool {
+	v, err := i.MBSN4RespFlags()
+	if err != nil {
+		return false
+	}
+
+	return has2ndBit(v)
+}
+
+// HasN19DTR reports whether an IE has N19DTR bit.
+func (i *IE) HasN19DTR() bool {
+	v, err := i.MBSN4RespFlags()
+	if err != nil {
+		return false
+	}
+
+	return has3rdBit(v)
+}
@@ -0,0 +1,29 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMeasurementIndication creates a new MeasurementIndication IE.
+func NewMeasurementIndication(flags uint8) *IE {
+	return newUint8ValIE(MeasurementIndication, flags)
+}
+
+// MeasurementIndication returns MeasurementIndication in uint8 if the type of IE matches.
+func (i *IE) MeasurementIndication() (uint8, error) {
+	if i.Type != MeasurementIndication {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.ValueAsUint8()
+}
+
+// HasDQFI reports whether an IE has DQFI bit.
+func (i *IE) HasDQFI() bool {
+	v, err := i.MeasurementIndication()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
@@ -4,6 +4,62 @@
 
 package ie
 
+// MeasurementMethodFlags is a set of the flags in MeasurementMethod IE.
+type MeasurementMethodFlags uint8
+
+// MeasurementMethod flag definitions.
+const (
+	MeasurementMethodDURAT MeasurementMethodFlags = 1 << iota
+	MeasurementMethodVOLUM
+	MeasurementMethodEVENT
+)
+
+var measurementMethodFlags = &flagSet{
+	names: []string{
+		"DURAT", "VOLUM", "EVENT",
+	},
+	minOctets: 1,
+}
+
+// Has reports whether all the given flags are set.
+func (f MeasurementMethodFlags) Has(flags MeasurementMethodFlags) bool {
+	return f&flags == flags
+}
+
+// Set sets the given flags.
+func (f *MeasurementMethodFlags) Set(flags MeasurementMethodFlags) {
+	*f |= flags
+}
+
+// Clear clears the given flags.
+func (f *MeasurementMethodFlags) Clear(flags MeasurementMethodFlags) {
+	*f &^= flags
+}
+
+// String returns the names of the set flags joined with "|", e.g., "DURAT|VOLUM".
+func (f MeasurementMethodFlags) String() string {
+	return measurementMethodFlags.string(uint64(f))
+}
+
+// Octets returns the flags serialized in as many octets as required by the set flags.
+func (f MeasurementMethodFlags) Octets() []byte {
+	return measurementMethodFlags.octets(uint64(f))
+}
+
+// NewMeasurementMethodFlags creates a new MeasurementMethod IE from the flag set.
+func NewMeasurementMethodFlags(flags MeasurementMethodFlags) *IE {
+	return New(MeasurementMethod, flags.Octets())
+}
+
+// MeasurementMethodFlags returns MeasurementMethod in MeasurementMethodFlags if the type of IE matches.
+func (i *IE) MeasurementMethodFlags() (MeasurementMethodFlags, error) {
+	v, err := i.MeasurementMethod()
+	if err != nil {
+		return 0, err
+	}
+	return MeasurementMethodFlags(v), nil
+}
+
 // NewMeasurementMethod creates a new MeasurementMethod IE.
 func NewMeasurementMethod(event, volum, durat int) *IE {
 	return newUint8ValIE(MeasurementMethod, uint8((event<<2)|(volum<<1)|(durat)))
@@ -0,0 +1,52 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// MediaTransportProtocolValue is the value of MediaTransportProtocol IE.
+type MediaTransportProtocolValue uint8
+
+// MediaTransportProtocol definitions.
+const (
+	MediaTransportProtocolUnspecified MediaTransportProtocolValue = 0
+	MediaTransportProtocolRTP         MediaTransportProtocolValue = 1
+	MediaTransportProtocolSRTP        MediaTransportProtocolValue = 2
+)
+
+var mediaTransportProtocolValues = newEnum("MediaTransportProtocolValue", map[MediaTransportProtocolValue]string{
+	MediaTransportProtocolUnspecified: "MediaTransportProtocolUnspecified",
+	MediaTransportProtocolRTP:         "MediaTransportProtocolRTP",
+	MediaTransportProtocolSRTP:        "MediaTransportProtocolSRTP",
+})
+
+// String returns the name of the MediaTransportProtocolValue, e.g., "MediaTransportProtocolRTP".
+func (v MediaTransportProtocolValue) String() string {
+	return mediaTransportProtocolValues.name(v)
+}
+
+// IsValid reports whether the MediaTransportProtocolValue is a defined value.
+func (v MediaTransportProtocolValue) IsValid() bool {
+	return mediaTransportProtocolValues.valid(v)
+}
+
+// MediaTransportProtocolValueByName returns the MediaTransportProtocolValue of the given name,
+// e.g., "MediaTransportProtocolRTP". The value in decimal is also accepted.
+func MediaTransportProtocolValueByName(name string) (MediaTransportProtocolValue, bool) {
+	return mediaTransportProtocolValues.byName(name)
+}
+
+// NewMediaTransportProtocol creates a new MediaTransportProtocol IE.
+func NewMediaTransportProtocol(v MediaTransportProtocolValue) *IE {
+	return newUint8ValIE(MediaTransportProtocol, uint8(v))
+}
+
+// MediaTransportProtocol returns MediaTransportProtocol in MediaTransportProtocolValue if the type of IE matches.
+func (i *IE) MediaTransportProtocol() (MediaTransportProtocolValue, error) {
+	if i.Type != MediaTransportProtocol {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+
+	v, err := i.ValueAsUint8()
+	return MediaTransportProtocolValue(v), err
+}
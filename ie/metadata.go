@@ -0,0 +1,89 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewMetadata creates a new Metadata IE.
+//
+// The metadata is an opaque string provided by the AF for the traffic steering,
+// which is encoded in Base64 as received from the PCF.
+func NewMetadata(metadata string) *IE {
+	fields := NewMetadataFields(metadata)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(Metadata, b)
+}
+
+// Metadata returns Metadata in structured format if the type of IE matches.
+func (i *IE) Metadata() (*MetadataFields, error) {
+	if i.Type != Metadata {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	fields, err := ParseMetadataFields(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// MetadataFields represents a fields contained in Metadata IE.
+type MetadataFields struct {
+	Metadata string
+}
+
+// NewMetadataFields creates a new MetadataFields.
+func NewMetadataFields(metadata string) *MetadataFields {
+	return &MetadataFields{Metadata: metadata}
+}
+
+// ParseMetadataFields parses b into MetadataFields.
+func ParseMetadataFields(b []byte) (*MetadataFields, error) {
+	f := &MetadataFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *MetadataFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Metadata = string(b)
+	return nil
+}
+
+// Marshal returns the serialized bytes of MetadataFields.
+func (f *MetadataFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *MetadataFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	copy(b, f.Metadata)
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *MetadataFields) MarshalLen() int {
+	return len(f.Metadata)
+}
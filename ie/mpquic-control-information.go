@@ -0,0 +1,29 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMPQUICControlInformation creates a new MPQUICControlInformation IE.
+func NewMPQUICControlInformation(flags uint8) *IE {
+	return newUint8ValIE(MPQUICControlInformation, flags)
+}
+
+// MPQUICControlInformation returns MPQUICControlInformation in uint8 if the type of IE matches.
+func (i *IE) MPQUICControlInformation() (uint8, error) {
+	if i.Type != MPQUICControlInformation {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.ValueAsUint8()
+}
+
+// HasTQI reports whether an IE has TQI bit.
+func (i *IE) HasTQI() bool {
+	v, err := i.MPQUICControlInformation()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
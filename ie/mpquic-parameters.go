@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMPQUICParameters creates a new MPQUICParameters IE.
+func NewMPQUICParameters(ies ...*IE) *IE {
+	return newGroupedIE(MPQUICParameters, 0, ies...)
+}
+
+// MPQUICParameters returns the IEs above MPQUICParameters if the type of IE matches.
+func (i *IE) MPQUICParameters() ([]*IE, error) {
+	if i.Type != MPQUICParameters {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
@@ -10,13 +10,36 @@ import (
 	"net"
 )
 
+// MPTCPProxyTypeValue is the value of the MPTCP Proxy Type in MPTCPAddressInformation IE.
+type MPTCPProxyTypeValue uint8
+
 // MPTCP Proxy Type definitions(TS24.193).
 const (
-	MPTCPProxyTransportConverter uint8 = 1
+	MPTCPProxyTransportConverter MPTCPProxyTypeValue = 1
 )
 
+var mPTCPProxyTypeValues = newEnum("MPTCPProxyTypeValue", map[MPTCPProxyTypeValue]string{
+	MPTCPProxyTransportConverter: "MPTCPProxyTransportConverter",
+})
+
+// String returns the name of the MPTCPProxyTypeValue, e.g., "MPTCPProxyTransportConverter".
+func (v MPTCPProxyTypeValue) String() string {
+	return mPTCPProxyTypeValues.name(v)
+}
+
+// IsValid reports whether the MPTCPProxyTypeValue is a defined value.
+func (v MPTCPProxyTypeValue) IsValid() bool {
+	return mPTCPProxyTypeValues.valid(v)
+}
+
+// MPTCPProxyTypeValueByName returns the MPTCPProxyTypeValue of the given name,
+// e.g., "MPTCPProxyTransportConverter". The value in decimal is also accepted.
+func MPTCPProxyTypeValueByName(name string) (MPTCPProxyTypeValue, bool) {
+	return mPTCPProxyTypeValues.byName(name)
+}
+
 // NewMPTCPAddressInformation creates a new MPTCPAddressInformation IE.
-func NewMPTCPAddressInformation(ptype uint8, port uint16, v4, v6 net.IP) *IE {
+func NewMPTCPAddressInformation(ptype MPTCPProxyTypeValue, port uint16, v4, v6 net.IP) *IE {
 	fields := NewMPTCPAddressInformationFields(ptype, port, v4, v6)
 
 	b, err := fields.Marshal()
@@ -67,14 +90,14 @@ func (i *IE) MPTCPAddressInformation() (*MPTCPAddressInformationFields, error) {
 // MPTCPAddressInformationFields represents a fields contained in MPTCPAddressInformation IE.
 type MPTCPAddressInformationFields struct {
 	Flags            uint8
-	MPTCPProxyType   uint8
+	MPTCPProxyType   MPTCPProxyTypeValue
 	MPTCPProxyPort   uint16
 	MPTCPIPv4Address net.IP
 	MPTCPIPv6Address net.IP
 }
 
 // NewMPTCPAddressInformationFields creates a new NewMPTCPAddressInformationFields.
-func NewMPTCPAddressInformationFields(ptype uint8, port uint16, v4, v6 net.IP) *MPTCPAddressInformationFields {
+func NewMPTCPAddressInformationFields(ptype MPTCPProxyTypeValue, port uint16, v4, v6 net.IP) *MPTCPAddressInformationFields {
 	f := &MPTCPAddressInformationFields{
 		Flags:          0x00,
 		MPTCPProxyType: ptype,
@@ -130,7 +153,7 @@ func (f *MPTCPAddressInformationFields) UnmarshalBinary(b []byte) error {
 	}
 
 	f.Flags = b[0]
-	f.MPTCPProxyType = b[1]
+	f.MPTCPProxyType = MPTCPProxyTypeValue(b[1])
 	f.MPTCPProxyPort = binary.BigEndian.Uint16(b[2:4])
 	offset := 4
 
@@ -169,7 +192,7 @@ func (f *MPTCPAddressInformationFields) MarshalTo(b []byte) error {
 	}
 
 	b[0] = f.Flags
-	b[1] = f.MPTCPProxyType
+	b[1] = uint8(f.MPTCPProxyType)
 	binary.BigEndian.PutUint16(b[2:4], f.MPTCPProxyPort)
 	offset := 4
 
@@ -32,7 +32,16 @@ func (i *IE) MTEDTControlInformation() (uint8, error) {
 
 // HasRDSI reports whether an IE has RDSI bit.
 func (i *IE) HasRDSI() bool {
-	v, err := i.MTEDTControlInformation()
+	var (
+		v   uint8
+		err error
+	)
+	switch i.Type {
+	case MTSDTControlInformation:
+		v, err = i.MTSDTControlInformation()
+	default:
+		v, err = i.MTEDTControlInformation()
+	}
 	if err != nil {
 		return false
 	}
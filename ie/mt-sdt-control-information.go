@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMTSDTControlInformation creates a new MTSDTControlInformation IE.
+func NewMTSDTControlInformation(flags uint8) *IE {
+	return newUint8ValIE(MTSDTControlInformation, flags)
+}
+
+// MTSDTControlInformation returns MTSDTControlInformation in uint8 if the type of IE matches.
+func (i *IE) MTSDTControlInformation() (uint8, error) {
+	if i.Type != MTSDTControlInformation {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.ValueAsUint8()
+}
@@ -0,0 +1,188 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+)
+
+// NewMulticastTransportInformation creates a new MulticastTransportInformation IE.
+//
+// The Distribution Address and the Source Address are encoded as IPv4 or IPv6
+// according to the type of the net.IP given.
+func NewMulticastTransportInformation(cteid uint32, dist, src net.IP) *IE {
+	fields := NewMulticastTransportInformationFields(cteid, dist, src)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(MulticastTransportInformation, b)
+}
+
+// MulticastTransportInformation returns MulticastTransportInformation in structured format if the type of IE matches.
+func (i *IE) MulticastTransportInformation() (*MulticastTransportInformationFields, error) {
+	switch i.Type {
+	case MulticastTransportInformation:
+		fields, err := ParseMulticastTransportInformationFields(i.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	case MBSSessionN4mbControlInformation:
+		ies, err := i.MBSSessionN4mbControlInformation()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == MulticastTransportInformation {
+				return x.MulticastTransportInformation()
+			}
+		}
+		return nil, ErrIENotFound
+	case MBSSessionN4mbInformation:
+		ies, err := i.MBSSessionN4mbInformation()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == MulticastTransportInformation {
+				return x.MulticastTransportInformation()
+			}
+		}
+		return nil, ErrIENotFound
+	case MBSSessionN4ControlInformation:
+		ies, err := i.MBSSessionN4ControlInformation()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == MulticastTransportInformation {
+				return x.MulticastTransportInformation()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MulticastTransportInformationFields represents a fields contained in MulticastTransportInformation IE.
+type MulticastTransportInformationFields struct {
+	CommonTEID          uint32
+	DistributionAddress net.IP
+	SourceAddress       net.IP
+}
+
+// NewMulticastTransportInformationFields creates a new MulticastTransportInformationFields.
+func NewMulticastTransportInformationFields(cteid uint32, dist, src net.IP) *MulticastTransportInformationFields {
+	return &MulticastTransportInformationFields{
+		CommonTEID:          cteid,
+		DistributionAddress: dist,
+		SourceAddress:       src,
+	}
+}
+
+// ParseMulticastTransportInformationFields parses b into MulticastTransportInformationFields.
+func ParseMulticastTransportInformationFields(b []byte) (*MulticastTransportInformationFields, error) {
+	f := &MulticastTransportInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *MulticastTransportInformationFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 5 {
+		return io.ErrUnexpectedEOF
+	}
+
+	// the first octet is spare.
+	f.CommonTEID = binary.BigEndian.Uint32(b[1:5])
+	offset := 5
+
+	var err error
+	f.DistributionAddress, offset, err = decodeTypedAddress(b, offset)
+	if err != nil {
+		return err
+	}
+	f.SourceAddress, _, err = decodeTypedAddress(b, offset)
+	return err
+}
+
+// Marshal returns the serialized bytes of MulticastTransportInformationFields.
+func (f *MulticastTransportInformationFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *MulticastTransportInformationFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = 0
+	binary.BigEndian.PutUint32(b[1:5], f.CommonTEID)
+	offset := encodeTypedAddress(b, 5, f.DistributionAddress)
+	encodeTypedAddress(b, offset, f.SourceAddress)
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *MulticastTransportInformationFields) MarshalLen() int {
+	return 5 + typedAddressLen(f.DistributionAddress) + typedAddressLen(f.SourceAddress)
+}
+
+// decodeTypedAddress decodes the IP address preceded by the octet of the Address
+// Type (0: IPv4, 1: IPv6) in bits 8-7 and the Address Length in bits 6-1, and
+// returns it with the offset next to it.
+func decodeTypedAddress(b []byte, offset int) (net.IP, int, error) {
+	if len(b) <= offset {
+		return nil, offset, io.ErrUnexpectedEOF
+	}
+
+	atype, alen := b[offset]>>6, int(b[offset]&0x3f)
+	offset++
+	switch {
+	case atype == 0 && alen == 4, atype == 1 && alen == 16:
+	default:
+		return nil, offset, ErrMalformed
+	}
+	if len(b) < offset+alen {
+		return nil, offset, io.ErrUnexpectedEOF
+	}
+	return net.IP(b[offset : offset+alen]), offset + alen, nil
+}
+
+// encodeTypedAddress puts ip at offset in b in the format decoded by decodeTypedAddress,
+// and returns the offset next to it.
+func encodeTypedAddress(b []byte, offset int, ip net.IP) int {
+	if v4 := ip.To4(); v4 != nil {
+		b[offset] = 4
+		copy(b[offset+1:offset+5], v4)
+		return offset + 5
+	}
+
+	b[offset] = 1<<6 | 16
+	copy(b[offset+1:offset+17], ip.To16())
+	return offset + 17
+}
+
+// typedAddressLen returns the length of ip encoded by encodeTypedAddress.
+func typedAddressLen(ip net.IP) int {
+	if ip.To4() != nil {
+		return 5
+	}
+	return 17
+}
@@ -0,0 +1,115 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewN6JitterMeasurement creates a new N6JitterMeasurement IE.
+//
+// The periodicity is the measured interval between the bursts on N6 in milliseconds,
+// and the lower and higher bound jitters are the earliest and latest deviations of
+// the arrival from it in milliseconds, which can be negative.
+func NewN6JitterMeasurement(periodicity uint32, lower, higher int32) *IE {
+	fields := NewN6JitterMeasurementFields(periodicity, lower, higher)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(N6JitterMeasurement, b)
+}
+
+// N6JitterMeasurement returns N6JitterMeasurement in structured format if the type of IE matches.
+func (i *IE) N6JitterMeasurement() (*N6JitterMeasurementFields, error) {
+	switch i.Type {
+	case N6JitterMeasurement:
+		fields, err := ParseN6JitterMeasurementFields(i.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	case TrafficParameterMeasurementReport:
+		ies, err := i.TrafficParameterMeasurementReport()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == N6JitterMeasurement {
+				return x.N6JitterMeasurement()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// N6JitterMeasurementFields represents a fields contained in N6JitterMeasurement IE.
+type N6JitterMeasurementFields struct {
+	Periodicity       uint32
+	LowerBoundJitter  int32
+	HigherBoundJitter int32
+}
+
+// NewN6JitterMeasurementFields creates a new N6JitterMeasurementFields.
+func NewN6JitterMeasurementFields(periodicity uint32, lower, higher int32) *N6JitterMeasurementFields {
+	return &N6JitterMeasurementFields{
+		Periodicity:       periodicity,
+		LowerBoundJitter:  lower,
+		HigherBoundJitter: higher,
+	}
+}
+
+// ParseN6JitterMeasurementFields parses b into N6JitterMeasurementFields.
+func ParseN6JitterMeasurementFields(b []byte) (*N6JitterMeasurementFields, error) {
+	f := &N6JitterMeasurementFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *N6JitterMeasurementFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 12 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Periodicity = binary.BigEndian.Uint32(b[0:4])
+	f.LowerBoundJitter = int32(binary.BigEndian.Uint32(b[4:8]))
+	f.HigherBoundJitter = int32(binary.BigEndian.Uint32(b[8:12]))
+	return nil
+}
+
+// Marshal returns the serialized bytes of N6JitterMeasurementFields.
+func (f *N6JitterMeasurementFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *N6JitterMeasurementFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	binary.BigEndian.PutUint32(b[0:4], f.Periodicity)
+	binary.BigEndian.PutUint32(b[4:8], uint32(f.LowerBoundJitter))
+	binary.BigEndian.PutUint32(b[8:12], uint32(f.HigherBoundJitter))
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *N6JitterMeasurementFields) MarshalLen() int {
+	return 12
+}
@@ -0,0 +1,249 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+)
+
+// NewN6RoutingInformation creates a new N6RoutingInformation IE.
+//
+// Each address and port is encoded only when it is non-nil or non-zero, and the
+// corresponding flags are set automatically.
+func NewN6RoutingInformation(srcIP net.IP, srcPort uint16, dstIP net.IP, dstPort uint16) *IE {
+	fields := NewN6RoutingInformationFields(srcIP, srcPort, dstIP, dstPort)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(N6RoutingInformation, b)
+}
+
+// N6RoutingInformation returns N6RoutingInformation in structured format if the type of IE matches.
+func (i *IE) N6RoutingInformation() (*N6RoutingInformationFields, error) {
+	if i.Type != N6RoutingInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	fields, err := ParseN6RoutingInformationFields(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// N6RoutingInformationFields represents a fields contained in N6RoutingInformation IE.
+type N6RoutingInformationFields struct {
+	Flags                  uint8
+	SourceIPv4Address      net.IP
+	SourceIPv6Address      net.IP
+	SourcePortNumber       uint16
+	DestinationIPv4Address net.IP
+	DestinationIPv6Address net.IP
+	DestinationPortNumber  uint16
+}
+
+// NewN6RoutingInformationFields creates a new N6RoutingInformationFields.
+func NewN6RoutingInformationFields(srcIP net.IP, srcPort uint16, dstIP net.IP, dstPort uint16) *N6RoutingInformationFields {
+	f := &N6RoutingInformationFields{}
+
+	if srcIP != nil {
+		if v4 := srcIP.To4(); v4 != nil {
+			f.SourceIPv4Address = v4
+			f.Flags |= 0x01
+		} else {
+			f.SourceIPv6Address = srcIP
+			f.Flags |= 0x02
+		}
+	}
+	if srcPort != 0 {
+		f.SourcePortNumber = srcPort
+		f.Flags |= 0x04
+	}
+	if dstIP != nil {
+		if v4 := dstIP.To4(); v4 != nil {
+			f.DestinationIPv4Address = v4
+			f.Flags |= 0x08
+		} else {
+			f.DestinationIPv6Address = dstIP
+			f.Flags |= 0x10
+		}
+	}
+	if dstPort != 0 {
+		f.DestinationPortNumber = dstPort
+		f.Flags |= 0x20
+	}
+
+	return f
+}
+
+// HasSIPV4 reports whether SIPV4 flag is set.
+func (f *N6RoutingInformationFields) HasSIPV4() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasSIPV6 reports whether SIPV6 flag is set.
+func (f *N6RoutingInformationFields) HasSIPV6() bool {
+	return has2ndBit(f.Flags)
+}
+
+// HasSPO reports whether SPO flag is set.
+func (f *N6RoutingInformationFields) HasSPO() bool {
+	return has3rdBit(f.Flags)
+}
+
+// HasDIPV4 reports whether DIPV4 flag is set.
+func (f *N6RoutingInformationFields) HasDIPV4() bool {
+	return has4thBit(f.Flags)
+}
+
+// HasDIPV6 reports whether DIPV6 flag is set.
+func (f *N6RoutingInformationFields) HasDIPV6() bool {
+	return has5thBit(f.Flags)
+}
+
+// HasDPO reports whether DPO flag is set.
+func (f *N6RoutingInformationFields) HasDPO() bool {
+	return has6thBit(f.Flags)
+}
+
+// ParseN6RoutingInformationFields parses b into N6RoutingInformationFields.
+func ParseN6RoutingInformationFields(b []byte) (*N6RoutingInformationFields, error) {
+	f := &N6RoutingInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *N6RoutingInformationFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	offset := 1
+
+	if f.HasSIPV4() {
+		if l < offset+4 {
+			return io.ErrUnexpectedEOF
+		}
+		f.SourceIPv4Address = net.IP(b[offset : offset+4])
+		offset += 4
+	}
+	if f.HasSIPV6() {
+		if l < offset+16 {
+			return io.ErrUnexpectedEOF
+		}
+		f.SourceIPv6Address = net.IP(b[offset : offset+16])
+		offset += 16
+	}
+	if f.HasSPO() {
+		if l < offset+2 {
+			return io.ErrUnexpectedEOF
+		}
+		f.SourcePortNumber = binary.BigEndian.Uint16(b[offset : offset+2])
+		offset += 2
+	}
+	if f.HasDIPV4() {
+		if l < offset+4 {
+			return io.ErrUnexpectedEOF
+		}
+		f.DestinationIPv4Address = net.IP(b[offset : offset+4])
+		offset += 4
+	}
+	if f.HasDIPV6() {
+		if l < offset+16 {
+			return io.ErrUnexpectedEOF
+		}
+		f.DestinationIPv6Address = net.IP(b[offset : offset+16])
+		offset += 16
+	}
+	if f.HasDPO() {
+		if l < offset+2 {
+			return io.ErrUnexpectedEOF
+		}
+		f.DestinationPortNumber = binary.BigEndian.Uint16(b[offset : offset+2])
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of N6RoutingInformationFields.
+func (f *N6RoutingInformationFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *N6RoutingInformationFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	offset := 1
+
+	if f.HasSIPV4() {
+		copy(b[offset:offset+4], f.SourceIPv4Address.To4())
+		offset += 4
+	}
+	if f.HasSIPV6() {
+		copy(b[offset:offset+16], f.SourceIPv6Address.To16())
+		offset += 16
+	}
+	if f.HasSPO() {
+		binary.BigEndian.PutUint16(b[offset:offset+2], f.SourcePortNumber)
+		offset += 2
+	}
+	if f.HasDIPV4() {
+		copy(b[offset:offset+4], f.DestinationIPv4Address.To4())
+		offset += 4
+	}
+	if f.HasDIPV6() {
+		copy(b[offset:offset+16], f.DestinationIPv6Address.To16())
+		offset += 16
+	}
+	if f.HasDPO() {
+		binary.BigEndian.PutUint16(b[offset:offset+2], f.DestinationPortNumber)
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *N6RoutingInformationFields) MarshalLen() int {
+	l := 1
+	if f.HasSIPV4() {
+		l += 4
+	}
+	if f.HasSIPV6() {
+		l += 16
+	}
+	if f.HasSPO() {
+		l += 2
+	}
+	if f.HasDIPV4() {
+		l += 4
+	}
+	if f.HasDIPV6() {
+		l += 16
+	}
+	if f.HasDPO() {
+		l += 2
+	}
+
+	return l
+}
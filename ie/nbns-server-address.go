@@ -0,0 +1,39 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"net"
+)
+
+// NewNBNSServerAddress creates a new NBNSServerAddress IE.
+func NewNBNSServerAddress(v4 net.IP) *IE {
+	return New(NBNSServerAddress, v4.To4())
+}
+
+// NBNSServerAddress returns NBNSServerAddress in net.IP if the type of IE matches.
+func (i *IE) NBNSServerAddress() (net.IP, error) {
+	switch i.Type {
+	case NBNSServerAddress:
+		if len(i.Payload) < net.IPv4len {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return net.IP(i.Payload[:net.IPv4len]), nil
+	case CreatedL2TPSession:
+		ies, err := i.CreatedL2TPSession()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == NBNSServerAddress {
+				return x.NBNSServerAddress()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
@@ -12,13 +12,38 @@ import (
 	"github.com/aalayanahmad/go-pfcp/internal/utils"
 )
 
+// NodeIDTypeValue is the value of the Node ID Type in NodeID IE.
+type NodeIDTypeValue uint8
+
 // NodeID definitions.
 const (
-	NodeIDIPv4Address uint8 = 0
-	NodeIDIPv6Address uint8 = 1
-	NodeIDFQDN        uint8 = 2
+	NodeIDIPv4Address NodeIDTypeValue = 0
+	NodeIDIPv6Address NodeIDTypeValue = 1
+	NodeIDFQDN        NodeIDTypeValue = 2
 )
 
+var nodeIDTypeValues = newEnum("NodeIDTypeValue", map[NodeIDTypeValue]string{
+	NodeIDIPv4Address: "NodeIDIPv4Address",
+	NodeIDIPv6Address: "NodeIDIPv6Address",
+	NodeIDFQDN:        "NodeIDFQDN",
+})
+
+// String returns the name of the NodeIDTypeValue, e.g., "NodeIDFQDN".
+func (v NodeIDTypeValue) String() string {
+	return nodeIDTypeValues.name(v)
+}
+
+// IsValid reports whether the NodeIDTypeValue is a defined value.
+func (v NodeIDTypeValue) IsValid() bool {
+	return nodeIDTypeValues.valid(v)
+}
+
+// NodeIDTypeValueByName returns the NodeIDTypeValue of the given name, e.g., "NodeIDFQDN".
+// The value in decimal is also accepted.
+func NodeIDTypeValueByName(name string) (NodeIDTypeValue, bool) {
+	return nodeIDTypeValues.byName(name)
+}
+
 // NewNodeID creates a new NodeID IE.
 //
 // Only one of the parameters should have a non-empty value(!="").
@@ -29,15 +54,15 @@ func NewNodeID(ipv4, ipv6, fqdn string) *IE {
 	switch {
 	case ipv4 != "":
 		p = make([]byte, 5)
-		p[0] = NodeIDIPv4Address
+		p[0] = uint8(NodeIDIPv4Address)
 		copy(p[1:], net.ParseIP(ipv4).To4())
 	case ipv6 != "":
 		p = make([]byte, 17)
-		p[0] = NodeIDIPv6Address
+		p[0] = uint8(NodeIDIPv6Address)
 		copy(p[1:], net.ParseIP(ipv6).To16())
 	case fqdn != "":
 		p = make([]byte, 2+len([]byte(fqdn)))
-		p[0] = NodeIDFQDN
+		p[0] = uint8(NodeIDFQDN)
 		copy(p[1:], utils.EncodeFQDN(fqdn))
 	default: // all params are empty
 		return nil
@@ -57,16 +82,16 @@ func NewNodeIDHeuristic(nodeID string) *IE {
 	if ip != nil {
 		if v4 := ip.To4(); v4 != nil { // IPv4
 			p = make([]byte, 5)
-			p[0] = NodeIDIPv4Address
+			p[0] = uint8(NodeIDIPv4Address)
 			copy(p[1:], v4)
 		} else { // IPv6
 			p = make([]byte, 17)
-			p[0] = NodeIDIPv6Address
+			p[0] = uint8(NodeIDIPv6Address)
 			copy(p[1:], ip.To16())
 		}
 	} else { // FQDN
 		p = make([]byte, 2+len([]byte(nodeID)))
-		p[0] = NodeIDFQDN
+		p[0] = uint8(NodeIDFQDN)
 		copy(p[1:], utils.EncodeFQDN(nodeID))
 	}
 
@@ -82,7 +107,7 @@ func (i *IE) NodeID() (string, error) {
 		return "", io.ErrUnexpectedEOF
 	}
 
-	switch i.Payload[0] {
+	switch NodeIDTypeValue(i.Payload[0]) {
 	case NodeIDIPv4Address:
 		return net.IP(i.Payload[1:]).To4().String(), nil
 	case NodeIDIPv6Address:
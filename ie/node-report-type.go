@@ -4,18 +4,93 @@
 
 package ie
 
+import (
+	"strconv"
+	"strings"
+)
+
+// NodeReportTypeValue is the value of NodeReportType IE, which is a set of the
+// types of the reports.
+type NodeReportTypeValue uint8
+
+// NodeReportType definitions.
+const (
+	NodeReportTypeUPFR NodeReportTypeValue = 0x01
+	NodeReportTypeUPRR NodeReportTypeValue = 0x02
+	NodeReportTypeCKDR NodeReportTypeValue = 0x04
+	NodeReportTypeGPQR NodeReportTypeValue = 0x08
+	NodeReportTypePURU NodeReportTypeValue = 0x10
+	NodeReportTypeVSR  NodeReportTypeValue = 0x20
+)
+
+var nodeReportTypeValues = newEnum("NodeReportTypeValue", map[NodeReportTypeValue]string{
+	NodeReportTypeUPFR: "NodeReportTypeUPFR",
+	NodeReportTypeUPRR: "NodeReportTypeUPRR",
+	NodeReportTypeCKDR: "NodeReportTypeCKDR",
+	NodeReportTypeGPQR: "NodeReportTypeGPQR",
+	NodeReportTypePURU: "NodeReportTypePURU",
+	NodeReportTypeVSR:  "NodeReportTypeVSR",
+})
+
+// String returns the names of the report types joined with "|", e.g.,
+// "NodeReportTypeUPFR|NodeReportTypeGPQR".
+func (v NodeReportTypeValue) String() string {
+	if v == 0 {
+		return "0"
+	}
+
+	var names []string
+	for bit := NodeReportTypeValue(1); bit != 0; bit <<= 1 {
+		if v&bit != 0 {
+			names = append(names, nodeReportTypeValues.name(bit))
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// IsValid reports whether the NodeReportTypeValue has only the defined report types.
+func (v NodeReportTypeValue) IsValid() bool {
+	for bit := NodeReportTypeValue(1); bit != 0; bit <<= 1 {
+		if v&bit != 0 && !nodeReportTypeValues.valid(bit) {
+			return false
+		}
+	}
+	return true
+}
+
+// NodeReportTypeValueByName returns the NodeReportTypeValue of the given names
+// joined with "|", e.g., "NodeReportTypeUPFR|NodeReportTypeGPQR". The value in
+// decimal is also accepted.
+func NodeReportTypeValueByName(name string) (NodeReportTypeValue, bool) {
+	if n, err := strconv.ParseUint(name, 10, 8); err == nil {
+		v := NodeReportTypeValue(n)
+		return v, v.IsValid()
+	}
+
+	var v NodeReportTypeValue
+	for _, n := range strings.Split(name, "|") {
+		bit, ok := nodeReportTypeValues.values[n]
+		if !ok {
+			return 0, false
+		}
+		v |= bit
+	}
+	return v, true
+}
+
 // NewNodeReportType creates a new NodeReportType IE.
-func NewNodeReportType(flags uint8) *IE {
-	return newUint8ValIE(NodeReportType, flags)
+func NewNodeReportType(flags NodeReportTypeValue) *IE {
+	return newUint8ValIE(NodeReportType, uint8(flags))
 }
 
-// NodeReportType returns NodeReportType in uint8 if the type of IE matches.
-func (i *IE) NodeReportType() (uint8, error) {
+// NodeReportType returns NodeReportType in NodeReportTypeValue if the type of IE matches.
+func (i *IE) NodeReportType() (NodeReportTypeValue, error) {
 	if i.Type != NodeReportType {
 		return 0, &InvalidTypeError{Type: i.Type}
 	}
 
-	return i.ValueAsUint8()
+	v, err := i.ValueAsUint8()
+	return NodeReportTypeValue(v), err
 }
 
 // HasUPFR reports whether an IE has UPFR bit.
@@ -13,7 +13,7 @@ func NewNonTGPPAccessForwardingActionInformation(ies ...*IE) *IE {
 func (i *IE) NonTGPPAccessForwardingActionInformation() ([]*IE, error) {
 	switch i.Type {
 	case NonTGPPAccessForwardingActionInformation:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreateMAR:
 		ies, err := i.CreateMAR()
 		if err != nil {
@@ -0,0 +1,102 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewNotificationCorrelationID creates a new NotificationCorrelationID IE.
+//
+// The ID is the notification correlation ID given by the NF consumer that subscribes
+// the QoS monitoring reports, which the UP function puts in the reports sent directly
+// to the EventNotificationURI.
+func NewNotificationCorrelationID(id string) *IE {
+	fields := NewNotificationCorrelationIDFields(id)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(NotificationCorrelationID, b)
+}
+
+// NotificationCorrelationID returns NotificationCorrelationID in structured format if the type of IE matches.
+func (i *IE) NotificationCorrelationID() (*NotificationCorrelationIDFields, error) {
+	switch i.Type {
+	case NotificationCorrelationID:
+		fields, err := ParseNotificationCorrelationIDFields(i.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	case DirectReportingInformation:
+		ies, err := i.DirectReportingInformation()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == NotificationCorrelationID {
+				return x.NotificationCorrelationID()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// NotificationCorrelationIDFields represents a fields contained in NotificationCorrelationID IE.
+type NotificationCorrelationIDFields struct {
+	NotificationCorrelationID string
+}
+
+// NewNotificationCorrelationIDFields creates a new NotificationCorrelationIDFields.
+func NewNotificationCorrelationIDFields(id string) *NotificationCorrelationIDFields {
+	return &NotificationCorrelationIDFields{NotificationCorrelationID: id}
+}
+
+// ParseNotificationCorrelationIDFields parses b into NotificationCorrelationIDFields.
+func ParseNotificationCorrelationIDFields(b []byte) (*NotificationCorrelationIDFields, error) {
+	f := &NotificationCorrelationIDFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *NotificationCorrelationIDFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.NotificationCorrelationID = string(b)
+	return nil
+}
+
+// Marshal returns the serialized bytes of NotificationCorrelationIDFields.
+func (f *NotificationCorrelationIDFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *NotificationCorrelationIDFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	copy(b, f.NotificationCorrelationID)
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *NotificationCorrelationIDFields) MarshalLen() int {
+	return len(f.NotificationCorrelationID)
+}
@@ -0,0 +1,107 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewOffendingIEInformation creates a new OffendingIEInformation IE.
+func NewOffendingIEInformation(itype uint16, value []byte) *IE {
+	fields := NewOffendingIEInformationFields(itype, value)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(OffendingIEInformation, b)
+}
+
+// OffendingIEInformation returns OffendingIEInformation in structured format if the type of IE matches.
+func (i *IE) OffendingIEInformation() (*OffendingIEInformationFields, error) {
+	switch i.Type {
+	case OffendingIEInformation:
+		fields, err := ParseOffendingIEInformationFields(i.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	case PartialFailureInformation:
+		ies, err := i.PartialFailureInformation()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == OffendingIEInformation {
+				return x.OffendingIEInformation()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// OffendingIEInformationFields represents a fields contained in OffendingIEInformation IE.
+type OffendingIEInformationFields struct {
+	Type  uint16
+	Value []byte
+}
+
+// NewOffendingIEInformationFields creates a new OffendingIEInformationFields.
+func NewOffendingIEInformationFields(itype uint16, value []byte) *OffendingIEInformationFields {
+	return &OffendingIEInformationFields{
+		Type:  itype,
+		Value: value,
+	}
+}
+
+// ParseOffendingIEInformationFields parses b into OffendingIEInformationFields.
+func ParseOffendingIEInformationFields(b []byte) (*OffendingIEInformationFields, error) {
+	f := &OffendingIEInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *OffendingIEInformationFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 2 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Type = binary.BigEndian.Uint16(b[0:2])
+	f.Value = b[2:]
+	return nil
+}
+
+// Marshal returns the serialized bytes of OffendingIEInformationFields.
+func (f *OffendingIEInformationFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *OffendingIEInformationFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	binary.BigEndian.PutUint16(b[0:2], f.Type)
+	copy(b[2:], f.Value)
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *OffendingIEInformationFields) MarshalLen() int {
+	return 2 + len(f.Value)
+}
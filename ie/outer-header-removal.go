@@ -6,8 +6,53 @@ package ie
 
 import "io"
 
+// OuterHeaderRemovalDescriptionValue is the value of Outer Header Removal Description
+// in OuterHeaderRemoval IE.
+type OuterHeaderRemovalDescriptionValue uint8
+
+// Outer Header Removal Description definitions.
+const (
+	OuterHeaderRemovalGTPUUDPIPv4 OuterHeaderRemovalDescriptionValue = 0
+	OuterHeaderRemovalGTPUUDPIPv6 OuterHeaderRemovalDescriptionValue = 1
+	OuterHeaderRemovalUDPIPv4     OuterHeaderRemovalDescriptionValue = 2
+	OuterHeaderRemovalUDPIPv6     OuterHeaderRemovalDescriptionValue = 3
+	OuterHeaderRemovalIPv4        OuterHeaderRemovalDescriptionValue = 4
+	OuterHeaderRemovalIPv6        OuterHeaderRemovalDescriptionValue = 5
+	OuterHeaderRemovalGTPUUDPIP   OuterHeaderRemovalDescriptionValue = 6
+	OuterHeaderRemovalVLANSTAG    OuterHeaderRemovalDescriptionValue = 7
+	OuterHeaderRemovalSTAGAndCTAG OuterHeaderRemovalDescriptionValue = 8
+)
+
+var outerHeaderRemovalDescriptionValues = newEnum("OuterHeaderRemovalDescriptionValue", map[OuterHeaderRemovalDescriptionValue]string{
+	OuterHeaderRemovalGTPUUDPIPv4: "OuterHeaderRemovalGTPUUDPIPv4",
+	OuterHeaderRemovalGTPUUDPIPv6: "OuterHeaderRemovalGTPUUDPIPv6",
+	OuterHeaderRemovalUDPIPv4:     "OuterHeaderRemovalUDPIPv4",
+	OuterHeaderRemovalUDPIPv6:     "OuterHeaderRemovalUDPIPv6",
+	OuterHeaderRemovalIPv4:        "OuterHeaderRemovalIPv4",
+	OuterHeaderRemovalIPv6:        "OuterHeaderRemovalIPv6",
+	OuterHeaderRemovalGTPUUDPIP:   "OuterHeaderRemovalGTPUUDPIP",
+	OuterHeaderRemovalVLANSTAG:    "OuterHeaderRemovalVLANSTAG",
+	OuterHeaderRemovalSTAGAndCTAG: "OuterHeaderRemovalSTAGAndCTAG",
+})
+
+// String returns the name of the OuterHeaderRemovalDescriptionValue, e.g., "OuterHeaderRemovalGTPUUDPIPv4".
+func (v OuterHeaderRemovalDescriptionValue) String() string {
+	return outerHeaderRemovalDescriptionValues.name(v)
+}
+
+// IsValid reports whether the OuterHeaderRemovalDescriptionValue is a defined value.
+func (v OuterHeaderRemovalDescriptionValue) IsValid() bool {
+	return outerHeaderRemovalDescriptionValues.valid(v)
+}
+
+// OuterHeaderRemovalDescriptionValueByName returns the OuterHeaderRemovalDescriptionValue of the given name, e.g., "OuterHeaderRemovalGTPUUDPIPv4".
+// The value in decimal is also accepted.
+func OuterHeaderRemovalDescriptionValueByName(name string) (OuterHeaderRemovalDescriptionValue, bool) {
+	return outerHeaderRemovalDescriptionValues.byName(name)
+}
+
 // NewOuterHeaderRemoval creates a new OuterHeaderRemoval IE.
-func NewOuterHeaderRemoval(desc, ext uint8) *IE {
+func NewOuterHeaderRemoval(desc OuterHeaderRemovalDescriptionValue, ext uint8) *IE {
 	return newUint16ValIE(OuterHeaderRemoval, uint16(desc)<<8|uint16(ext))
 }
 
@@ -50,14 +95,15 @@ func (i *IE) OuterHeaderRemoval() ([]byte, error) {
 	}
 }
 
-// OuterHeaderRemovalDescription returns OuterHeaderRemovalDescription in uint8 if the type of IE matches.
-func (i *IE) OuterHeaderRemovalDescription() (uint8, error) {
+// OuterHeaderRemovalDescription returns OuterHeaderRemovalDescription in
+// OuterHeaderRemovalDescriptionValue if the type of IE matches.
+func (i *IE) OuterHeaderRemovalDescription() (OuterHeaderRemovalDescriptionValue, error) {
 	v, err := i.OuterHeaderRemoval()
 	if err != nil {
 		return 0, err
 	}
 
-	return v[0], nil
+	return OuterHeaderRemovalDescriptionValue(v[0]), nil
 }
 
 // GTPUExtensionHeaderDeletion returns GTPUExtensionHeaderDeletion in uint8 if the type of IE matches.
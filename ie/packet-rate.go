@@ -9,17 +9,44 @@ import (
 	"io"
 )
 
+// TimeUnitValue is the value of the Time Unit in PacketRate IE.
+type TimeUnitValue uint8
+
 // Time Unit definitions
 const (
-	TimeUnitMinute   uint8 = 0
-	TimeUnit6Minutes uint8 = 1
-	TimeUnitHour     uint8 = 2
-	TimeUnitDay      uint8 = 3
-	TimeUnitWeek     uint8 = 4
+	TimeUnitMinute   TimeUnitValue = 0
+	TimeUnit6Minutes TimeUnitValue = 1
+	TimeUnitHour     TimeUnitValue = 2
+	TimeUnitDay      TimeUnitValue = 3
+	TimeUnitWeek     TimeUnitValue = 4
 )
 
+var timeUnitValues = newEnum("TimeUnitValue", map[TimeUnitValue]string{
+	TimeUnitMinute:   "TimeUnitMinute",
+	TimeUnit6Minutes: "TimeUnit6Minutes",
+	TimeUnitHour:     "TimeUnitHour",
+	TimeUnitDay:      "TimeUnitDay",
+	TimeUnitWeek:     "TimeUnitWeek",
+})
+
+// String returns the name of the TimeUnitValue, e.g., "TimeUnitHour".
+func (v TimeUnitValue) String() string {
+	return timeUnitValues.name(v)
+}
+
+// IsValid reports whether the TimeUnitValue is a defined value.
+func (v TimeUnitValue) IsValid() bool {
+	return timeUnitValues.valid(v)
+}
+
+// TimeUnitValueByName returns the TimeUnitValue of the given name, e.g., "TimeUnitHour".
+// The value in decimal is also accepted.
+func TimeUnitValueByName(name string) (TimeUnitValue, bool) {
+	return timeUnitValues.byName(name)
+}
+
 // NewPacketRate creates a new PacketRate IE.
-func NewPacketRate(flags uint8, ulunit uint8, ulpackets uint16, dlunit uint8, dlpackets uint16) *IE {
+func NewPacketRate(flags uint8, ulunit TimeUnitValue, ulpackets uint16, dlunit TimeUnitValue, dlpackets uint16) *IE {
 	fields := NewPacketRateFields(flags, ulunit, ulpackets, dlunit, dlpackets)
 	b, err := fields.Marshal()
 	if err != nil {
@@ -93,14 +120,14 @@ func (i *IE) HasULPR() bool {
 // PacketRateFields represents a fields contained in PacketRate IE.
 type PacketRateFields struct {
 	Flags              uint8
-	UplinkTimeUnit     uint8
-	DownlinkTimeUnit   uint8
+	UplinkTimeUnit     TimeUnitValue
+	DownlinkTimeUnit   TimeUnitValue
 	UplinkPacketRate   uint16
 	DownlinkPacketRate uint16
 }
 
 // NewPacketRateFields creates a new PacketRateFields.
-func NewPacketRateFields(flags uint8, ulunit uint8, ulpackets uint16, dlunit uint8, dlpackets uint16) *PacketRateFields {
+func NewPacketRateFields(flags uint8, ulunit TimeUnitValue, ulpackets uint16, dlunit TimeUnitValue, dlpackets uint16) *PacketRateFields {
 	f := &PacketRateFields{Flags: flags}
 
 	if has1stBit(flags) {
@@ -139,7 +166,7 @@ func (f *PacketRateFields) UnmarshalBinary(b []byte) error {
 		if l < offset+3 {
 			return io.ErrUnexpectedEOF
 		}
-		f.UplinkTimeUnit = b[offset]
+		f.UplinkTimeUnit = TimeUnitValue(b[offset])
 		f.UplinkPacketRate = binary.BigEndian.Uint16(b[offset+1 : offset+3])
 		offset += 3
 	}
@@ -148,7 +175,7 @@ func (f *PacketRateFields) UnmarshalBinary(b []byte) error {
 		if l < offset+3 {
 			return io.ErrUnexpectedEOF
 		}
-		f.DownlinkTimeUnit = b[offset]
+		f.DownlinkTimeUnit = TimeUnitValue(b[offset])
 		f.DownlinkPacketRate = binary.BigEndian.Uint16(b[offset+1 : offset+3])
 	}
 
@@ -175,13 +202,13 @@ func (f *PacketRateFields) MarshalTo(b []byte) error {
 	offset := 1
 
 	if has1stBit(f.Flags) {
-		b[offset] = f.UplinkTimeUnit
+		b[offset] = uint8(f.UplinkTimeUnit)
 		binary.BigEndian.PutUint16(b[offset+1:offset+3], f.UplinkPacketRate)
 		offset += 3
 	}
 
 	if has2ndBit(f.Flags) {
-		b[offset] = f.DownlinkTimeUnit
+		b[offset] = uint8(f.DownlinkTimeUnit)
 		binary.BigEndian.PutUint16(b[offset+1:offset+3], f.DownlinkPacketRate)
 	}
 
@@ -13,7 +13,7 @@ func NewPDI(ies ...*IE) *IE {
 func (i *IE) PDI() ([]*IE, error) {
 	switch i.Type {
 	case PDI:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreatePDR:
 		ies, err := i.CreatePDR()
 		if err != nil {
@@ -4,26 +4,54 @@
 
 package ie
 
+// PDNTypeValue is the value of PDNType IE.
+type PDNTypeValue uint8
+
 // PDNType definitions.
 const (
-	_               uint8 = 0
-	PDNTypeIPv4     uint8 = 1
-	PDNTypeIPv6     uint8 = 2
-	PDNTypeIPv4v6   uint8 = 3
-	PDNTypeNonIP    uint8 = 4
-	PDNTypeEthernet uint8 = 5
+	_               PDNTypeValue = 0
+	PDNTypeIPv4     PDNTypeValue = 1
+	PDNTypeIPv6     PDNTypeValue = 2
+	PDNTypeIPv4v6   PDNTypeValue = 3
+	PDNTypeNonIP    PDNTypeValue = 4
+	PDNTypeEthernet PDNTypeValue = 5
 )
 
+var pDNTypeValues = newEnum("PDNTypeValue", map[PDNTypeValue]string{
+	PDNTypeIPv4:     "PDNTypeIPv4",
+	PDNTypeIPv6:     "PDNTypeIPv6",
+	PDNTypeIPv4v6:   "PDNTypeIPv4v6",
+	PDNTypeNonIP:    "PDNTypeNonIP",
+	PDNTypeEthernet: "PDNTypeEthernet",
+})
+
+// String returns the name of the PDNTypeValue, e.g., "PDNTypeIPv4".
+func (v PDNTypeValue) String() string {
+	return pDNTypeValues.name(v)
+}
+
+// IsValid reports whether the PDNTypeValue is a defined value.
+func (v PDNTypeValue) IsValid() bool {
+	return pDNTypeValues.valid(v)
+}
+
+// PDNTypeValueByName returns the PDNTypeValue of the given name, e.g., "PDNTypeIPv4".
+// The value in decimal is also accepted.
+func PDNTypeValueByName(name string) (PDNTypeValue, bool) {
+	return pDNTypeValues.byName(name)
+}
+
 // NewPDNType creates a new PDNType IE.
-func NewPDNType(typ uint8) *IE {
-	return newUint8ValIE(PDNType, typ)
+func NewPDNType(typ PDNTypeValue) *IE {
+	return newUint8ValIE(PDNType, uint8(typ))
 }
 
-// PDNType returns PDNType in uint8 if the type of IE matches.
-func (i *IE) PDNType() (uint8, error) {
+// PDNType returns PDNType in PDNTypeValue if the type of IE matches.
+func (i *IE) PDNType() (PDNTypeValue, error) {
 	if i.Type != PDNType {
 		return 0, &InvalidTypeError{Type: i.Type}
 	}
 
-	return i.ValueAsUint8()
+	v, err := i.ValueAsUint8()
+	return PDNTypeValue(v), err
 }
@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewPeerUPRestartReport creates a new PeerUPRestartReport IE.
+func NewPeerUPRestartReport(ies ...*IE) *IE {
+	return newGroupedIE(PeerUPRestartReport, 0, ies...)
+}
+
+// PeerUPRestartReport returns the IEs above PeerUPRestartReport if the type of IE matches.
+func (i *IE) PeerUPRestartReport() ([]*IE, error) {
+	if i.Type != PeerUPRestartReport {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
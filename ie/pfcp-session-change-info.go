@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewPFCPSessionChangeInfo creates a new PFCPSessionChangeInfo IE.
+func NewPFCPSessionChangeInfo(ies ...*IE) *IE {
+	return newGroupedIE(PFCPSessionChangeInfo, 0, ies...)
+}
+
+// PFCPSessionChangeInfo returns the IEs above PFCPSessionChangeInfo if the type of IE matches.
+func (i *IE) PFCPSessionChangeInfo() ([]*IE, error) {
+	if i.Type != PFCPSessionChangeInfo {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
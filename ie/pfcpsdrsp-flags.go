@@ -0,0 +1,29 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewPFCPSDRspFlags creates a new PFCPSDRspFlags IE.
+func NewPFCPSDRspFlags(flags uint8) *IE {
+	return newUint8ValIE(PFCPSDRspFlags, flags)
+}
+
+// PFCPSDRspFlags returns PFCPSDRspFlags in uint8 if the type of IE matches.
+func (i *IE) PFCPSDRspFlags() (uint8, error) {
+	if i.Type != PFCPSDRspFlags {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.ValueAsUint8()
+}
+
+// HasPURU reports whether an IE has PURU bit.
+func (i *IE) HasPURU() bool {
+	v, err := i.PFCPSDRspFlags()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
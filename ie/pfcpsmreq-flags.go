@@ -4,6 +4,62 @@
 
 package ie
 
+// PFCPSMReqFlagSet is a set of the flags in PFCPSMReqFlags IE.
+type PFCPSMReqFlagSet uint8
+
+// PFCPSMReqFlags flag definitions.
+const (
+	PFCPSMReqFlagsDROBU PFCPSMReqFlagSet = 1 << iota
+	PFCPSMReqFlagsSNDEM
+	PFCPSMReqFlagsQAURR
+)
+
+var pfcpSMReqFlagSet = &flagSet{
+	names: []string{
+		"DROBU", "SNDEM", "QAURR",
+	},
+	minOctets: 1,
+}
+
+// Has reports whether all the given flags are set.
+func (f PFCPSMReqFlagSet) Has(flags PFCPSMReqFlagSet) bool {
+	return f&flags == flags
+}
+
+// Set sets the given flags.
+func (f *PFCPSMReqFlagSet) Set(flags PFCPSMReqFlagSet) {
+	*f |= flags
+}
+
+// Clear clears the given flags.
+func (f *PFCPSMReqFlagSet) Clear(flags PFCPSMReqFlagSet) {
+	*f &^= flags
+}
+
+// String returns the names of the set flags joined with "|", e.g., "DROBU|QAURR".
+func (f PFCPSMReqFlagSet) String() string {
+	return pfcpSMReqFlagSet.string(uint64(f))
+}
+
+// Octets returns the flags serialized in as many octets as required by the set flags.
+func (f PFCPSMReqFlagSet) Octets() []byte {
+	return pfcpSMReqFlagSet.octets(uint64(f))
+}
+
+// NewPFCPSMReqFlagSet creates a new PFCPSMReqFlags IE from the flag set.
+func NewPFCPSMReqFlagSet(flags PFCPSMReqFlagSet) *IE {
+	return New(PFCPSMReqFlags, flags.Octets())
+}
+
+// PFCPSMReqFlagSet returns PFCPSMReqFlags in PFCPSMReqFlagSet if the type of IE matches.
+func (i *IE) PFCPSMReqFlagSet() (PFCPSMReqFlagSet, error) {
+	v, err := i.PFCPSMReqFlags()
+	if err != nil {
+		return 0, err
+	}
+	return PFCPSMReqFlagSet(v), nil
+}
+
 // NewPFCPSMReqFlags creates a new PFCPSMReqFlags IE.
 func NewPFCPSMReqFlags(flag uint8) *IE {
 	return newUint8ValIE(PFCPSMReqFlags, flag)
@@ -13,7 +13,7 @@ func NewPFDContext(contents ...*IE) *IE {
 func (i *IE) PFDContext() ([]*IE, error) {
 	switch i.Type {
 	case PFDContext:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case ApplicationIDsPFDs:
 		ies, err := i.ApplicationIDsPFDs()
 		if err != nil {
@@ -13,7 +13,7 @@ func NewPMFParameters(info *IE) *IE {
 func (i *IE) PMFParameters() ([]*IE, error) {
 	switch i.Type {
 	case PMFParameters:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case ATSSSControlParameters:
 		ies, err := i.ATSSSControlParameters()
 		if err != nil {
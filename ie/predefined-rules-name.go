@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewPredefinedRulesName creates a new PredefinedRulesName IE.
+func NewPredefinedRulesName(v string) *IE {
+	return newStringIE(PredefinedRulesName, v)
+}
+
+// PredefinedRulesName returns PredefinedRulesName in string if the type of IE matches.
+func (i *IE) PredefinedRulesName() (string, error) {
+	if i.Type != PredefinedRulesName {
+		return "", &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.ValueAsString()
+}
@@ -4,25 +4,53 @@
 
 package ie
 
+// PriorityValue is the value of Priority IE.
+type PriorityValue uint8
+
 // Priority definitions.
 const (
-	PriorityActive    uint8 = 0
-	PriorityStandby   uint8 = 1
-	PriorityNoStandby uint8 = 2
-	PriorityHigh      uint8 = 3
-	PriorityLow       uint8 = 4
+	PriorityActive    PriorityValue = 0
+	PriorityStandby   PriorityValue = 1
+	PriorityNoStandby PriorityValue = 2
+	PriorityHigh      PriorityValue = 3
+	PriorityLow       PriorityValue = 4
 )
 
+var priorityValues = newEnum("PriorityValue", map[PriorityValue]string{
+	PriorityActive:    "PriorityActive",
+	PriorityStandby:   "PriorityStandby",
+	PriorityNoStandby: "PriorityNoStandby",
+	PriorityHigh:      "PriorityHigh",
+	PriorityLow:       "PriorityLow",
+})
+
+// String returns the name of the PriorityValue, e.g., "PriorityActive".
+func (v PriorityValue) String() string {
+	return priorityValues.name(v)
+}
+
+// IsValid reports whether the PriorityValue is a defined value.
+func (v PriorityValue) IsValid() bool {
+	return priorityValues.valid(v)
+}
+
+// PriorityValueByName returns the PriorityValue of the given name, e.g., "PriorityActive".
+// The value in decimal is also accepted.
+func PriorityValueByName(name string) (PriorityValue, bool) {
+	return priorityValues.byName(name)
+}
+
 // NewPriority creates a new Priority IE.
-func NewPriority(priority uint8) *IE {
-	return newUint8ValIE(Priority, priority&0x0f)
+func NewPriority(priority PriorityValue) *IE {
+	return newUint8ValIE(Priority, uint8(priority)&0x0f)
 }
 
-// Priority returns Priority in uint8 if the type of IE matches.
-func (i *IE) Priority() (uint8, error) {
+// Priority returns Priority in PriorityValue if the type of IE matches.
+func (i *IE) Priority() (PriorityValue, error) {
 	switch i.Type {
 	case Priority:
-		return i.ValueAsUint8()
+		v, err := i.ValueAsUint8()
+		return PriorityValue(v), err
 	case CreateMAR:
 		ies, err := i.CreateMAR()
 		if err != nil {
@@ -0,0 +1,180 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewProtocolDescription creates a new ProtocolDescription IE.
+//
+// The transport protocol, the type and ID of the RTP header extension, and the
+// list of the RTP payload types describe how the UP function finds the PDU Sets in
+// the media. They are encoded only when the TP (0x01), RHE (0x02) and RPT (0x04)
+// flags are set respectively.
+func NewProtocolDescription(flags uint8, tp MediaTransportProtocolValue, extType, extID uint8, payloadTypes []uint8) *IE {
+	fields := NewProtocolDescriptionFields(flags, tp, extType, extID, payloadTypes)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(ProtocolDescription, b)
+}
+
+// ProtocolDescription returns ProtocolDescription in structured format if the type of IE matches.
+func (i *IE) ProtocolDescription() (*ProtocolDescriptionFields, error) {
+	if i.Type != ProtocolDescription {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	fields, err := ParseProtocolDescriptionFields(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// ProtocolDescriptionFields represents a fields contained in ProtocolDescription IE.
+type ProtocolDescriptionFields struct {
+	Flags                  uint8
+	TransportProtocol      MediaTransportProtocolValue
+	RTPHeaderExtensionType uint8
+	RTPHeaderExtensionID   uint8
+	RTPPayloadTypes        []uint8
+}
+
+// NewProtocolDescriptionFields creates a new ProtocolDescriptionFields.
+func NewProtocolDescriptionFields(flags uint8, tp MediaTransportProtocolValue, extType, extID uint8, payloadTypes []uint8) *ProtocolDescriptionFields {
+	f := &ProtocolDescriptionFields{Flags: flags}
+
+	if f.HasTP() {
+		f.TransportProtocol = tp
+	}
+	if f.HasRHE() {
+		f.RTPHeaderExtensionType = extType
+		f.RTPHeaderExtensionID = extID
+	}
+	if f.HasRPT() {
+		f.RTPPayloadTypes = payloadTypes
+	}
+
+	return f
+}
+
+// HasTP reports whether TP flag is set.
+func (f *ProtocolDescriptionFields) HasTP() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasRHE reports whether RHE flag is set.
+func (f *ProtocolDescriptionFields) HasRHE() bool {
+	return has2ndBit(f.Flags)
+}
+
+// HasRPT reports whether RPT flag is set.
+func (f *ProtocolDescriptionFields) HasRPT() bool {
+	return has3rdBit(f.Flags)
+}
+
+// ParseProtocolDescriptionFields parses b into ProtocolDescriptionFields.
+func ParseProtocolDescriptionFields(b []byte) (*ProtocolDescriptionFields, error) {
+	f := &ProtocolDescriptionFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *ProtocolDescriptionFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	offset := 1
+
+	if f.HasTP() {
+		if l < offset+1 {
+			return io.ErrUnexpectedEOF
+		}
+		f.TransportProtocol = MediaTransportProtocolValue(b[offset])
+		offset++
+	}
+	if f.HasRHE() {
+		if l < offset+2 {
+			return io.ErrUnexpectedEOF
+		}
+		f.RTPHeaderExtensionType = b[offset]
+		f.RTPHeaderExtensionID = b[offset+1]
+		offset += 2
+	}
+	if f.HasRPT() {
+		if l < offset+1 {
+			return io.ErrUnexpectedEOF
+		}
+		n := int(b[offset])
+		offset++
+		if l < offset+n {
+			return io.ErrUnexpectedEOF
+		}
+		f.RTPPayloadTypes = b[offset : offset+n]
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of ProtocolDescriptionFields.
+func (f *ProtocolDescriptionFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *ProtocolDescriptionFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	offset := 1
+
+	if f.HasTP() {
+		b[offset] = uint8(f.TransportProtocol)
+		offset++
+	}
+	if f.HasRHE() {
+		b[offset] = f.RTPHeaderExtensionType
+		b[offset+1] = f.RTPHeaderExtensionID
+		offset += 2
+	}
+	if f.HasRPT() {
+		b[offset] = uint8(len(f.RTPPayloadTypes))
+		copy(b[offset+1:], f.RTPPayloadTypes)
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *ProtocolDescriptionFields) MarshalLen() int {
+	l := 1
+	if f.HasTP() {
+		l++
+	}
+	if f.HasRHE() {
+		l += 2
+	}
+	if f.HasRPT() {
+		l += 1 + len(f.RTPPayloadTypes)
+	}
+
+	return l
+}
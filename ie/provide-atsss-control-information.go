@@ -15,5 +15,5 @@ func (i *IE) ProvideATSSSControlInformation() ([]*IE, error) {
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
 
-	return ParseMultiIEs(i.Payload)
+	return i.childIEs()
 }
@@ -0,0 +1,29 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewQERIndications creates a new QERIndications IE.
+func NewQERIndications(flags uint8) *IE {
+	return newUint8ValIE(QERIndications, flags)
+}
+
+// QERIndications returns QERIndications in uint8 if the type of IE matches.
+func (i *IE) QERIndications() (uint8, error) {
+	if i.Type != QERIndications {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.ValueAsUint8()
+}
+
+// HasIQFISN reports whether an IE has IQFISN bit.
+func (i *IE) HasIQFISN() bool {
+	v, err := i.QERIndications()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
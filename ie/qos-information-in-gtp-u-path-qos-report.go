@@ -13,7 +13,7 @@ func NewQoSInformationInGTPUPathQoSReport(ies ...*IE) *IE {
 func (i *IE) QoSInformationInGTPUPathQoSReport() ([]*IE, error) {
 	switch i.Type {
 	case QoSInformationInGTPUPathQoSReport:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case GTPUPathQoSReport:
 		ies, err := i.GTPUPathQoSReport()
 		if err != nil {
@@ -13,7 +13,7 @@ func NewQoSMonitoringPerQoSFlowControlInformation(ies ...*IE) *IE {
 func (i *IE) QoSMonitoringPerQoSFlowControlInformation() ([]*IE, error) {
 	switch i.Type {
 	case QoSMonitoringPerQoSFlowControlInformation:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreateSRR:
 		ies, err := i.CreateSRR()
 		if err != nil {
@@ -13,7 +13,7 @@ func NewQoSMonitoringReport(ies ...*IE) *IE {
 func (i *IE) QoSMonitoringReport() ([]*IE, error) {
 	switch i.Type {
 	case QoSMonitoringReport:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case SessionReport:
 		ies, err := i.SessionReport()
 		if err != nil {
@@ -0,0 +1,237 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Match is an IE found by Find with its location.
+type Match struct {
+	// IE is the IE found.
+	IE *IE
+
+	// Path is the human-readable location of the IE, e.g., "CreatePDR[1]/PDI/FTEID".
+	// The index is the position among the siblings of the same type, and is omitted
+	// when the IE is the only one of its type in the parent.
+	Path string
+
+	// Index is the list of the positions at each depth. The first one is the position
+	// in the list given to Find, and the rest can be given to ReplaceAt, InsertAt and
+	// RemoveAt of the top-level IE to modify the IE found.
+	Index []int
+}
+
+// Query is a compiled query to look up the IEs in nested grouped IEs.
+//
+// A query is a list of steps separated by "/", each of which is an IE name (the
+// same as the name of the constant, e.g., "CreatePDR"), the IE type in decimal,
+// or "*" for any type. A step can optionally have one of the following selectors.
+//
+//   - [*]: all the IEs of the type. This is the same as no selector.
+//   - [n]: the n-th IE (0-origin) among the siblings of the same type.
+//   - [Name=value]: the IEs that have a child IE of the Name whose value is equal
+//     to the value, either as an unsigned integer or as a string.
+//
+// For example, "CreatePDR[*]/PDI/FTEID" matches all the F-TEIDs in the PDIs of
+// all the CreatePDRs, and "CreateFAR[FARID=3]/ForwardingParameters" matches the
+// ForwardingParameters in the CreateFAR whose FARID is 3.
+type Query struct {
+	query string
+	steps []queryStep
+}
+
+type queryStep struct {
+	anyType bool
+	itype   uint16
+
+	// selector. index < 0 means no index.
+	index    int
+	hasChild bool
+	child    uint16
+	value    string
+}
+
+// CompileQuery parses the query so that it can be used multiple times.
+func CompileQuery(query string) (*Query, error) {
+	if query == "" {
+		return nil, &InvalidQueryError{Query: query, Reason: "empty query"}
+	}
+
+	q := &Query{query: query}
+	for _, s := range strings.Split(query, "/") {
+		st, reason := parseQueryStep(s)
+		if reason != "" {
+			return nil, &InvalidQueryError{Query: query, Reason: reason}
+		}
+		q.steps = append(q.steps, st)
+	}
+	return q, nil
+}
+
+func parseQueryStep(s string) (queryStep, string) {
+	st := queryStep{index: -1}
+
+	name, sel := s, ""
+	if n := strings.IndexByte(s, '['); n >= 0 {
+		if !strings.HasSuffix(s, "]") {
+			return st, "missing ] in " + strconv.Quote(s)
+		}
+		name, sel = s[:n], s[n+1:len(s)-1]
+	}
+
+	switch name {
+	case "":
+		return st, "empty IE name"
+	case "*":
+		st.anyType = true
+	default:
+		t, ok := TypeByName(name)
+		if !ok {
+			return st, "unknown IE name " + strconv.Quote(name)
+		}
+		st.itype = t
+	}
+
+	switch {
+	case sel == "" || sel == "*":
+	case strings.IndexByte(sel, '=') >= 0:
+		n := strings.IndexByte(sel, '=')
+		t, ok := TypeByName(sel[:n])
+		if !ok {
+			return st, "unknown IE name " + strconv.Quote(sel[:n])
+		}
+		st.hasChild, st.child, st.value = true, t, sel[n+1:]
+	default:
+		idx, err := strconv.Atoi(sel)
+		if err != nil || idx < 0 {
+			return st, "invalid selector " + strconv.Quote(sel)
+		}
+		st.index = idx
+	}
+
+	return st, ""
+}
+
+// String returns the query given to CompileQuery.
+func (q *Query) String() string {
+	return q.query
+}
+
+// Find returns all the IEs that match the query, searching from the given IEs
+// into the child IEs of the grouped IEs.
+func (q *Query) Find(ies []*IE) []*Match {
+	return q.find(ies, 0, "", nil, nil)
+}
+
+func (q *Query) find(ies []*IE, depth int, path string, index []int, matches []*Match) []*Match {
+	st := q.steps[depth]
+
+	counts := make(map[uint16]int)
+	for _, i := range ies {
+		if i != nil {
+			counts[i.Type]++
+		}
+	}
+
+	seen := make(map[uint16]int)
+	for idx, i := range ies {
+		if i == nil {
+			continue
+		}
+		n := seen[i.Type]
+		seen[i.Type]++
+
+		if !st.match(i, n) {
+			continue
+		}
+
+		p := i.Name()
+		if counts[i.Type] > 1 {
+			p += "[" + strconv.Itoa(n) + "]"
+		}
+		if path != "" {
+			p = path + "/" + p
+		}
+		x := make([]int, len(index)+1)
+		copy(x, index)
+		x[len(index)] = idx
+
+		if depth == len(q.steps)-1 {
+			matches = append(matches, &Match{IE: i, Path: p, Index: x})
+			continue
+		}
+		if !i.IsGrouped() {
+			continue
+		}
+		children, err := i.ValueAsGrouped()
+		if err != nil {
+			continue
+		}
+		matches = q.find(children, depth+1, p, x, matches)
+	}
+
+	return matches
+}
+
+func (st queryStep) match(i *IE, n int) bool {
+	if !st.anyType && i.Type != st.itype {
+		return false
+	}
+	if st.index >= 0 && st.index != n {
+		return false
+	}
+	if !st.hasChild {
+		return true
+	}
+
+	if !i.IsGrouped() {
+		return false
+	}
+	children, err := i.ValueAsGrouped()
+	if err != nil {
+		return false
+	}
+	for _, c := range children {
+		if c != nil && c.Type == st.child && valueEquals(c, st.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// valueEquals reports whether the value of the IE is equal to v, either as an
+// unsigned integer or as a string.
+func valueEquals(i *IE, v string) bool {
+	if string(i.Payload) == v {
+		return true
+	}
+
+	n, err := strconv.ParseUint(v, 10, 64)
+	if err != nil || len(i.Payload) == 0 || len(i.Payload) > 8 {
+		return false
+	}
+
+	var got uint64
+	for _, b := range i.Payload {
+		got = got<<8 | uint64(b)
+	}
+	return got == n
+}
+
+// Find returns all the IEs that match the query, searching from the given IEs
+// into the child IEs of the grouped IEs. See Query for the syntax of the query.
+//
+//	matches, err := ie.Find(m.CreatePDR, "CreatePDR[*]/PDI/FTEID")
+//
+// Use CompileQuery instead when the same query is used multiple times.
+func Find(ies []*IE, query string) ([]*Match, error) {
+	q, err := CompileQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	return q.Find(ies), nil
+}
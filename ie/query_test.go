@@ -0,0 +1,132 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFind(t *testing.T) {
+	newPDR := func(id uint16, teid uint32) *ie.IE {
+		return ie.NewCreatePDR(
+			ie.NewPDRID(id),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x01, teid, net.ParseIP("127.0.0.1"), nil, 0),
+			),
+		)
+	}
+	ies := []*ie.IE{
+		ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+		newPDR(1, 0x11111111),
+		newPDR(2, 0x22222222),
+		ie.NewCreateFAR(
+			ie.NewFARID(3),
+			ie.NewForwardingParameters(
+				ie.NewDestinationInterface(ie.DstInterfaceCore),
+				ie.NewNetworkInstance("internet"),
+			),
+		),
+	}
+
+	type match struct {
+		Path  string
+		Index []int
+	}
+	cases := []struct {
+		description string
+		query       string
+		matches     []match
+	}{
+		{
+			"Wildcard",
+			"CreatePDR[*]/PDI/FTEID",
+			[]match{
+				{"CreatePDR[0]/PDI/FTEID", []int{1, 1, 1}},
+				{"CreatePDR[1]/PDI/FTEID", []int{2, 1, 1}},
+			},
+		}, {
+			"NoSelector",
+			"CreatePDR/PDRID",
+			[]match{
+				{"CreatePDR[0]/PDRID", []int{1, 0}},
+				{"CreatePDR[1]/PDRID", []int{2, 0}},
+			},
+		}, {
+			"Index",
+			"CreatePDR[1]/PDI/FTEID",
+			[]match{
+				{"CreatePDR[1]/PDI/FTEID", []int{2, 1, 1}},
+			},
+		}, {
+			"ChildValue",
+			"CreatePDR[PDRID=1]/PDI/SourceInterface",
+			[]match{
+				{"CreatePDR[0]/PDI/SourceInterface", []int{1, 1, 0}},
+			},
+		}, {
+			"ChildString",
+			"CreateFAR/ForwardingParameters[NetworkInstance=internet]/DestinationInterface",
+			[]match{
+				{"CreateFAR/ForwardingParameters/DestinationInterface", []int{3, 1, 0}},
+			},
+		}, {
+			"AnyType",
+			"CreateFAR/*",
+			[]match{
+				{"CreateFAR/FARID", []int{3, 0}},
+				{"CreateFAR/ForwardingParameters", []int{3, 1}},
+			},
+		}, {
+			"Number",
+			"3/108",
+			[]match{
+				{"CreateFAR/FARID", []int{3, 0}},
+			},
+		}, {
+			"NotFound",
+			"CreatePDR[PDRID=3]",
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			got, err := ie.Find(ies, c.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var matches []match
+			for _, m := range got {
+				matches = append(matches, match{m.Path, m.Index})
+
+				i := ies[m.Index[0]]
+				if len(m.Index) > 1 {
+					i, err = i.ChildAt(m.Index[1:]...)
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+				if i != m.IE {
+					t.Errorf("Index %v does not point to the IE found", m.Index)
+				}
+			}
+			if diff := cmp.Diff(matches, c.matches); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+
+	for _, q := range []string{"", "CreatePDR/", "NoSuchIE", "CreatePDR[1", "CreatePDR[-1]", "CreatePDR[NoSuchIE=1]"} {
+		if _, err := ie.CompileQuery(q); err == nil {
+			t.Errorf("expected error for query %q", q)
+		}
+	}
+}
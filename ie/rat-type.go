@@ -0,0 +1,67 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// RATTypeValue is the value of RATType IE, encoded as the RAT Type in TS 29.274.
+type RATTypeValue uint8
+
+// RATType definitions.
+const (
+	_                    RATTypeValue = 0
+	RATTypeUTRAN         RATTypeValue = 1
+	RATTypeGERAN         RATTypeValue = 2
+	RATTypeWLAN          RATTypeValue = 3
+	RATTypeGAN           RATTypeValue = 4
+	RATTypeHSPAEvolution RATTypeValue = 5
+	RATTypeEUTRAN        RATTypeValue = 6
+	RATTypeVirtual       RATTypeValue = 7
+	RATTypeEUTRANNBIoT   RATTypeValue = 8
+	RATTypeLTEM          RATTypeValue = 9
+	RATTypeNR            RATTypeValue = 10
+)
+
+var rATTypeValues = newEnum("RATTypeValue", map[RATTypeValue]string{
+	RATTypeUTRAN:         "RATTypeUTRAN",
+	RATTypeGERAN:         "RATTypeGERAN",
+	RATTypeWLAN:          "RATTypeWLAN",
+	RATTypeGAN:           "RATTypeGAN",
+	RATTypeHSPAEvolution: "RATTypeHSPAEvolution",
+	RATTypeEUTRAN:        "RATTypeEUTRAN",
+	RATTypeVirtual:       "RATTypeVirtual",
+	RATTypeEUTRANNBIoT:   "RATTypeEUTRANNBIoT",
+	RATTypeLTEM:          "RATTypeLTEM",
+	RATTypeNR:            "RATTypeNR",
+})
+
+// String returns the name of the RATTypeValue, e.g., "RATTypeEUTRAN".
+func (v RATTypeValue) String() string {
+	return rATTypeValues.name(v)
+}
+
+// IsValid reports whether the RATTypeValue is a defined value.
+func (v RATTypeValue) IsValid() bool {
+	return rATTypeValues.valid(v)
+}
+
+// RATTypeValueByName returns the RATTypeValue of the given name, e.g., "RATTypeEUTRAN".
+// The value in decimal is also accepted.
+func RATTypeValueByName(name string) (RATTypeValue, bool) {
+	return rATTypeValues.byName(name)
+}
+
+// NewRATType creates a new RATType IE.
+func NewRATType(v RATTypeValue) *IE {
+	return newUint8ValIE(RATType, uint8(v))
+}
+
+// RATType returns RATType in RATTypeValue if the type of IE matches.
+func (i *IE) RATType() (RATTypeValue, error) {
+	if i.Type != RATType {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+
+	v, err := i.ValueAsUint8()
+	return RATTypeValue(v), err
+}
@@ -10,7 +10,7 @@ import (
 )
 
 // NewRedirectInformation creates a new RedirectInformation IE.
-func NewRedirectInformation(addrType uint8, addrs ...string) *IE {
+func NewRedirectInformation(addrType RedirectAddressTypeValue, addrs ...string) *IE {
 	fields := NewRedirectInformationFields(addrType, addrs...)
 
 	b, err := fields.Marshal()
@@ -57,18 +57,45 @@ func (i *IE) RedirectInformation() (*RedirectInformationFields, error) {
 	}
 }
 
+// RedirectAddressTypeValue is the Redirect Address Type in RedirectInformation IE.
+type RedirectAddressTypeValue uint8
+
 // RedirectAddressType definitions.
 const (
-	RedirectAddrIPv4        uint8 = 0
-	RedirectAddrIPv6        uint8 = 1
-	RedirectAddrURL         uint8 = 2
-	RedirectAddrSIPURI      uint8 = 3
-	RedirectAddrIPv4AndIPv6 uint8 = 4
+	RedirectAddrIPv4        RedirectAddressTypeValue = 0
+	RedirectAddrIPv6        RedirectAddressTypeValue = 1
+	RedirectAddrURL         RedirectAddressTypeValue = 2
+	RedirectAddrSIPURI      RedirectAddressTypeValue = 3
+	RedirectAddrIPv4AndIPv6 RedirectAddressTypeValue = 4
 )
 
+var redirectAddressTypeValues = newEnum("RedirectAddressTypeValue", map[RedirectAddressTypeValue]string{
+	RedirectAddrIPv4:        "RedirectAddrIPv4",
+	RedirectAddrIPv6:        "RedirectAddrIPv6",
+	RedirectAddrURL:         "RedirectAddrURL",
+	RedirectAddrSIPURI:      "RedirectAddrSIPURI",
+	RedirectAddrIPv4AndIPv6: "RedirectAddrIPv4AndIPv6",
+})
+
+// String returns the name of the RedirectAddressTypeValue, e.g., "RedirectAddrURL".
+func (v RedirectAddressTypeValue) String() string {
+	return redirectAddressTypeValues.name(v)
+}
+
+// IsValid reports whether the RedirectAddressTypeValue is a defined value.
+func (v RedirectAddressTypeValue) IsValid() bool {
+	return redirectAddressTypeValues.valid(v)
+}
+
+// RedirectAddressTypeValueByName returns the RedirectAddressTypeValue of the given name, e.g., "RedirectAddrURL".
+// The value in decimal is also accepted.
+func RedirectAddressTypeValueByName(name string) (RedirectAddressTypeValue, bool) {
+	return redirectAddressTypeValues.byName(name)
+}
+
 // RedirectInformationFields represents a fields contained in RedirectInformation IE.
 type RedirectInformationFields struct {
-	RedirectAddressType        uint8 // half octet
+	RedirectAddressType        RedirectAddressTypeValue // half octet
 	ServerAddrLength           uint16
 	RedirectServerAddress      string
 	OtherServerAddrLength      uint16
@@ -79,7 +106,7 @@ type RedirectInformationFields struct {
 //
 // You can put multiple addrs here, but the second one is used only when addrType is
 // RedirectAddrIPv4AndIPv6. Third - nth addrs will never be used.
-func NewRedirectInformationFields(addrType uint8, addrs ...string) *RedirectInformationFields {
+func NewRedirectInformationFields(addrType RedirectAddressTypeValue, addrs ...string) *RedirectInformationFields {
 	if len(addrs) < 1 {
 		return nil
 	}
@@ -114,7 +141,7 @@ func (f *RedirectInformationFields) UnmarshalBinary(b []byte) error {
 		return io.ErrUnexpectedEOF
 	}
 
-	f.RedirectAddressType = b[0]
+	f.RedirectAddressType = RedirectAddressTypeValue(b[0])
 	offset := 1
 
 	f.ServerAddrLength = binary.BigEndian.Uint16(b[offset : offset+2])
@@ -154,7 +181,7 @@ func (f *RedirectInformationFields) MarshalTo(b []byte) error {
 		return io.ErrUnexpectedEOF
 	}
 
-	b[0] = f.RedirectAddressType
+	b[0] = uint8(f.RedirectAddressType)
 	offset := 1
 
 	if l < offset+int(f.ServerAddrLength) {
@@ -13,7 +13,7 @@ func NewRedundantTransmissionForwardingParameters(ies ...*IE) *IE {
 func (i *IE) RedundantTransmissionForwardingParameters() ([]*IE, error) {
 	switch i.Type {
 	case RedundantTransmissionForwardingParameters:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreateFAR:
 		ies, err := i.CreateFAR()
 		if err != nil {
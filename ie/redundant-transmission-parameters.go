@@ -23,7 +23,7 @@ func NewRedundantTransmissionParametersInFAR(ohc, ni *IE) *IE {
 func (i *IE) RedundantTransmissionParameters() ([]*IE, error) {
 	switch i.Type {
 	case RedundantTransmissionParameters:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreatePDR:
 		ies, err := i.CreatePDR()
 		if err != nil {
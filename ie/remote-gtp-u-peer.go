@@ -11,7 +11,7 @@ import (
 )
 
 // NewRemoteGTPUPeer creates a new RemoteGTPUPeer IE.
-func NewRemoteGTPUPeer(flags uint8, v4, v6 string, di uint8, ni string) *IE {
+func NewRemoteGTPUPeer(flags uint8, v4, v6 string, di DestinationInterfaceValue, ni string) *IE {
 	fields := NewRemoteGTPUPeerFields(flags, v4, v6, di, ni)
 	b, err := fields.Marshal()
 	if err != nil {
@@ -110,13 +110,13 @@ type RemoteGTPUPeerFields struct {
 	IPv4Address          net.IP
 	IPv6Address          net.IP
 	DILength             uint16
-	DestinationInterface uint8
+	DestinationInterface DestinationInterfaceValue
 	NILength             uint16
 	NetworkInstance      string
 }
 
 // NewRemoteGTPUPeerFields creates a new RemoteGTPUPeerFields.
-func NewRemoteGTPUPeerFields(flags uint8, v4, v6 string, di uint8, ni string) *RemoteGTPUPeerFields {
+func NewRemoteGTPUPeerFields(flags uint8, v4, v6 string, di DestinationInterfaceValue, ni string) *RemoteGTPUPeerFields {
 	f := &RemoteGTPUPeerFields{Flags: flags}
 
 	if has2ndBit(flags) {
@@ -185,7 +185,7 @@ func (f *RemoteGTPUPeerFields) UnmarshalBinary(b []byte) error {
 		if l < offset+int(f.DILength) {
 			return io.ErrUnexpectedEOF
 		}
-		f.DestinationInterface = b[offset]
+		f.DestinationInterface = DestinationInterfaceValue(b[offset])
 		offset += int(f.DILength)
 	}
 
@@ -237,7 +237,7 @@ func (f *RemoteGTPUPeerFields) MarshalTo(b []byte) error {
 	if has3rdBit(f.Flags) {
 		binary.BigEndian.PutUint16(b[offset:offset+2], f.DILength)
 		offset += 2
-		b[offset] = f.DestinationInterface
+		b[offset] = uint8(f.DestinationInterface)
 		offset += int(f.DILength)
 	}
 
@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRemoveMBSUnicastParameters creates a new RemoveMBSUnicastParameters IE.
+func NewRemoveMBSUnicastParameters(ies ...*IE) *IE {
+	return newGroupedIE(RemoveMBSUnicastParameters, 0, ies...)
+}
+
+// RemoveMBSUnicastParameters returns the IEs above RemoveMBSUnicastParameters if the type of IE matches.
+func (i *IE) RemoveMBSUnicastParameters() ([]*IE, error) {
+	if i.Type != RemoveMBSUnicastParameters {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
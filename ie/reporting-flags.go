@@ -0,0 +1,31 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewReportingFlags creates a new ReportingFlags IE.
+func NewReportingFlags(flags uint8) *IE {
+	return newUint8ValIE(ReportingFlags, flags)
+}
+
+// ReportingFlags returns ReportingFlags in uint8 if the type of IE matches.
+func (i *IE) ReportingFlags() (uint8, error) {
+	switch i.Type {
+	case ReportingFlags:
+		return i.ValueAsUint8()
+	case DirectReportingInformation:
+		ies, err := i.DirectReportingInformation()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == ReportingFlags {
+				return x.ReportingFlags()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
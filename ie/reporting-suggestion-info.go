@@ -0,0 +1,120 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewReportingSuggestionInfo creates a new ReportingSuggestionInfo IE.
+//
+// The urgency is 0 when the usage report is delay tolerant and 1 when it is not.
+// The reporting time info, the time in seconds within which the UP function should
+// send the delay tolerant report, is encoded only when the urgency is 0.
+func NewReportingSuggestionInfo(urgency uint8, timeInfo uint32) *IE {
+	fields := NewReportingSuggestionInfoFields(urgency, timeInfo)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(ReportingSuggestionInfo, b)
+}
+
+// ReportingSuggestionInfo returns ReportingSuggestionInfo in structured format if the type of IE matches.
+func (i *IE) ReportingSuggestionInfo() (*ReportingSuggestionInfoFields, error) {
+	if i.Type != ReportingSuggestionInfo {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	fields, err := ParseReportingSuggestionInfoFields(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// ReportingSuggestionInfoFields represents a fields contained in ReportingSuggestionInfo IE.
+type ReportingSuggestionInfoFields struct {
+	ReportingUrgency  uint8
+	ReportingTimeInfo uint32
+}
+
+// NewReportingSuggestionInfoFields creates a new ReportingSuggestionInfoFields.
+func NewReportingSuggestionInfoFields(urgency uint8, timeInfo uint32) *ReportingSuggestionInfoFields {
+	f := &ReportingSuggestionInfoFields{ReportingUrgency: urgency & 0x0f}
+
+	if f.IsDelayTolerant() {
+		f.ReportingTimeInfo = timeInfo
+	}
+
+	return f
+}
+
+// IsDelayTolerant reports whether the ReportingUrgency is delay tolerant.
+func (f *ReportingSuggestionInfoFields) IsDelayTolerant() bool {
+	return f.ReportingUrgency == 0
+}
+
+// ParseReportingSuggestionInfoFields parses b into ReportingSuggestionInfoFields.
+func ParseReportingSuggestionInfoFields(b []byte) (*ReportingSuggestionInfoFields, error) {
+	f := &ReportingSuggestionInfoFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *ReportingSuggestionInfoFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.ReportingUrgency = b[0] & 0x0f
+	if f.IsDelayTolerant() {
+		if l < 5 {
+			return io.ErrUnexpectedEOF
+		}
+		f.ReportingTimeInfo = binary.BigEndian.Uint32(b[1:5])
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of ReportingSuggestionInfoFields.
+func (f *ReportingSuggestionInfoFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *ReportingSuggestionInfoFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.ReportingUrgency & 0x0f
+	if f.IsDelayTolerant() {
+		binary.BigEndian.PutUint32(b[1:5], f.ReportingTimeInfo)
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *ReportingSuggestionInfoFields) MarshalLen() int {
+	if f.IsDelayTolerant() {
+		return 5
+	}
+	return 1
+}
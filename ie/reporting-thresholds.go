@@ -0,0 +1,197 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+
+	"github.com/aalayanahmad/go-pfcp/internal/utils"
+)
+
+// NewReportingThresholds creates a new ReportingThresholds IE.
+//
+// The DL and UL data rate thresholds are in kbps, encoded in 5 octets as MBR, and
+// the DL and UL congestion information thresholds are in percent. They are encoded
+// only when the DLDR (0x01), ULDR (0x02), DLCI (0x04) and ULCI (0x08) flags are set
+// respectively.
+func NewReportingThresholds(flags uint8, dlRate, ulRate uint64, dlCongestion, ulCongestion uint8) *IE {
+	fields := NewReportingThresholdsFields(flags, dlRate, ulRate, dlCongestion, ulCongestion)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(ReportingThresholds, b)
+}
+
+// ReportingThresholds returns ReportingThresholds in structured format if the type of IE matches.
+func (i *IE) ReportingThresholds() (*ReportingThresholdsFields, error) {
+	if i.Type != ReportingThresholds {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	fields, err := ParseReportingThresholdsFields(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// ReportingThresholdsFields represents a fields contained in ReportingThresholds IE.
+type ReportingThresholdsFields struct {
+	Flags                            uint8
+	DLDataRateThreshold              uint64
+	ULDataRateThreshold              uint64
+	DLCongestionInformationThreshold uint8
+	ULCongestionInformationThreshold uint8
+}
+
+// NewReportingThresholdsFields creates a new ReportingThresholdsFields.
+func NewReportingThresholdsFields(flags uint8, dlRate, ulRate uint64, dlCongestion, ulCongestion uint8) *ReportingThresholdsFields {
+	f := &ReportingThresholdsFields{Flags: flags}
+
+	if f.HasDLDR() {
+		f.DLDataRateThreshold = dlRate
+	}
+	if f.HasULDR() {
+		f.ULDataRateThreshold = ulRate
+	}
+	if f.HasDLCI() {
+		f.DLCongestionInformationThreshold = dlCongestion
+	}
+	if f.HasULCI() {
+		f.ULCongestionInformationThreshold = ulCongestion
+	}
+
+	return f
+}
+
+// HasDLDR reports whether DLDR flag is set.
+func (f *ReportingThresholdsFields) HasDLDR() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasULDR reports whether ULDR flag is set.
+func (f *ReportingThresholdsFields) HasULDR() bool {
+	return has2ndBit(f.Flags)
+}
+
+// HasDLCI reports whether DLCI flag is set.
+func (f *ReportingThresholdsFields) HasDLCI() bool {
+	return has3rdBit(f.Flags)
+}
+
+// HasULCI reports whether ULCI flag is set.
+func (f *ReportingThresholdsFields) HasULCI() bool {
+	return has4thBit(f.Flags)
+}
+
+// ParseReportingThresholdsFields parses b into ReportingThresholdsFields.
+func ParseReportingThresholdsFields(b []byte) (*ReportingThresholdsFields, error) {
+	f := &ReportingThresholdsFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *ReportingThresholdsFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	offset := 1
+
+	if f.HasDLDR() {
+		if l < offset+5 {
+			return io.ErrUnexpectedEOF
+		}
+		f.DLDataRateThreshold = utils.Uint40To64(b[offset : offset+5])
+		offset += 5
+	}
+	if f.HasULDR() {
+		if l < offset+5 {
+			return io.ErrUnexpectedEOF
+		}
+		f.ULDataRateThreshold = utils.Uint40To64(b[offset : offset+5])
+		offset += 5
+	}
+	if f.HasDLCI() {
+		if l < offset+1 {
+			return io.ErrUnexpectedEOF
+		}
+		f.DLCongestionInformationThreshold = b[offset]
+		offset++
+	}
+	if f.HasULCI() {
+		if l < offset+1 {
+			return io.ErrUnexpectedEOF
+		}
+		f.ULCongestionInformationThreshold = b[offset]
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of ReportingThresholdsFields.
+func (f *ReportingThresholdsFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *ReportingThresholdsFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	offset := 1
+
+	if f.HasDLDR() {
+		copy(b[offset:offset+5], utils.Uint64To40(f.DLDataRateThreshold))
+		offset += 5
+	}
+	if f.HasULDR() {
+		copy(b[offset:offset+5], utils.Uint64To40(f.ULDataRateThreshold))
+		offset += 5
+	}
+	if f.HasDLCI() {
+		b[offset] = f.DLCongestionInformationThreshold
+		offset++
+	}
+	if f.HasULCI() {
+		b[offset] = f.ULCongestionInformationThreshold
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *ReportingThresholdsFields) MarshalLen() int {
+	l := 1
+	if f.HasDLDR() {
+		l += 5
+	}
+	if f.HasULDR() {
+		l += 5
+	}
+	if f.HasDLCI() {
+		l++
+	}
+	if f.HasULCI() {
+		l++
+	}
+
+	return l
+}
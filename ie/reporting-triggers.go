@@ -8,6 +8,79 @@ import (
 	"io"
 )
 
+// ReportingTriggersFlags is a set of the flags in ReportingTriggers IE.
+type ReportingTriggersFlags uint32
+
+// ReportingTriggers flag definitions.
+const (
+	ReportingTriggersPERIO ReportingTriggersFlags = 1 << iota
+	ReportingTriggersVOLTH
+	ReportingTriggersTIMTH
+	ReportingTriggersQUHTI
+	ReportingTriggersSTART
+	ReportingTriggersSTOPT
+	ReportingTriggersDROTH
+	ReportingTriggersLIUSA
+	ReportingTriggersVOLQU
+	ReportingTriggersTIMQU
+	ReportingTriggersENVCL
+	ReportingTriggersMACAR
+	ReportingTriggersEVETH
+	ReportingTriggersEVEQU
+	ReportingTriggersIPMJL
+	ReportingTriggersQUVTI
+	ReportingTriggersREEMR
+	ReportingTriggersUPINT
+)
+
+var reportingTriggersFlags = &flagSet{
+	names: []string{
+		"PERIO", "VOLTH", "TIMTH", "QUHTI", "START", "STOPT", "DROTH", "LIUSA",
+		"VOLQU", "TIMQU", "ENVCL", "MACAR", "EVETH", "EVEQU", "IPMJL", "QUVTI",
+		"REEMR", "UPINT",
+	},
+	minOctets: 2,
+}
+
+// Has reports whether all the given flags are set.
+func (f ReportingTriggersFlags) Has(flags ReportingTriggersFlags) bool {
+	return f&flags == flags
+}
+
+// Set sets the given flags.
+func (f *ReportingTriggersFlags) Set(flags ReportingTriggersFlags) {
+	*f |= flags
+}
+
+// Clear clears the given flags.
+func (f *ReportingTriggersFlags) Clear(flags ReportingTriggersFlags) {
+	*f &^= flags
+}
+
+// String returns the names of the set flags joined with "|", e.g., "PERIO|VOLTH".
+func (f ReportingTriggersFlags) String() string {
+	return reportingTriggersFlags.string(uint64(f))
+}
+
+// Octets returns the flags serialized in as many octets as required by the set flags.
+func (f ReportingTriggersFlags) Octets() []byte {
+	return reportingTriggersFlags.octets(uint64(f))
+}
+
+// NewReportingTriggersFlags creates a new ReportingTriggers IE from the flag set.
+func NewReportingTriggersFlags(flags ReportingTriggersFlags) *IE {
+	return New(ReportingTriggers, flags.Octets())
+}
+
+// ReportingTriggersFlags returns ReportingTriggers in ReportingTriggersFlags if the type of IE matches.
+func (i *IE) ReportingTriggersFlags() (ReportingTriggersFlags, error) {
+	v, err := i.ReportingTriggers()
+	if err != nil {
+		return 0, err
+	}
+	return ReportingTriggersFlags(reportingTriggersFlags.fromOctets(v)), nil
+}
+
 // NewReportingTriggers creates a new ReportingTriggers IE.
 func NewReportingTriggers(triggersOctets ...uint8) *IE {
 	return New(ReportingTriggers, triggersOctets)
@@ -43,20 +43,40 @@ func (i *IE) HasRP() bool {
 
 // HasUL reports whether an IE has UL bit.
 func (i *IE) HasUL() bool {
-	v, err := i.RequestedQoSMonitoring()
-	if err != nil {
-		return false
-	}
+	switch i.Type {
+	case TrafficParameterMeasurementIndication, TrafficParameterMeasurementControlInformation:
+		v, err := i.TrafficParameterMeasurementIndication()
+		if err != nil {
+			return false
+		}
+
+		return has1stBit(v)
+	default:
+		v, err := i.RequestedQoSMonitoring()
+		if err != nil {
+			return false
+		}
 
-	return has2ndBit(v)
+		return has2ndBit(v)
+	}
 }
 
 // HasDL reports whether an IE has DL bit.
 func (i *IE) HasDL() bool {
-	v, err := i.RequestedQoSMonitoring()
-	if err != nil {
-		return false
-	}
+	switch i.Type {
+	case TrafficParameterMeasurementIndication, TrafficParameterMeasurementControlInformation:
+		v, err := i.TrafficParameterMeasurementIndication()
+		if err != nil {
+			return false
+		}
+
+		return has2ndBit(v)
+	default:
+		v, err := i.RequestedQoSMonitoring()
+		if err != nil {
+			return false
+		}
 
-	return has1stBit(v)
+		return has1stBit(v)
+	}
 }
@@ -0,0 +1,107 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewRTPHeaderExtensionAdditionalInformation creates a new RTPHeaderExtensionAdditionalInformation IE.
+//
+// The FORM flag (0x01) indicates that the PDU Set marking RTP header extension
+// defined in TS 26.522 is in the long format, which carries the PDU Set size.
+func NewRTPHeaderExtensionAdditionalInformation(flags uint8) *IE {
+	fields := NewRTPHeaderExtensionAdditionalInformationFields(flags)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(RTPHeaderExtensionAdditionalInformation, b)
+}
+
+// RTPHeaderExtensionAdditionalInformation returns RTPHeaderExtensionAdditionalInformation in structured format if the type of IE matches.
+func (i *IE) RTPHeaderExtensionAdditionalInformation() (*RTPHeaderExtensionAdditionalInformationFields, error) {
+	switch i.Type {
+	case RTPHeaderExtensionAdditionalInformation:
+		fields, err := ParseRTPHeaderExtensionAdditionalInformationFields(i.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	case RTPHeaderExtensionInformation:
+		ies, err := i.RTPHeaderExtensionInformation()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == RTPHeaderExtensionAdditionalInformation {
+				return x.RTPHeaderExtensionAdditionalInformation()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// RTPHeaderExtensionAdditionalInformationFields represents a fields contained in
+// RTPHeaderExtensionAdditionalInformation IE.
+type RTPHeaderExtensionAdditionalInformationFields struct {
+	Flags uint8
+}
+
+// NewRTPHeaderExtensionAdditionalInformationFields creates a new RTPHeaderExtensionAdditionalInformationFields.
+func NewRTPHeaderExtensionAdditionalInformationFields(flags uint8) *RTPHeaderExtensionAdditionalInformationFields {
+	return &RTPHeaderExtensionAdditionalInformationFields{Flags: flags}
+}
+
+// HasFORM reports whether FORM flag is set.
+func (f *RTPHeaderExtensionAdditionalInformationFields) HasFORM() bool {
+	return has1stBit(f.Flags)
+}
+
+// ParseRTPHeaderExtensionAdditionalInformationFields parses b into RTPHeaderExtensionAdditionalInformationFields.
+func ParseRTPHeaderExtensionAdditionalInformationFields(b []byte) (*RTPHeaderExtensionAdditionalInformationFields, error) {
+	f := &RTPHeaderExtensionAdditionalInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *RTPHeaderExtensionAdditionalInformationFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	return nil
+}
+
+// Marshal returns the serialized bytes of RTPHeaderExtensionAdditionalInformationFields.
+func (f *RTPHeaderExtensionAdditionalInformationFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *RTPHeaderExtensionAdditionalInformationFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *RTPHeaderExtensionAdditionalInformationFields) MarshalLen() int {
+	return 1
+}
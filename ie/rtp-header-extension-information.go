@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRTPHeaderExtensionInformation creates a new RTPHeaderExtensionInformation IE.
+func NewRTPHeaderExtensionInformation(ies ...*IE) *IE {
+	return newGroupedIE(RTPHeaderExtensionInformation, 0, ies...)
+}
+
+// RTPHeaderExtensionInformation returns the IEs above RTPHeaderExtensionInformation if the type of IE matches.
+func (i *IE) RTPHeaderExtensionInformation() ([]*IE, error) {
+	if i.Type != RTPHeaderExtensionInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
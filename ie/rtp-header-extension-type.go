@@ -0,0 +1,31 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRTPHeaderExtensionType creates a new RTPHeaderExtensionType IE.
+func NewRTPHeaderExtensionType(v uint8) *IE {
+	return newUint8ValIE(RTPHeaderExtensionType, v)
+}
+
+// RTPHeaderExtensionType returns RTPHeaderExtensionType in uint8 if the type of IE matches.
+func (i *IE) RTPHeaderExtensionType() (uint8, error) {
+	switch i.Type {
+	case RTPHeaderExtensionType:
+		return i.ValueAsUint8()
+	case RTPHeaderExtensionInformation:
+		ies, err := i.RTPHeaderExtensionInformation()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == RTPHeaderExtensionType {
+				return x.RTPHeaderExtensionType()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRTPPayloadFormat creates a new RTPPayloadFormat IE.
+func NewRTPPayloadFormat(v uint8) *IE {
+	return newUint8ValIE(RTPPayloadFormat, v)
+}
+
+// RTPPayloadFormat returns RTPPayloadFormat in uint8 if the type of IE matches.
+func (i *IE) RTPPayloadFormat() (uint8, error) {
+	switch i.Type {
+	case RTPPayloadFormat:
+		return i.ValueAsUint8()
+	case RTPPayloadInformation:
+		ies, err := i.RTPPayloadInformation()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == RTPPayloadFormat {
+				return x.RTPPayloadFormat()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRTPPayloadInformation creates a new RTPPayloadInformation IE.
+func NewRTPPayloadInformation(ies ...*IE) *IE {
+	return newGroupedIE(RTPPayloadInformation, 0, ies...)
+}
+
+// RTPPayloadInformation returns the IEs above RTPPayloadInformation if the type of IE matches.
+func (i *IE) RTPPayloadInformation() ([]*IE, error) {
+	if i.Type != RTPPayloadInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
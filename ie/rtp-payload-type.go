@@ -0,0 +1,31 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRTPPayloadType creates a new RTPPayloadType IE.
+func NewRTPPayloadType(v uint8) *IE {
+	return newUint8ValIE(RTPPayloadType, v)
+}
+
+// RTPPayloadType returns RTPPayloadType in uint8 if the type of IE matches.
+func (i *IE) RTPPayloadType() (uint8, error) {
+	switch i.Type {
+	case RTPPayloadType:
+		return i.ValueAsUint8()
+	case RTPPayloadInformation:
+		ies, err := i.RTPPayloadInformation()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == RTPPayloadType {
+				return x.RTPPayloadType()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
@@ -45,7 +45,7 @@ func (i *IE) SNSSAI() ([]byte, error) {
 
 // SST returns SST in uint8 if the type of IE matches.
 func (i *IE) SST() (uint8, error) {
-	v, err := i.SNSSAI()
+	v, err := i.snssaiValue()
 	if err != nil {
 		return 0, err
 	}
@@ -55,10 +55,18 @@ func (i *IE) SST() (uint8, error) {
 
 // SD returns SD in uint32 if the type of IE matches.
 func (i *IE) SD() (uint32, error) {
-	v, err := i.SNSSAI()
+	v, err := i.snssaiValue()
 	if err != nil {
 		return 0, err
 	}
 
 	return utils.Uint24To32(v[1:4]), nil
 }
+
+// snssaiValue returns the value of SNSSAI or HPLMNSNSSAI IE.
+func (i *IE) snssaiValue() ([]byte, error) {
+	if i.Type == HPLMNSNSSAI {
+		return i.HPLMNSNSSAI()
+	}
+	return i.SNSSAI()
+}
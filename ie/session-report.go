@@ -13,7 +13,7 @@ func NewSessionReport(ies ...*IE) *IE {
 func (i *IE) SessionReport() ([]*IE, error) {
 	switch i.Type {
 	case SessionReport:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	default:
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
@@ -4,25 +4,53 @@
 
 package ie
 
+// SourceInterfaceValue is the value of SourceInterface IE.
+type SourceInterfaceValue uint8
+
 // Interface definitions.
 const (
-	SrcInterfaceAccess       uint8 = 0
-	SrcInterfaceCore         uint8 = 1
-	SrcInterfaceSGiLANN6LAN  uint8 = 2
-	SrcInterfaceCPFunction   uint8 = 3
-	SrcInterface5GVNInternal uint8 = 4
+	SrcInterfaceAccess       SourceInterfaceValue = 0
+	SrcInterfaceCore         SourceInterfaceValue = 1
+	SrcInterfaceSGiLANN6LAN  SourceInterfaceValue = 2
+	SrcInterfaceCPFunction   SourceInterfaceValue = 3
+	SrcInterface5GVNInternal SourceInterfaceValue = 4
 )
 
+var sourceInterfaceValues = newEnum("SourceInterfaceValue", map[SourceInterfaceValue]string{
+	SrcInterfaceAccess:       "SrcInterfaceAccess",
+	SrcInterfaceCore:         "SrcInterfaceCore",
+	SrcInterfaceSGiLANN6LAN:  "SrcInterfaceSGiLANN6LAN",
+	SrcInterfaceCPFunction:   "SrcInterfaceCPFunction",
+	SrcInterface5GVNInternal: "SrcInterface5GVNInternal",
+})
+
+// String returns the name of the SourceInterfaceValue, e.g., "SrcInterfaceAccess".
+func (v SourceInterfaceValue) String() string {
+	return sourceInterfaceValues.name(v)
+}
+
+// IsValid reports whether the SourceInterfaceValue is a defined value.
+func (v SourceInterfaceValue) IsValid() bool {
+	return sourceInterfaceValues.valid(v)
+}
+
+// SourceInterfaceValueByName returns the SourceInterfaceValue of the given name, e.g., "SrcInterfaceAccess".
+// The value in decimal is also accepted.
+func SourceInterfaceValueByName(name string) (SourceInterfaceValue, bool) {
+	return sourceInterfaceValues.byName(name)
+}
+
 // NewSourceInterface creates a new SourceInterface IE.
-func NewSourceInterface(intf uint8) *IE {
-	return newUint8ValIE(SourceInterface, intf)
+func NewSourceInterface(intf SourceInterfaceValue) *IE {
+	return newUint8ValIE(SourceInterface, uint8(intf))
 }
 
-// SourceInterface returns SourceInterface in uint8 if the type of IE matches.
-func (i *IE) SourceInterface() (uint8, error) {
+// SourceInterface returns SourceInterface in SourceInterfaceValue if the type of IE matches.
+func (i *IE) SourceInterface() (SourceInterfaceValue, error) {
 	switch i.Type {
 	case SourceInterface:
-		return i.ValueAsUint8()
+		v, err := i.ValueAsUint8()
+		return SourceInterfaceValue(v), err
 	case CreatePDR:
 		ies, err := i.CreatePDR()
 		if err != nil {
@@ -4,22 +4,47 @@
 
 package ie
 
+// SteeringFunctionalityValue is the value of SteeringFunctionality IE.
+type SteeringFunctionalityValue uint8
+
 // SteeringFunctionality definitions.
 const (
-	SteeringFunctionalityATSSSLL uint8 = 0
-	SteeringFunctionalityMPTCP   uint8 = 1
+	SteeringFunctionalityATSSSLL SteeringFunctionalityValue = 0
+	SteeringFunctionalityMPTCP   SteeringFunctionalityValue = 1
 )
 
+var steeringFunctionalityValues = newEnum("SteeringFunctionalityValue", map[SteeringFunctionalityValue]string{
+	SteeringFunctionalityATSSSLL: "SteeringFunctionalityATSSSLL",
+	SteeringFunctionalityMPTCP:   "SteeringFunctionalityMPTCP",
+})
+
+// String returns the name of the SteeringFunctionalityValue, e.g., "SteeringFunctionalityMPTCP".
+func (v SteeringFunctionalityValue) String() string {
+	return steeringFunctionalityValues.name(v)
+}
+
+// IsValid reports whether the SteeringFunctionalityValue is a defined value.
+func (v SteeringFunctionalityValue) IsValid() bool {
+	return steeringFunctionalityValues.valid(v)
+}
+
+// SteeringFunctionalityValueByName returns the SteeringFunctionalityValue of the given name, e.g., "SteeringFunctionalityMPTCP".
+// The value in decimal is also accepted.
+func SteeringFunctionalityValueByName(name string) (SteeringFunctionalityValue, bool) {
+	return steeringFunctionalityValues.byName(name)
+}
+
 // NewSteeringFunctionality creates a new SteeringFunctionality IE.
-func NewSteeringFunctionality(sfunc uint8) *IE {
-	return newUint8ValIE(SteeringFunctionality, sfunc&0x0f)
+func NewSteeringFunctionality(sfunc SteeringFunctionalityValue) *IE {
+	return newUint8ValIE(SteeringFunctionality, uint8(sfunc)&0x0f)
 }
 
-// SteeringFunctionality returns SteeringFunctionality in uint8 if the type of IE matches.
-func (i *IE) SteeringFunctionality() (uint8, error) {
+// SteeringFunctionality returns SteeringFunctionality in SteeringFunctionalityValue if the type of IE matches.
+func (i *IE) SteeringFunctionality() (SteeringFunctionalityValue, error) {
 	switch i.Type {
 	case SteeringFunctionality:
-		return i.ValueAsUint8()
+		v, err := i.ValueAsUint8()
+		return SteeringFunctionalityValue(v), err
 	case CreateMAR:
 		ies, err := i.CreateMAR()
 		if err != nil {
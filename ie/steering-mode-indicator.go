@@ -0,0 +1,39 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewSteeringModeIndicator creates a new SteeringModeIndicator IE.
+func NewSteeringModeIndicator(flags uint8) *IE {
+	return newUint8ValIE(SteeringModeIndicator, flags)
+}
+
+// SteeringModeIndicator returns SteeringModeIndicator in uint8 if the type of IE matches.
+func (i *IE) SteeringModeIndicator() (uint8, error) {
+	if i.Type != SteeringModeIndicator {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.ValueAsUint8()
+}
+
+// HasALBI reports whether an IE has ALBI bit.
+func (i *IE) HasALBI() bool {
+	v, err := i.SteeringModeIndicator()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
+
+// HasUEAI reports whether an IE has UEAI bit.
+func (i *IE) HasUEAI() bool {
+	v, err := i.SteeringModeIndicator()
+	if err != nil {
+		return false
+	}
+
+	return has2ndBit(v)
+}
@@ -4,24 +4,51 @@
 
 package ie
 
+// SteeringModeValue is the value of SteeringMode IE.
+type SteeringModeValue uint8
+
 // SteeringMode definitions.
 const (
-	SteeringModeActiveStandby uint8 = 0
-	SteeringModeSmallestDelay uint8 = 1
-	SteeringModeLoadBalancing uint8 = 2
-	SteeringModePriorityBased uint8 = 3
+	SteeringModeActiveStandby SteeringModeValue = 0
+	SteeringModeSmallestDelay SteeringModeValue = 1
+	SteeringModeLoadBalancing SteeringModeValue = 2
+	SteeringModePriorityBased SteeringModeValue = 3
 )
 
+var steeringModeValues = newEnum("SteeringModeValue", map[SteeringModeValue]string{
+	SteeringModeActiveStandby: "SteeringModeActiveStandby",
+	SteeringModeSmallestDelay: "SteeringModeSmallestDelay",
+	SteeringModeLoadBalancing: "SteeringModeLoadBalancing",
+	SteeringModePriorityBased: "SteeringModePriorityBased",
+})
+
+// String returns the name of the SteeringModeValue, e.g., "SteeringModeActiveStandby".
+func (v SteeringModeValue) String() string {
+	return steeringModeValues.name(v)
+}
+
+// IsValid reports whether the SteeringModeValue is a defined value.
+func (v SteeringModeValue) IsValid() bool {
+	return steeringModeValues.valid(v)
+}
+
+// SteeringModeValueByName returns the SteeringModeValue of the given name, e.g., "SteeringModeActiveStandby".
+// The value in decimal is also accepted.
+func SteeringModeValueByName(name string) (SteeringModeValue, bool) {
+	return steeringModeValues.byName(name)
+}
+
 // NewSteeringMode creates a new SteeringMode IE.
-func NewSteeringMode(mode uint8) *IE {
-	return newUint8ValIE(SteeringMode, mode&0x0f)
+func NewSteeringMode(mode SteeringModeValue) *IE {
+	return newUint8ValIE(SteeringMode, uint8(mode)&0x0f)
 }
 
-// SteeringMode returns SteeringMode in uint8 if the type of IE matches.
-func (i *IE) SteeringMode() (uint8, error) {
+// SteeringMode returns SteeringMode in SteeringModeValue if the type of IE matches.
+func (i *IE) SteeringMode() (SteeringModeValue, error) {
 	switch i.Type {
 	case SteeringMode:
-		return i.ValueAsUint8()
+		v, err := i.ValueAsUint8()
+		return SteeringModeValue(v), err
 	case CreateMAR:
 		ies, err := i.CreateMAR()
 		if err != nil {
@@ -13,7 +13,7 @@ func NewTGPPAccessForwardingActionInformation(ies ...*IE) *IE {
 func (i *IE) TGPPAccessForwardingActionInformation() ([]*IE, error) {
 	switch i.Type {
 	case TGPPAccessForwardingActionInformation:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreateMAR:
 		ies, err := i.CreateMAR()
 		if err != nil {
@@ -6,45 +6,88 @@ package ie
 
 import "io"
 
+// TGPPInterfaceTypeValue is the value of TGPPInterfaceType IE.
+type TGPPInterfaceTypeValue uint8
+
 // TGPPInterfaceType definitons.
 const (
-	TGPPInterfaceTypeS1U                      uint8 = 0
-	TGPPInterfaceTypeS5S8U                    uint8 = 1
-	TGPPInterfaceTypeS4U                      uint8 = 2
-	TGPPInterfaceTypeS11U                     uint8 = 3
-	TGPPInterfaceTypeS12U                     uint8 = 4
-	TGPPInterfaceTypeGnGpU                    uint8 = 5
-	TGPPInterfaceTypeS2aU                     uint8 = 6
-	TGPPInterfaceTypeS2bU                     uint8 = 7
-	TGPPInterfaceTypeENBDL                    uint8 = 8
-	TGPPInterfaceTypeENBUL                    uint8 = 9
-	TGPPInterfaceTypeSGWUPFDL                 uint8 = 10
-	TGPPInterfaceTypeN33GPPAccess             uint8 = 11
-	TGPPInterfaceTypeN3TrustedNon3GPPAccess   uint8 = 12
-	TGPPInterfaceTypeN3UnTrustedNon3GPPAccess uint8 = 13
-	TGPPInterfaceTypeN3ForDataForwarding      uint8 = 14
-	TGPPInterfaceTypeN9                       uint8 = 15
-	TGPPInterfaceTypeSGi                      uint8 = 16
-	TGPPInterfaceTypeN6                       uint8 = 17
-	TGPPInterfaceTypeN19                      uint8 = 18
-	TGPPInterfaceTypeS8U                      uint8 = 19
-	TGPPInterfaceTypeGpU                      uint8 = 20
+	TGPPInterfaceTypeS1U                      TGPPInterfaceTypeValue = 0
+	TGPPInterfaceTypeS5S8U                    TGPPInterfaceTypeValue = 1
+	TGPPInterfaceTypeS4U                      TGPPInterfaceTypeValue = 2
+	TGPPInterfaceTypeS11U                     TGPPInterfaceTypeValue = 3
+	TGPPInterfaceTypeS12U                     TGPPInterfaceTypeValue = 4
+	TGPPInterfaceTypeGnGpU                    TGPPInterfaceTypeValue = 5
+	TGPPInterfaceTypeS2aU                     TGPPInterfaceTypeValue = 6
+	TGPPInterfaceTypeS2bU                     TGPPInterfaceTypeValue = 7
+	TGPPInterfaceTypeENBDL                    TGPPInterfaceTypeValue = 8
+	TGPPInterfaceTypeENBUL                    TGPPInterfaceTypeValue = 9
+	TGPPInterfaceTypeSGWUPFDL                 TGPPInterfaceTypeValue = 10
+	TGPPInterfaceTypeN33GPPAccess             TGPPInterfaceTypeValue = 11
+	TGPPInterfaceTypeN3TrustedNon3GPPAccess   TGPPInterfaceTypeValue = 12
+	TGPPInterfaceTypeN3UnTrustedNon3GPPAccess TGPPInterfaceTypeValue = 13
+	TGPPInterfaceTypeN3ForDataForwarding      TGPPInterfaceTypeValue = 14
+	TGPPInterfaceTypeN9                       TGPPInterfaceTypeValue = 15
+	TGPPInterfaceTypeSGi                      TGPPInterfaceTypeValue = 16
+	TGPPInterfaceTypeN6                       TGPPInterfaceTypeValue = 17
+	TGPPInterfaceTypeN19                      TGPPInterfaceTypeValue = 18
+	TGPPInterfaceTypeS8U                      TGPPInterfaceTypeValue = 19
+	TGPPInterfaceTypeGpU                      TGPPInterfaceTypeValue = 20
 )
 
+var tGPPInterfaceTypeValues = newEnum("TGPPInterfaceTypeValue", map[TGPPInterfaceTypeValue]string{
+	TGPPInterfaceTypeS1U:                      "TGPPInterfaceTypeS1U",
+	TGPPInterfaceTypeS5S8U:                    "TGPPInterfaceTypeS5S8U",
+	TGPPInterfaceTypeS4U:                      "TGPPInterfaceTypeS4U",
+	TGPPInterfaceTypeS11U:                     "TGPPInterfaceTypeS11U",
+	TGPPInterfaceTypeS12U:                     "TGPPInterfaceTypeS12U",
+	TGPPInterfaceTypeGnGpU:                    "TGPPInterfaceTypeGnGpU",
+	TGPPInterfaceTypeS2aU:                     "TGPPInterfaceTypeS2aU",
+	TGPPInterfaceTypeS2bU:                     "TGPPInterfaceTypeS2bU",
+	TGPPInterfaceTypeENBDL:                    "TGPPInterfaceTypeENBDL",
+	TGPPInterfaceTypeENBUL:                    "TGPPInterfaceTypeENBUL",
+	TGPPInterfaceTypeSGWUPFDL:                 "TGPPInterfaceTypeSGWUPFDL",
+	TGPPInterfaceTypeN33GPPAccess:             "TGPPInterfaceTypeN33GPPAccess",
+	TGPPInterfaceTypeN3TrustedNon3GPPAccess:   "TGPPInterfaceTypeN3TrustedNon3GPPAccess",
+	TGPPInterfaceTypeN3UnTrustedNon3GPPAccess: "TGPPInterfaceTypeN3UnTrustedNon3GPPAccess",
+	TGPPInterfaceTypeN3ForDataForwarding:      "TGPPInterfaceTypeN3ForDataForwarding",
+	TGPPInterfaceTypeN9:                       "TGPPInterfaceTypeN9",
+	TGPPInterfaceTypeSGi:                      "TGPPInterfaceTypeSGi",
+	TGPPInterfaceTypeN6:                       "TGPPInterfaceTypeN6",
+	TGPPInterfaceTypeN19:                      "TGPPInterfaceTypeN19",
+	TGPPInterfaceTypeS8U:                      "TGPPInterfaceTypeS8U",
+	TGPPInterfaceTypeGpU:                      "TGPPInterfaceTypeGpU",
+})
+
+// String returns the name of the TGPPInterfaceTypeValue, e.g., "TGPPInterfaceTypeS1U".
+func (v TGPPInterfaceTypeValue) String() string {
+	return tGPPInterfaceTypeValues.name(v)
+}
+
+// IsValid reports whether the TGPPInterfaceTypeValue is a defined value.
+func (v TGPPInterfaceTypeValue) IsValid() bool {
+	return tGPPInterfaceTypeValues.valid(v)
+}
+
+// TGPPInterfaceTypeValueByName returns the TGPPInterfaceTypeValue of the given name, e.g., "TGPPInterfaceTypeS1U".
+// The value in decimal is also accepted.
+func TGPPInterfaceTypeValueByName(name string) (TGPPInterfaceTypeValue, bool) {
+	return tGPPInterfaceTypeValues.byName(name)
+}
+
 // NewTGPPInterfaceType creates a new TGPPInterfaceType IE.
-func NewTGPPInterfaceType(intf uint8) *IE {
-	return newUint8ValIE(TGPPInterfaceType, intf)
+func NewTGPPInterfaceType(intf TGPPInterfaceTypeValue) *IE {
+	return newUint8ValIE(TGPPInterfaceType, uint8(intf))
 }
 
-// TGPPInterfaceType returns TGPPInterfaceType in uint8 if the type of IE matches.
-func (i *IE) TGPPInterfaceType() (uint8, error) {
+// TGPPInterfaceType returns TGPPInterfaceType in TGPPInterfaceTypeValue if the type of IE matches.
+func (i *IE) TGPPInterfaceType() (TGPPInterfaceTypeValue, error) {
 	if len(i.Payload) < 1 {
 		return 0, io.ErrUnexpectedEOF
 	}
 
 	switch i.Type {
 	case TGPPInterfaceType:
-		return i.Payload[0] & 0x3f, nil
+		return TGPPInterfaceTypeValue(i.Payload[0] & 0x3f), nil
 	case ForwardingParameters:
 		ies, err := i.ForwardingParameters()
 		if err != nil {
@@ -0,0 +1,148 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewThresholds creates a new Thresholds IE.
+//
+// RTT and PLR are encoded only when the corresponding flag is set in flags.
+func NewThresholds(flags uint8, rtt uint16, plr uint8) *IE {
+	fields := NewThresholdsFields(flags, rtt, plr)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(Thresholds, b)
+}
+
+// Thresholds returns Thresholds in structured format if the type of IE matches.
+func (i *IE) Thresholds() (*ThresholdsFields, error) {
+	if i.Type != Thresholds {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	fields, err := ParseThresholdsFields(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// ThresholdsFields represents a fields contained in Thresholds IE.
+type ThresholdsFields struct {
+	Flags uint8
+	RTT   uint16 // in milliseconds
+	PLR   uint8  // in percent
+}
+
+// NewThresholdsFields creates a new ThresholdsFields.
+func NewThresholdsFields(flags uint8, rtt uint16, plr uint8) *ThresholdsFields {
+	f := &ThresholdsFields{Flags: flags}
+
+	if f.HasRTT() {
+		f.RTT = rtt
+	}
+	if f.HasPLR() {
+		f.PLR = plr
+	}
+
+	return f
+}
+
+// HasRTT reports whether RTT flag is set.
+func (f *ThresholdsFields) HasRTT() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasPLR reports whether PLR flag is set.
+func (f *ThresholdsFields) HasPLR() bool {
+	return has2ndBit(f.Flags)
+}
+
+// ParseThresholdsFields parses b into ThresholdsFields.
+func ParseThresholdsFields(b []byte) (*ThresholdsFields, error) {
+	f := &ThresholdsFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *ThresholdsFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	offset := 1
+
+	if f.HasRTT() {
+		if l < offset+2 {
+			return io.ErrUnexpectedEOF
+		}
+		f.RTT = binary.BigEndian.Uint16(b[offset : offset+2])
+		offset += 2
+	}
+
+	if f.HasPLR() {
+		if l < offset+1 {
+			return io.ErrUnexpectedEOF
+		}
+		f.PLR = b[offset]
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of ThresholdsFields.
+func (f *ThresholdsFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *ThresholdsFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	offset := 1
+
+	if f.HasRTT() {
+		binary.BigEndian.PutUint16(b[offset:offset+2], f.RTT)
+		offset += 2
+	}
+	if f.HasPLR() {
+		b[offset] = f.PLR
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *ThresholdsFields) MarshalLen() int {
+	l := 1
+	if f.HasRTT() {
+		l += 2
+	}
+	if f.HasPLR() {
+		l++
+	}
+
+	return l
+}
@@ -9,16 +9,40 @@ import (
 	"time"
 )
 
+// BaseTimeIntervalTypeValue is the Base Time Interval Type in TimeQuotaMechanism IE.
+type BaseTimeIntervalTypeValue uint8
+
 // BaseTimeIntervalType definitions.
 const (
-	BTITCTP uint8 = 0
-	BTITDTP uint8 = 1
+	BTITCTP BaseTimeIntervalTypeValue = 0
+	BTITDTP BaseTimeIntervalTypeValue = 1
 )
 
+var baseTimeIntervalTypeValues = newEnum("BaseTimeIntervalTypeValue", map[BaseTimeIntervalTypeValue]string{
+	BTITCTP: "BTITCTP",
+	BTITDTP: "BTITDTP",
+})
+
+// String returns the name of the BaseTimeIntervalTypeValue, e.g., "BTITCTP".
+func (v BaseTimeIntervalTypeValue) String() string {
+	return baseTimeIntervalTypeValues.name(v)
+}
+
+// IsValid reports whether the BaseTimeIntervalTypeValue is a defined value.
+func (v BaseTimeIntervalTypeValue) IsValid() bool {
+	return baseTimeIntervalTypeValues.valid(v)
+}
+
+// BaseTimeIntervalTypeValueByName returns the BaseTimeIntervalTypeValue of the given name, e.g., "BTITCTP".
+// The value in decimal is also accepted.
+func BaseTimeIntervalTypeValueByName(name string) (BaseTimeIntervalTypeValue, bool) {
+	return baseTimeIntervalTypeValues.byName(name)
+}
+
 // NewTimeQuotaMechanism creates a new TimeQuotaMechanism IE.
-func NewTimeQuotaMechanism(btit uint8, bti time.Duration) *IE {
+func NewTimeQuotaMechanism(btit BaseTimeIntervalTypeValue, bti time.Duration) *IE {
 	b := make([]byte, 5)
-	b[0] = btit & 0x03
+	b[0] = uint8(btit) & 0x03
 	binary.BigEndian.PutUint32(b[1:5], uint32(bti.Seconds()))
 
 	return New(TimeQuotaMechanism, b)
@@ -0,0 +1,90 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewTLContainer creates a new TLContainer IE.
+//
+// The container carries the TSN bridge or router management information exchanged
+// between the TSN AF or TSCTSF and the UP function, encoded as defined in TS 24.539.
+// This library does not look into the container.
+func NewTLContainer(container []byte) *IE {
+	fields := NewTLContainerFields(container)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(TLContainer, b)
+}
+
+// TLContainer returns TLContainer in structured format if the type of IE matches.
+func (i *IE) TLContainer() (*TLContainerFields, error) {
+	if i.Type != TLContainer {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	fields, err := ParseTLContainerFields(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// TLContainerFields represents a fields contained in TLContainer IE.
+type TLContainerFields struct {
+	Container []byte
+}
+
+// NewTLContainerFields creates a new TLContainerFields.
+func NewTLContainerFields(container []byte) *TLContainerFields {
+	return &TLContainerFields{Container: container}
+}
+
+// ParseTLContainerFields parses b into TLContainerFields.
+func ParseTLContainerFields(b []byte) (*TLContainerFields, error) {
+	f := &TLContainerFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *TLContainerFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Container = b
+	return nil
+}
+
+// Marshal returns the serialized bytes of TLContainerFields.
+func (f *TLContainerFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *TLContainerFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	copy(b, f.Container)
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *TLContainerFields) MarshalLen() int {
+	return len(f.Container)
+}
@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewTrafficParameterMeasurementControlInformation creates a new TrafficParameterMeasurementControlInformation IE.
+func NewTrafficParameterMeasurementControlInformation(ies ...*IE) *IE {
+	return newGroupedIE(TrafficParameterMeasurementControlInformation, 0, ies...)
+}
+
+// TrafficParameterMeasurementControlInformation returns the IEs above TrafficParameterMeasurementControlInformation if the type of IE matches.
+func (i *IE) TrafficParameterMeasurementControlInformation() ([]*IE, error) {
+	if i.Type != TrafficParameterMeasurementControlInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
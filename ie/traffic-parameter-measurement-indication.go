@@ -0,0 +1,41 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewTrafficParameterMeasurementIndication creates a new TrafficParameterMeasurementIndication IE.
+func NewTrafficParameterMeasurementIndication(flags uint8) *IE {
+	return newUint8ValIE(TrafficParameterMeasurementIndication, flags)
+}
+
+// TrafficParameterMeasurementIndication returns TrafficParameterMeasurementIndication in uint8 if the type of IE matches.
+func (i *IE) TrafficParameterMeasurementIndication() (uint8, error) {
+	switch i.Type {
+	case TrafficParameterMeasurementIndication:
+		return i.ValueAsUint8()
+	case TrafficParameterMeasurementControlInformation:
+		ies, err := i.TrafficParameterMeasurementControlInformation()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == TrafficParameterMeasurementIndication {
+				return x.TrafficParameterMeasurementIndication()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// HasN6JM reports whether an IE has N6JM bit.
+func (i *IE) HasN6JM() bool {
+	v, err := i.TrafficParameterMeasurementIndication()
+	if err != nil {
+		return false
+	}
+
+	return has3rdBit(v)
+}
@@ -0,0 +1,19 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewTrafficParameterMeasurementReport creates a new TrafficParameterMeasurementReport IE.
+func NewTrafficParameterMeasurementReport(ies ...*IE) *IE {
+	return newGroupedIE(TrafficParameterMeasurementReport, 0, ies...)
+}
+
+// TrafficParameterMeasurementReport returns the IEs above TrafficParameterMeasurementReport if the type of IE matches.
+func (i *IE) TrafficParameterMeasurementReport() ([]*IE, error) {
+	if i.Type != TrafficParameterMeasurementReport {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.childIEs()
+}
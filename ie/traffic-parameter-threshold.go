@@ -0,0 +1,131 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewTrafficParameterThreshold creates a new TrafficParameterThreshold IE.
+//
+// The DL N6 jitter threshold in milliseconds is encoded only when the DL flag (0x01)
+// is set. The UP function reports the N6 jitter when the measured one exceeds it.
+func NewTrafficParameterThreshold(flags uint8, dlJitter uint32) *IE {
+	fields := NewTrafficParameterThresholdFields(flags, dlJitter)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(TrafficParameterThreshold, b)
+}
+
+// TrafficParameterThreshold returns TrafficParameterThreshold in structured format if the type of IE matches.
+func (i *IE) TrafficParameterThreshold() (*TrafficParameterThresholdFields, error) {
+	switch i.Type {
+	case TrafficParameterThreshold:
+		fields, err := ParseTrafficParameterThresholdFields(i.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	case TrafficParameterMeasurementControlInformation:
+		ies, err := i.TrafficParameterMeasurementControlInformation()
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range ies {
+			if x.Type == TrafficParameterThreshold {
+				return x.TrafficParameterThreshold()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// TrafficParameterThresholdFields represents a fields contained in TrafficParameterThreshold IE.
+type TrafficParameterThresholdFields struct {
+	Flags               uint8
+	DLN6JitterThreshold uint32
+}
+
+// NewTrafficParameterThresholdFields creates a new TrafficParameterThresholdFields.
+func NewTrafficParameterThresholdFields(flags uint8, dlJitter uint32) *TrafficParameterThresholdFields {
+	f := &TrafficParameterThresholdFields{Flags: flags}
+
+	if f.HasDL() {
+		f.DLN6JitterThreshold = dlJitter
+	}
+
+	return f
+}
+
+// HasDL reports whether DL flag is set.
+func (f *TrafficParameterThresholdFields) HasDL() bool {
+	return has1stBit(f.Flags)
+}
+
+// ParseTrafficParameterThresholdFields parses b into TrafficParameterThresholdFields.
+func ParseTrafficParameterThresholdFields(b []byte) (*TrafficParameterThresholdFields, error) {
+	f := &TrafficParameterThresholdFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *TrafficParameterThresholdFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	if f.HasDL() {
+		if l < 5 {
+			return io.ErrUnexpectedEOF
+		}
+		f.DLN6JitterThreshold = binary.BigEndian.Uint32(b[1:5])
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of TrafficParameterThresholdFields.
+func (f *TrafficParameterThresholdFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *TrafficParameterThresholdFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	if f.HasDL() {
+		binary.BigEndian.PutUint32(b[1:5], f.DLN6JitterThreshold)
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *TrafficParameterThresholdFields) MarshalLen() int {
+	if f.HasDL() {
+		return 5
+	}
+	return 1
+}
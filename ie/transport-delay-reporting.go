@@ -13,7 +13,7 @@ func NewTransportDelayReporting(ies ...*IE) *IE {
 func (i *IE) TransportDelayReporting() ([]*IE, error) {
 	switch i.Type {
 	case TransportDelayReporting:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case CreatePDR:
 		ies, err := i.CreatePDR()
 		if err != nil {
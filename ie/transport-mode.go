@@ -0,0 +1,53 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// TransportModeValue is the value of TransportMode IE, the MPQUIC transport mode
+// defined in TS 24.193.
+type TransportModeValue uint8
+
+// TransportMode definitions.
+const (
+	TransportModeDatagram1 TransportModeValue = 0
+	TransportModeDatagram2 TransportModeValue = 1
+	TransportModeStreaming TransportModeValue = 2
+)
+
+var transportModeValues = newEnum("TransportModeValue", map[TransportModeValue]string{
+	TransportModeDatagram1: "TransportModeDatagram1",
+	TransportModeDatagram2: "TransportModeDatagram2",
+	TransportModeStreaming: "TransportModeStreaming",
+})
+
+// String returns the name of the TransportModeValue, e.g., "TransportModeStreaming".
+func (v TransportModeValue) String() string {
+	return transportModeValues.name(v)
+}
+
+// IsValid reports whether the TransportModeValue is a defined value.
+func (v TransportModeValue) IsValid() bool {
+	return transportModeValues.valid(v)
+}
+
+// TransportModeValueByName returns the TransportModeValue of the given name, e.g.,
+// "TransportModeStreaming". The value in decimal is also accepted.
+func TransportModeValueByName(name string) (TransportModeValue, bool) {
+	return transportModeValues.byName(name)
+}
+
+// NewTransportMode creates a new TransportMode IE.
+func NewTransportMode(v TransportModeValue) *IE {
+	return newUint8ValIE(TransportMode, uint8(v))
+}
+
+// TransportMode returns TransportMode in TransportModeValue if the type of IE matches.
+func (i *IE) TransportMode() (TransportModeValue, error) {
+	if i.Type != TransportMode {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+
+	v, err := i.ValueAsUint8()
+	return TransportModeValue(v), err
+}
@@ -0,0 +1,31 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewTunnelPassword creates a new TunnelPassword IE.
+func NewTunnelPassword(v string) *IE {
+	return newStringIE(TunnelPassword, v)
+}
+
+// TunnelPassword returns TunnelPassword in string if the type of IE matches.
+func (i *IE) TunnelPassword() (string, error) {
+	switch i.Type {
+	case TunnelPassword:
+		return i.ValueAsString()
+	case L2TPTunnelInformation:
+		ies, err := i.L2TPTunnelInformation()
+		if err != nil {
+			return "", err
+		}
+		for _, x := range ies {
+			if x.Type == TunnelPassword {
+				return x.TunnelPassword()
+			}
+		}
+		return "", ErrIENotFound
+	default:
+		return "", &InvalidTypeError{Type: i.Type}
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewTunnelPreference creates a new TunnelPreference IE.
+func NewTunnelPreference(v uint32) *IE {
+	return newUint32ValIE(TunnelPreference, v)
+}
+
+// TunnelPreference returns TunnelPreference in uint32 if the type of IE matches.
+func (i *IE) TunnelPreference() (uint32, error) {
+	switch i.Type {
+	case TunnelPreference:
+		return i.ValueAsUint32()
+	case L2TPTunnelInformation:
+		ies, err := i.L2TPTunnelInformation()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == TunnelPreference {
+				return x.TunnelPreference()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "strconv"
+
+// typeNames is the names of IE types, which are the same as the constants.
+var typeNames = map[uint16]string{
+	CreatePDR:                            "CreatePDR",
+	PDI:                                  "PDI",
+	CreateFAR:                            "CreateFAR",
+	ForwardingParameters:                 "ForwardingParameters",
+	DuplicatingParameters:                "DuplicatingParameters",
+	CreateURR:                            "CreateURR",
+	CreateQER:                            "CreateQER",
+	CreatedPDR:                           "CreatedPDR",
+	UpdatePDR:                            "UpdatePDR",
+	UpdateFAR:                            "UpdateFAR",
+	UpdateForwardingParameters:           "UpdateForwardingParameters",
+	UpdateBARWithinSessionReportResponse: "UpdateBARWithinSessionReportResponse",
+	UpdateURR:                            "UpdateURR",
+	UpdateQER:                            "UpdateQER",
+	RemovePDR:                            "RemovePDR",
+	RemoveFAR:                            "RemoveFAR",
+	RemoveURR:                            "RemoveURR",
+	RemoveQER:                            "RemoveQER",
+	Cause:                                "Cause",
+	SourceInterface:                      "SourceInterface",
+	FTEID:                                "FTEID",
+	NetworkInstance:                      "NetworkInstance",
+	SDFFilter:                            "SDFFilter",
+	ApplicationID:                        "ApplicationID",
+	GateStatus:                           "GateStatus",
+	MBR:                                  "MBR",
+	GBR:                                  "GBR",
+	QERCorrelationID:                     "QERCorrelationID",
+	Precedence:                           "Precedence",
+	TransportLevelMarking:                "TransportLevelMarking",
+	VolumeThreshold:                      "VolumeThreshold",
+	TimeThreshold:                        "TimeThreshold",
+	MonitoringTime:                       "MonitoringTime",
+	SubsequentVolumeThreshold:            "SubsequentVolumeThreshold",
+	SubsequentTimeThreshold:              "SubsequentTimeThreshold",
+	InactivityDetectionTime:              "InactivityDetectionTime",
+	ReportingTriggers:                    "ReportingTriggers",
+	RedirectInformation:                  "RedirectInformation",
+	ReportType:                           "ReportType",
+	OffendingIE:                          "OffendingIE",
+	ForwardingPolicy:                     "ForwardingPolicy",
+	DestinationInterface:                 "DestinationInterface",
+	UPFunctionFeatures:                   "UPFunctionFeatures",
+	ApplyAction:                          "ApplyAction",
+	DownlinkDataServiceInformation:       "DownlinkDataServiceInformation",
+	DownlinkDataNotificationDelay:        "DownlinkDataNotificationDelay",
+	DLBufferingDuration:                  "DLBufferingDuration",
+	DLBufferingSuggestedPacketCount:      "DLBufferingSuggestedPacketCount",
+	PFCPSMReqFlags:                       "PFCPSMReqFlags",
+	PFCPSRRspFlags:                       "PFCPSRRspFlags",
+	LoadControlInformation:               "LoadControlInformation",
+	SequenceNumber:                       "SequenceNumber",
+	Metric:                               "Metric",
+	OverloadControlInformation:           "OverloadControlInformation",
+	Timer:                                "Timer",
+	PDRID:                                "PDRID",
+	FSEID:                                "FSEID",
+	ApplicationIDsPFDs:                   "ApplicationIDsPFDs",
+	PFDContext:                           "PFDContext",
+	NodeID:                               "NodeID",
+	PFDContents:                          "PFDContents",
+	MeasurementMethod:                    "MeasurementMethod",
+	UsageReportTrigger:                   "UsageReportTrigger",
+	MeasurementPeriod:                    "MeasurementPeriod",
+	FQCSID:                               "FQCSID",
+	VolumeMeasurement:                    "VolumeMeasurement",
+	DurationMeasurement:                  "DurationMeasurement",
+	ApplicationDetectionInformation:      "ApplicationDetectionInformation",
+	TimeOfFirstPacket:                    "TimeOfFirstPacket",
+	TimeOfLastPacket:                     "TimeOfLastPacket",
+	QuotaHoldingTime:                     "QuotaHoldingTime",
+	DroppedDLTrafficThreshold:            "DroppedDLTrafficThreshold",
+	VolumeQuota:                          "VolumeQuota",
+	TimeQuota:                            "TimeQuota",
+	StartTime:                            "StartTime",
+	EndTime:                              "EndTime",
+	QueryURR:                             "QueryURR",
+	UsageReportWithinSessionModificationResponse: "UsageReportWithinSessionModificationResponse",
+	UsageReportWithinSessionDeletionResponse:     "UsageReportWithinSessionDeletionResponse",
+	UsageReportWithinSessionReportRequest:        "UsageReportWithinSessionReportRequest",
+	URRID:                                        "URRID",
+	LinkedURRID:                                  "LinkedURRID",
+	DownlinkDataReport:                           "DownlinkDataReport",
+	OuterHeaderCreation:                          "OuterHeaderCreation",
+	CreateBAR:                                    "CreateBAR",
+	UpdateBARWithinSessionModificationRequest:    "UpdateBARWithinSessionModificationRequest",
+	RemoveBAR:                                    "RemoveBAR",
+	BARID:                                        "BARID",
+	CPFunctionFeatures:                           "CPFunctionFeatures",
+	UsageInformation:                             "UsageInformation",
+	ApplicationInstanceID:                        "ApplicationInstanceID",
+	FlowInformation:                              "FlowInformation",
+	UEIPAddress:                                  "UEIPAddress",
+	PacketRate:                                   "PacketRate",
+	OuterHeaderRemoval:                           "OuterHeaderRemoval",
+	RecoveryTimeStamp:                            "RecoveryTimeStamp",
+	DLFlowLevelMarking:                           "DLFlowLevelMarking",
+	HeaderEnrichment:                             "HeaderEnrichment",
+	ErrorIndicationReport:                        "ErrorIndicationReport",
+	MeasurementInformation:                       "MeasurementInformation",
+	NodeReportType:                               "NodeReportType",
+	UserPlanePathFailureReport:                   "UserPlanePathFailureReport",
+	RemoteGTPUPeer:                               "RemoteGTPUPeer",
+	URSEQN:                                       "URSEQN",
+	UpdateDuplicatingParameters:                  "UpdateDuplicatingParameters",
+	ActivatePredefinedRules:                      "ActivatePredefinedRules",
+	DeactivatePredefinedRules:                    "DeactivatePredefinedRules",
+	FARID:                                        "FARID",
+	QERID:                                        "QERID",
+	OCIFlags:                                     "OCIFlags",
+	PFCPAssociationReleaseRequest:                "PFCPAssociationReleaseRequest",
+	GracefulReleasePeriod:                        "GracefulReleasePeriod",
+	PDNType:                                      "PDNType",
+	FailedRuleID:                                 "FailedRuleID",
+	TimeQuotaMechanism:                           "TimeQuotaMechanism",
+	UserPlaneIPResourceInformation:               "UserPlaneIPResourceInformation",
+	UserPlaneInactivityTimer:                     "UserPlaneInactivityTimer",
+	AggregatedURRs:                               "AggregatedURRs",
+	Multiplier:                                   "Multiplier",
+	AggregatedURRID:                              "AggregatedURRID",
+	SubsequentVolumeQuota:                        "SubsequentVolumeQuota",
+	SubsequentTimeQuota:                          "SubsequentTimeQuota",
+	RQI:                                          "RQI",
+	QFI:                                          "QFI",
+	QueryURRReference:                            "QueryURRReference",
+	AdditionalUsageReportsInformation:            "AdditionalUsageReportsInformation",
+	CreateTrafficEndpoint:                        "CreateTrafficEndpoint",
+	CreatedTrafficEndpoint:                       "CreatedTrafficEndpoint",
+	UpdateTrafficEndpoint:                        "UpdateTrafficEndpoint",
+	RemoveTrafficEndpoint:                        "RemoveTrafficEndpoint",
+	TrafficEndpointID:                            "TrafficEndpointID",
+	EthernetPacketFilter:                         "EthernetPacketFilter",
+	MACAddress:                                   "MACAddress",
+	CTAG:                                         "CTAG",
+	STAG:                                         "STAG",
+	Ethertype:                                    "Ethertype",
+	Proxying:                                     "Proxying",
+	EthernetFilterID:                             "EthernetFilterID",
+	EthernetFilterProperties:                     "EthernetFilterProperties",
+	SuggestedBufferingPacketsCount:               "SuggestedBufferingPacketsCount",
+	UserID:                                       "UserID",
+	EthernetPDUSessionInformation:                "EthernetPDUSessionInformation",
+	EthernetTrafficInformation:                   "EthernetTrafficInformation",
+	MACAddressesDetected:                         "MACAddressesDetected",
+	MACAddressesRemoved:                          "MACAddressesRemoved",
+	EthernetInactivityTimer:                      "EthernetInactivityTimer",
+	AdditionalMonitoringTime:                     "AdditionalMonitoringTime",
+	EventQuota:                                   "EventQuota",
+	EventThreshold:                               "EventThreshold",
+	SubsequentEventQuota:                         "SubsequentEventQuota",
+	SubsequentEventThreshold:                     "SubsequentEventThreshold",
+	TraceInformation:                             "TraceInformation",
+	FramedRoute:                                  "FramedRoute",
+	FramedRouting:                                "FramedRouting",
+	FramedIPv6Route:                              "FramedIPv6Route",
+	EventTimeStamp:                               "EventTimeStamp",
+	AveragingWindow:                              "AveragingWindow",
+	PagingPolicyIndicator:                        "PagingPolicyIndicator",
+	APNDNN:                                       "APNDNN",
+	TGPPInterfaceType:                            "TGPPInterfaceType",
+	PFCPSRReqFlags:                               "PFCPSRReqFlags",
+	PFCPAUReqFlags:                               "PFCPAUReqFlags",
+	ActivationTime:                               "ActivationTime",
+	DeactivationTime:                             "DeactivationTime",
+	CreateMAR:                                    "CreateMAR",
+	TGPPAccessForwardingActionInformation:        "TGPPAccessForwardingActionInformation",
+	NonTGPPAccessForwardingActionInformation:     "NonTGPPAccessForwardingActionInformation",
+	RemoveMAR:                                    "RemoveMAR",
+	UpdateMAR:                                    "UpdateMAR",
+	MARID:                                        "MARID",
+	SteeringFunctionality:                        "SteeringFunctionality",
+	SteeringMode:                                 "SteeringMode",
+	Weight:                                       "Weight",
+	Priority:                                     "Priority",
+	UpdateTGPPAccessForwardingActionInformation:     "UpdateTGPPAccessForwardingActionInformation",
+	UpdateNonTGPPAccessForwardingActionInformation:  "UpdateNonTGPPAccessForwardingActionInformation",
+	UEIPAddressPoolIdentity:                         "UEIPAddressPoolIdentity",
+	AlternativeSMFIPAddress:                         "AlternativeSMFIPAddress",
+	PacketReplicationAndDetectionCarryOnInformation: "PacketReplicationAndDetectionCarryOnInformation",
+	SMFSetID:                                     "SMFSetID",
+	QuotaValidityTime:                            "QuotaValidityTime",
+	NumberOfReports:                              "NumberOfReports",
+	PFCPSessionRetentionInformation:              "PFCPSessionRetentionInformation",
+	PFCPASRspFlags:                               "PFCPASRspFlags",
+	CPPFCPEntityIPAddress:                        "CPPFCPEntityIPAddress",
+	PFCPSEReqFlags:                               "PFCPSEReqFlags",
+	UserPlanePathRecoveryReport:                  "UserPlanePathRecoveryReport",
+	IPMulticastAddressingInfo:                    "IPMulticastAddressingInfo",
+	JoinIPMulticastInformationWithinUsageReport:  "JoinIPMulticastInformationWithinUsageReport",
+	LeaveIPMulticastInformationWithinUsageReport: "LeaveIPMulticastInformationWithinUsageReport",
+	IPMulticastAddress:                           "IPMulticastAddress",
+	SourceIPAddress:                              "SourceIPAddress",
+	PacketRateStatus:                             "PacketRateStatus",
+	CreateBridgeInfoForTSC:                       "CreateBridgeInfoForTSC",
+	CreatedBridgeInfoForTSC:                      "CreatedBridgeInfoForTSC",
+	DSTTPortNumber:                               "DSTTPortNumber",
+	NWTTPortNumber:                               "NWTTPortNumber",
+	TSNBridgeID:                                  "TSNBridgeID",
+	TSCManagementInformationWithinSessionModificationRequest:  "TSCManagementInformationWithinSessionModificationRequest",
+	TSCManagementInformationWithinSessionModificationResponse: "TSCManagementInformationWithinSessionModificationResponse",
+	TSCManagementInformationWithinSessionReportRequest:        "TSCManagementInformationWithinSessionReportRequest",
+	PortManagementInformationContainer:                        "PortManagementInformationContainer",
+	ClockDriftControlInformation:                              "ClockDriftControlInformation",
+	RequestedClockDriftInformation:                            "RequestedClockDriftInformation",
+	ClockDriftReport:                                          "ClockDriftReport",
+	TSNTimeDomainNumber:                                       "TSNTimeDomainNumber",
+	TimeOffsetThreshold:                                       "TimeOffsetThreshold",
+	CumulativeRateRatioThreshold:                              "CumulativeRateRatioThreshold",
+	TimeOffsetMeasurement:                                     "TimeOffsetMeasurement",
+	CumulativeRateRatioMeasurement:                            "CumulativeRateRatioMeasurement",
+	RemoveSRR:                                                 "RemoveSRR",
+	CreateSRR:                                                 "CreateSRR",
+	UpdateSRR:                                                 "UpdateSRR",
+	SessionReport:                                             "SessionReport",
+	SRRID:                                                     "SRRID",
+	AccessAvailabilityControlInformation:                      "AccessAvailabilityControlInformation",
+	RequestedAccessAvailabilityInformation:                    "RequestedAccessAvailabilityInformation",
+	AccessAvailabilityReport:                                  "AccessAvailabilityReport",
+	AccessAvailabilityInformation:                             "AccessAvailabilityInformation",
+	ProvideATSSSControlInformation:                            "ProvideATSSSControlInformation",
+	ATSSSControlParameters:                                    "ATSSSControlParameters",
+	MPTCPControlInformation:                                   "MPTCPControlInformation",
+	ATSSSLLControlInformation:                                 "ATSSSLLControlInformation",
+	PMFControlInformation:                                     "PMFControlInformation",
+	MPTCPParameters:                                           "MPTCPParameters",
+	ATSSSLLParameters:                                         "ATSSSLLParameters",
+	PMFParameters:                                             "PMFParameters",
+	MPTCPAddressInformation:                                   "MPTCPAddressInformation",
+	UELinkSpecificIPAddress:                                   "UELinkSpecificIPAddress",
+	PMFAddressInformation:                                     "PMFAddressInformation",
+	ATSSSLLInformation:                                        "ATSSSLLInformation",
+	DataNetworkAccessIdentifier:                               "DataNetworkAccessIdentifier",
+	UEIPAddressPoolInformation:                                "UEIPAddressPoolInformation",
+	AveragePacketDelay:                                        "AveragePacketDelay",
+	MinimumPacketDelay:                                        "MinimumPacketDelay",
+	MaximumPacketDelay:                                        "MaximumPacketDelay",
+	QoSReportTrigger:                                          "QoSReportTrigger",
+	GTPUPathQoSControlInformation:                             "GTPUPathQoSControlInformation",
+	GTPUPathQoSReport:                                         "GTPUPathQoSReport",
+	QoSInformationInGTPUPathQoSReport:                         "QoSInformationInGTPUPathQoSReport",
+	GTPUPathInterfaceType:                                     "GTPUPathInterfaceType",
+	QoSMonitoringPerQoSFlowControlInformation:                 "QoSMonitoringPerQoSFlowControlInformation",
+	RequestedQoSMonitoring:                                    "RequestedQoSMonitoring",
+	ReportingFrequency:                                        "ReportingFrequency",
+	PacketDelayThresholds:                                     "PacketDelayThresholds",
+	MinimumWaitTime:                                           "MinimumWaitTime",
+	QoSMonitoringReport:                                       "QoSMonitoringReport",
+	QoSMonitoringMeasurement:                                  "QoSMonitoringMeasurement",
+	MTEDTControlInformation:                                   "MTEDTControlInformation",
+	DLDataPacketsSize:                                         "DLDataPacketsSize",
+	QERControlIndications:                                     "QERControlIndications",
+	PacketRateStatusReport:                                    "PacketRateStatusReport",
+	NFInstanceID:                                              "NFInstanceID",
+	EthernetContextInformation:                                "EthernetContextInformation",
+	RedundantTransmissionParameters:                           "RedundantTransmissionParameters",
+	UpdatedPDR:                                                "UpdatedPDR",
+	SNSSAI:                                                    "SNSSAI",
+	IPVersion:                                                 "IPVersion",
+	PFCPASReqFlags:                                            "PFCPASReqFlags",
+	DataStatus:                                                "DataStatus",
+	ProvideRDSConfigurationInformation:                        "ProvideRDSConfigurationInformation",
+	RDSConfigurationInformation:                               "RDSConfigurationInformation",
+	QueryPacketRateStatusWithinSessionModificationRequest:   "QueryPacketRateStatusWithinSessionModificationRequest",
+	PacketRateStatusReportWithinSessionModificationResponse: "PacketRateStatusReportWithinSessionModificationResponse",
+	MPTCPApplicableIndication:                               "MPTCPApplicableIndication",
+	BridgeManagementInformationContainer:                    "BridgeManagementInformationContainer",
+	UEIPAddressUsageInformation:                             "UEIPAddressUsageInformation",
+	NumberOfUEIPAddresses:                                   "NumberOfUEIPAddresses",
+	ValidityTimer:                                           "ValidityTimer",
+	RedundantTransmissionForwardingParameters:               "RedundantTransmissionForwardingParameters",
+	TransportDelayReporting:                                 "TransportDelayReporting",
+	PartialFailureInformation:                               "PartialFailureInformation",
+	OffendingIEInformation:                                  "OffendingIEInformation",
+	RATType:                                                 "RATType",
+	L2TPTunnelInformation:                                   "L2TPTunnelInformation",
+	L2TPSessionInformation:                                  "L2TPSessionInformation",
+	L2TPUserAuthentication:                                  "L2TPUserAuthentication",
+	CreatedL2TPSession:                                      "CreatedL2TPSession",
+	LNSAddress:                                              "LNSAddress",
+	TunnelPreference:                                        "TunnelPreference",
+	CallingNumber:                                           "CallingNumber",
+	CalledNumber:                                            "CalledNumber",
+	L2TPSessionIndications:                                  "L2TPSessionIndications",
+	DNSServerAddress:                                        "DNSServerAddress",
+	NBNSServerAddress:                                       "NBNSServerAddress",
+	MaximumReceiveUnit:                                      "MaximumReceiveUnit",
+	Thresholds:                                              "Thresholds",
+	SteeringModeIndicator:                                   "SteeringModeIndicator",
+	PFCPSessionChangeInfo:                                   "PFCPSessionChangeInfo",
+	GroupID:                                                 "GroupID",
+	CPIPAddress:                                             "CPIPAddress",
+	IPAddressAndPortNumberReplacement:                       "IPAddressAndPortNumberReplacement",
+	DNSQueryResponseFilter:                                  "DNSQueryResponseFilter",
+	DirectReportingInformation:                              "DirectReportingInformation",
+	EventNotificationURI:                                    "EventNotificationURI",
+	NotificationCorrelationID:                               "NotificationCorrelationID",
+	ReportingFlags:                                          "ReportingFlags",
+	PredefinedRulesName:                                     "PredefinedRulesName",
+	MBSSessionN4mbControlInformation:                        "MBSSessionN4mbControlInformation",
+	MBSMulticastParameters:                                  "MBSMulticastParameters",
+	AddMBSUnicastParameters:                                 "AddMBSUnicastParameters",
+	MBSSessionN4mbInformation:                               "MBSSessionN4mbInformation",
+	RemoveMBSUnicastParameters:                              "RemoveMBSUnicastParameters",
+	MBSSessionIdentifier:                                    "MBSSessionIdentifier",
+	MulticastTransportInformation:                           "MulticastTransportInformation",
+	MBSN4mbReqFlags:                                         "MBSN4mbReqFlags",
+	LocalIngressTunnel:                                      "LocalIngressTunnel",
+	MBSUnicastParametersID:                                  "MBSUnicastParametersID",
+	MBSSessionN4ControlInformation:                          "MBSSessionN4ControlInformation",
+	MBSSessionN4Information:                                 "MBSSessionN4Information",
+	MBSN4RespFlags:                                          "MBSN4RespFlags",
+	TunnelPassword:                                          "TunnelPassword",
+	AreaSessionID:                                           "AreaSessionID",
+	PeerUPRestartReport:                                     "PeerUPRestartReport",
+	DSCPToPPIControlInformation:                             "DSCPToPPIControlInformation",
+	DSCPToPPIMappingInformation:                             "DSCPToPPIMappingInformation",
+	PFCPSDRspFlags:                                          "PFCPSDRspFlags",
+	QERIndications:                                          "QERIndications",
+	VendorSpecificNodeReportType:                            "VendorSpecificNodeReportType",
+	ConfiguredTimeDomain:                                    "ConfiguredTimeDomain",
+	Metadata:                                                "Metadata",
+	TrafficParameterMeasurementControlInformation:           "TrafficParameterMeasurementControlInformation",
+	TrafficParameterMeasurementReport:                       "TrafficParameterMeasurementReport",
+	TrafficParameterThreshold:                               "TrafficParameterThreshold",
+	DLPeriodicity:                                           "DLPeriodicity",
+	N6JitterMeasurement:                                     "N6JitterMeasurement",
+	TrafficParameterMeasurementIndication:                   "TrafficParameterMeasurementIndication",
+	ULPeriodicity:                                           "ULPeriodicity",
+	MPQUICControlInformation:                                "MPQUICControlInformation",
+	MPQUICParameters:                                        "MPQUICParameters",
+	MPQUICAddressInformation:                                "MPQUICAddressInformation",
+	TransportMode:                                           "TransportMode",
+	ProtocolDescription:                                     "ProtocolDescription",
+	ReportingSuggestionInfo:                                 "ReportingSuggestionInfo",
+	TLContainer:                                             "TLContainer",
+	MeasurementIndication:                                   "MeasurementIndication",
+	HPLMNSNSSAI:                                             "HPLMNSNSSAI",
+	MediaTransportProtocol:                                  "MediaTransportProtocol",
+	RTPHeaderExtensionInformation:                           "RTPHeaderExtensionInformation",
+	RTPPayloadInformation:                                   "RTPPayloadInformation",
+	RTPHeaderExtensionType:                                  "RTPHeaderExtensionType",
+	RTPHeaderExtensionID:                                    "RTPHeaderExtensionID",
+	RTPPayloadType:                                          "RTPPayloadType",
+	RTPPayloadFormat:                                        "RTPPayloadFormat",
+	ExtendedDLBufferingNotificationPolicy:                   "ExtendedDLBufferingNotificationPolicy",
+	MTSDTControlInformation:                                 "MTSDTControlInformation",
+	ReportingThresholds:                                     "ReportingThresholds",
+	RTPHeaderExtensionAdditionalInformation:                 "RTPHeaderExtensionAdditionalInformation",
+	MappedN6IPAddress:                                       "MappedN6IPAddress",
+	N6RoutingInformation:                                    "N6RoutingInformation",
+}
+
+// typesByName is the reverse lookup table of typeNames, including the old names
+// of the IEs renamed in the later releases.
+var typesByName = func() map[string]uint16 {
+	m := make(map[string]uint16, len(typeNames))
+	for t, n := range typeNames {
+		m[n] = t
+	}
+	m["PortManagementInformationForTSCWithinSessionModificationRequest"] = PortManagementInformationForTSCWithinSessionModificationRequest
+	m["PortManagementInformationForTSCWithinSessionModificationResponse"] = PortManagementInformationForTSCWithinSessionModificationResponse
+	m["PortManagementInformationForTSCWithinSessionReportRequest"] = PortManagementInformationForTSCWithinSessionReportRequest
+	return m
+}()
+
+// TypeName returns the name of the IE type, which is the same as the name of the
+// constant, e.g., "CreatePDR" for CreatePDR.
+//
+// For unknown types, this returns the type in decimal.
+func TypeName(itype uint16) string {
+	if n, ok := typeNames[itype]; ok {
+		return n
+	}
+	return strconv.Itoa(int(itype))
+}
+
+// TypeByName returns the IE type of the given name, which is the same as the name
+// of the constant. The type in decimal is also accepted.
+func TypeByName(name string) (uint16, bool) {
+	if t, ok := typesByName[name]; ok {
+		return t, true
+	}
+
+	n, err := strconv.ParseUint(name, 10, 16)
+	if err != nil {
+		return 0, false
+	}
+	return uint16(n), true
+}
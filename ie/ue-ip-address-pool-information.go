@@ -15,5 +15,5 @@ func (i *IE) UEIPAddressPoolInformation() ([]*IE, error) {
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
 
-	return ParseMultiIEs(i.Payload)
+	return i.childIEs()
 }
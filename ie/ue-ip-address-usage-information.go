@@ -13,7 +13,7 @@ func NewUEIPAddressUsageInformation(ies ...*IE) *IE {
 func (i *IE) UEIPAddressUsageInformation() ([]*IE, error) {
 	switch i.Type {
 	case UEIPAddressUsageInformation:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	default:
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
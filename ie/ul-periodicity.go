@@ -0,0 +1,31 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewULPeriodicity creates a new ULPeriodicity IE.
+func NewULPeriodicity(v uint32) *IE {
+	return newUint32ValIE(ULPeriodicity, v)
+}
+
+// ULPeriodicity returns ULPeriodicity in uint32 if the type of IE matches.
+func (i *IE) ULPeriodicity() (uint32, error) {
+	switch i.Type {
+	case ULPeriodicity:
+		return i.ValueAsUint32()
+	case TrafficParameterMeasurementReport:
+		ies, err := i.TrafficParameterMeasurementReport()
+		if err != nil {
+			return 0, err
+		}
+		for _, x := range ies {
+			if x.Type == ULPeriodicity {
+				return x.ULPeriodicity()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
@@ -13,7 +13,7 @@ func NewUpdateTGPPAccessForwardingActionInformation(ies ...*IE) *IE {
 func (i *IE) UpdateTGPPAccessForwardingActionInformation() ([]*IE, error) {
 	switch i.Type {
 	case UpdateTGPPAccessForwardingActionInformation:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case UpdateMAR:
 		ies, err := i.UpdateMAR()
 		if err != nil {
@@ -13,7 +13,7 @@ func NewUpdateDuplicatingParameters(ies ...*IE) *IE {
 func (i *IE) UpdateDuplicatingParameters() ([]*IE, error) {
 	switch i.Type {
 	case UpdateDuplicatingParameters:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case UpdateFAR:
 		ies, err := i.UpdateFAR()
 		if err != nil {
@@ -13,7 +13,7 @@ func NewUpdateForwardingParameters(ies ...*IE) *IE {
 func (i *IE) UpdateForwardingParameters() ([]*IE, error) {
 	switch i.Type {
 	case UpdateForwardingParameters:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case UpdateFAR:
 		ies, err := i.UpdateFAR()
 		if err != nil {
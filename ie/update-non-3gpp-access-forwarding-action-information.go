@@ -13,7 +13,7 @@ func NewUpdateNonTGPPAccessForwardingActionInformation(ies ...*IE) *IE {
 func (i *IE) UpdateNonTGPPAccessForwardingActionInformation() ([]*IE, error) {
 	switch i.Type {
 	case UpdateNonTGPPAccessForwardingActionInformation:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	case UpdateMAR:
 		ies, err := i.UpdateMAR()
 		if err != nil {
@@ -13,7 +13,7 @@ func NewUpdateSRR(ies ...*IE) *IE {
 func (i *IE) UpdateSRR() ([]*IE, error) {
 	switch i.Type {
 	case UpdateSRR:
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	default:
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
@@ -8,6 +8,83 @@ import (
 	"io"
 )
 
+// UsageReportTriggerFlags is a set of the flags in UsageReportTrigger IE.
+type UsageReportTriggerFlags uint32
+
+// UsageReportTrigger flag definitions.
+const (
+	UsageReportTriggerPERIO UsageReportTriggerFlags = 1 << iota
+	UsageReportTriggerVOLTH
+	UsageReportTriggerTIMTH
+	UsageReportTriggerQUHTI
+	UsageReportTriggerSTART
+	UsageReportTriggerSTOPT
+	UsageReportTriggerDROTH
+	UsageReportTriggerIMMER
+	UsageReportTriggerVOLQU
+	UsageReportTriggerTIMQU
+	UsageReportTriggerLIUSA
+	UsageReportTriggerTERMR
+	UsageReportTriggerMONIT
+	UsageReportTriggerENVCL
+	UsageReportTriggerMACAR
+	UsageReportTriggerEVETH
+	UsageReportTriggerEVEQU
+	UsageReportTriggerTEBUR
+	UsageReportTriggerIPMJL
+	UsageReportTriggerQUVTI
+	UsageReportTriggerEMRRE
+	UsageReportTriggerUPINT
+)
+
+var usageReportTriggerFlags = &flagSet{
+	names: []string{
+		"PERIO", "VOLTH", "TIMTH", "QUHTI", "START", "STOPT", "DROTH", "IMMER",
+		"VOLQU", "TIMQU", "LIUSA", "TERMR", "MONIT", "ENVCL", "MACAR", "EVETH",
+		"EVEQU", "TEBUR", "IPMJL", "QUVTI", "EMRRE", "UPINT",
+	},
+	minOctets: 3,
+}
+
+// Has reports whether all the given flags are set.
+func (f UsageReportTriggerFlags) Has(flags UsageReportTriggerFlags) bool {
+	return f&flags == flags
+}
+
+// Set sets the given flags.
+func (f *UsageReportTriggerFlags) Set(flags UsageReportTriggerFlags) {
+	*f |= flags
+}
+
+// Clear clears the given flags.
+func (f *UsageReportTriggerFlags) Clear(flags UsageReportTriggerFlags) {
+	*f &^= flags
+}
+
+// String returns the names of the set flags joined with "|", e.g., "PERIO|VOLTH".
+func (f UsageReportTriggerFlags) String() string {
+	return usageReportTriggerFlags.string(uint64(f))
+}
+
+// Octets returns the flags serialized in as many octets as required by the set flags.
+func (f UsageReportTriggerFlags) Octets() []byte {
+	return usageReportTriggerFlags.octets(uint64(f))
+}
+
+// NewUsageReportTriggerFlags creates a new UsageReportTrigger IE from the flag set.
+func NewUsageReportTriggerFlags(flags UsageReportTriggerFlags) *IE {
+	return New(UsageReportTrigger, flags.Octets())
+}
+
+// UsageReportTriggerFlags returns UsageReportTrigger in UsageReportTriggerFlags if the type of IE matches.
+func (i *IE) UsageReportTriggerFlags() (UsageReportTriggerFlags, error) {
+	v, err := i.UsageReportTrigger()
+	if err != nil {
+		return 0, err
+	}
+	return UsageReportTriggerFlags(usageReportTriggerFlags.fromOctets(v)), nil
+}
+
 // NewUsageReportTrigger creates a new UsageReportTrigger IE.
 func NewUsageReportTrigger(triggerOctets ...uint8) *IE {
 	return New(UsageReportTrigger, triggerOctets)
@@ -31,7 +31,7 @@ func (i *IE) UsageReport() ([]*IE, error) {
 		UsageReportWithinSessionDeletionResponse,
 		UsageReportWithinSessionReportRequest:
 
-		return ParseMultiIEs(i.Payload)
+		return i.childIEs()
 	default:
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
@@ -10,7 +10,7 @@ import (
 )
 
 // NewUserPlaneIPResourceInformation creates a new UserPlaneIPResourceInformation IE.
-func NewUserPlaneIPResourceInformation(flags uint8, tRange uint8, v4, v6, ni string, si uint8) *IE {
+func NewUserPlaneIPResourceInformation(flags uint8, tRange uint8, v4, v6, ni string, si SourceInterfaceValue) *IE {
 	fields := NewUserPlaneIPResourceInformationFields(flags, tRange, v4, v6, ni, si)
 	b, err := fields.Marshal()
 	if err != nil {
@@ -74,11 +74,11 @@ type UserPlaneIPResourceInformationFields struct {
 	IPv4Address     net.IP
 	IPv6Address     net.IP
 	NetworkInstance string
-	SourceInterface uint8
+	SourceInterface SourceInterfaceValue
 }
 
 // NewUserPlaneIPResourceInformationFields creates a new UserPlaneIPResourceInformationFields.
-func NewUserPlaneIPResourceInformationFields(flags uint8, tRange uint8, v4, v6, ni string, si uint8) *UserPlaneIPResourceInformationFields {
+func NewUserPlaneIPResourceInformationFields(flags uint8, tRange uint8, v4, v6, ni string, si SourceInterfaceValue) *UserPlaneIPResourceInformationFields {
 	f := &UserPlaneIPResourceInformationFields{Flags: flags}
 
 	if (flags>>2)&0x07 != 0 {
@@ -150,7 +150,7 @@ func (f *UserPlaneIPResourceInformationFields) UnmarshalBinary(b []byte) error {
 	if has6thBit(f.Flags) {
 		n := l
 		if has7thBit(f.Flags) {
-			f.SourceInterface = b[n] & 0x0f
+			f.SourceInterface = SourceInterfaceValue(b[n] & 0x0f)
 			n--
 		}
 
@@ -162,7 +162,7 @@ func (f *UserPlaneIPResourceInformationFields) UnmarshalBinary(b []byte) error {
 	}
 
 	if has7thBit(f.Flags) {
-		f.SourceInterface = b[offset] & 0x0f
+		f.SourceInterface = SourceInterfaceValue(b[offset] & 0x0f)
 	}
 
 	return nil
@@ -208,7 +208,7 @@ func (f *UserPlaneIPResourceInformationFields) MarshalTo(b []byte) error {
 	}
 
 	if has7thBit(f.Flags) {
-		b[offset] = f.SourceInterface
+		b[offset] = uint8(f.SourceInterface)
 	}
 
 	return nil
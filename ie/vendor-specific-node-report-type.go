@@ -0,0 +1,95 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewVendorSpecificNodeReportType creates a new VendorSpecificNodeReportType IE.
+func NewVendorSpecificNodeReportType(eid uint16, flags uint8) *IE {
+	fields := NewVendorSpecificNodeReportTypeFields(eid, flags)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(VendorSpecificNodeReportType, b)
+}
+
+// VendorSpecificNodeReportType returns VendorSpecificNodeReportType in structured format if the type of IE matches.
+func (i *IE) VendorSpecificNodeReportType() (*VendorSpecificNodeReportTypeFields, error) {
+	if i.Type != VendorSpecificNodeReportType {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	fields, err := ParseVendorSpecificNodeReportTypeFields(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// VendorSpecificNodeReportTypeFields represents a fields contained in VendorSpecificNodeReportType IE.
+type VendorSpecificNodeReportTypeFields struct {
+	EnterpriseID uint16
+	Flags        uint8 // defined by the vendor
+}
+
+// NewVendorSpecificNodeReportTypeFields creates a new VendorSpecificNodeReportTypeFields.
+func NewVendorSpecificNodeReportTypeFields(eid uint16, flags uint8) *VendorSpecificNodeReportTypeFields {
+	return &VendorSpecificNodeReportTypeFields{
+		EnterpriseID: eid,
+		Flags:        flags,
+	}
+}
+
+// ParseVendorSpecificNodeReportTypeFields parses b into VendorSpecificNodeReportTypeFields.
+func ParseVendorSpecificNodeReportTypeFields(b []byte) (*VendorSpecificNodeReportTypeFields, error) {
+	f := &VendorSpecificNodeReportTypeFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *VendorSpecificNodeReportTypeFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 3 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.EnterpriseID = binary.BigEndian.Uint16(b[0:2])
+	f.Flags = b[2]
+	return nil
+}
+
+// Marshal returns the serialized bytes of VendorSpecificNodeReportTypeFields.
+func (f *VendorSpecificNodeReportTypeFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *VendorSpecificNodeReportTypeFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	binary.BigEndian.PutUint16(b[0:2], f.EnterpriseID)
+	b[2] = f.Flags
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *VendorSpecificNodeReportTypeFields) MarshalLen() int {
+	return 3
+}
@@ -0,0 +1,115 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "sync"
+
+// VendorIEKey is the key to identify a vendor-specific IE.
+//
+// The same Type can be used by different vendors, so the EnterpriseID is needed
+// to determine the definition of the IE. Type should have the bit 8 of octet 1 set.
+type VendorIEKey struct {
+	EnterpriseID uint16
+	Type         uint16
+}
+
+// VendorIEDefinition is a definition of a vendor-specific IE registered with
+// RegisterVendorIE.
+type VendorIEDefinition struct {
+	// Name is the human-readable name of the IE.
+	Name string
+
+	// Grouped reports whether the IE is grouped type. If true, the Payload is
+	// decoded into ChildIEs when parsing the IE and ChildIEs are used when
+	// serializing, in the same way as the grouped IEs defined by 3GPP.
+	Grouped bool
+
+	// Decode decodes the IE into the vendor-defined value. This can be nil if the
+	// vendor has nothing to be decoded other than the Payload or ChildIEs.
+	Decode func(i *IE) (interface{}, error)
+}
+
+var (
+	vendorMu    sync.RWMutex
+	vendorIEMap = map[VendorIEKey]*VendorIEDefinition{}
+)
+
+// RegisterVendorIE registers the definition of a vendor-specific IE identified by
+// the given EnterpriseID and Type.
+//
+// Registering the same pair twice overwrites the previous definition. Passing nil
+// as def removes the registration.
+//
+// The registration takes precedence over AddGroupedIEType and SetIsGroupedFun
+// when determining if the vendor-specific IE is grouped or not.
+func RegisterVendorIE(eid, itype uint16, def *VendorIEDefinition) {
+	vendorMu.Lock()
+	defer vendorMu.Unlock()
+
+	key := VendorIEKey{EnterpriseID: eid, Type: itype}
+	if def == nil {
+		delete(vendorIEMap, key)
+		return
+	}
+	vendorIEMap[key] = def
+}
+
+// LookupVendorIE returns the definition of a vendor-specific IE identified by
+// the given EnterpriseID and Type registered with RegisterVendorIE.
+func LookupVendorIE(eid, itype uint16) (*VendorIEDefinition, bool) {
+	vendorMu.RLock()
+	defer vendorMu.RUnlock()
+
+	def, ok := vendorIEMap[VendorIEKey{EnterpriseID: eid, Type: itype}]
+	return def, ok
+}
+
+// vendorDefinition returns the registered definition of the IE if it is vendor-specific.
+func (i *IE) vendorDefinition() (*VendorIEDefinition, bool) {
+	if !i.IsVendorSpecific() {
+		return nil, false
+	}
+	return LookupVendorIE(i.EnterpriseID, i.Type)
+}
+
+// VendorIEName returns the name of vendor-specific IE registered with RegisterVendorIE.
+//
+// If the IE is not vendor-specific or not registered, this returns empty string.
+func (i *IE) VendorIEName() string {
+	def, ok := i.vendorDefinition()
+	if !ok {
+		return ""
+	}
+	return def.Name
+}
+
+// Name returns the name of the IE, which is the one registered with RegisterVendorIE
+// for vendor-specific IE and TypeName of the type for the others.
+func (i *IE) Name() string {
+	if n := i.VendorIEName(); n != "" {
+		return n
+	}
+	return TypeName(i.Type)
+}
+
+// VendorIE returns the value of vendor-specific IE decoded by the Decode function
+// registered with RegisterVendorIE.
+//
+// If the IE is registered without Decode function, this returns the ChildIEs for
+// grouped IE and the Payload for others.
+func (i *IE) VendorIE() (interface{}, error) {
+	def, ok := i.vendorDefinition()
+	if !ok {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	if def.Decode != nil {
+		return def.Decode(i)
+	}
+	if def.Grouped {
+		return i.ValueAsGrouped()
+	}
+	return i.Payload, nil
+}
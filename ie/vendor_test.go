@@ -0,0 +1,131 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestVendorIERegistry(t *testing.T) {
+	const (
+		vendorType uint16 = 0x8001
+		vendorA    uint16 = 10415
+		vendorB    uint16 = 0x1234
+	)
+
+	type counter struct {
+		Value uint32
+	}
+
+	ie.RegisterVendorIE(vendorA, vendorType, &ie.VendorIEDefinition{
+		Name:    "Vendor A Container",
+		Grouped: true,
+	})
+	ie.RegisterVendorIE(vendorB, vendorType, &ie.VendorIEDefinition{
+		Name: "Vendor B Counter",
+		Decode: func(i *ie.IE) (interface{}, error) {
+			v, err := i.ValueAsUint32()
+			if err != nil {
+				return nil, err
+			}
+			return &counter{Value: v}, nil
+		},
+	})
+	defer func() {
+		ie.RegisterVendorIE(vendorA, vendorType, nil)
+		ie.RegisterVendorIE(vendorB, vendorType, nil)
+	}()
+
+	t.Run("Grouped", func(t *testing.T) {
+		structured := ie.NewVendorSpecificGroupedIE(vendorType, vendorA,
+			ie.NewPDRID(1),
+			ie.NewFARID(2),
+		)
+		serialized := []byte{
+			0x80, 0x01, 0x00, 0x10, 0x28, 0xaf,
+			0x00, 0x38, 0x00, 0x02, 0x00, 0x01,
+			0x00, 0x6c, 0x00, 0x04, 0x00, 0x00, 0x00, 0x02,
+		}
+
+		b, err := structured.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(b, serialized); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err := ie.Parse(serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, structured); diff != "" {
+			t.Error(diff)
+		}
+		if got, want := got.VendorIEName(), "Vendor A Container"; got != want {
+			t.Errorf("got %v want %v", got, want)
+		}
+
+		v, err := got.VendorIE()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(v, interface{}(structured.ChildIEs)); diff != "" {
+			t.Error(diff)
+		}
+
+		matches, err := ie.Find([]*ie.IE{got}, "*/FARID")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(matches) != 1 || matches[0].Path != "Vendor A Container/FARID" {
+			t.Errorf("got %v want one match at Vendor A Container/FARID", matches)
+		}
+	})
+
+	t.Run("SameTypeOtherVendor", func(t *testing.T) {
+		payload := make([]byte, 4)
+		binary.BigEndian.PutUint32(payload, 0xdeadbeef)
+
+		got, err := ie.Parse(append([]byte{0x80, 0x01, 0x00, 0x06, 0x12, 0x34}, payload...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.IsGrouped() {
+			t.Fatal("IE of vendor B should not be grouped")
+		}
+		if got, want := got.VendorIEName(), "Vendor B Counter"; got != want {
+			t.Errorf("got %v want %v", got, want)
+		}
+
+		v, err := got.VendorIE()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(v, interface{}(&counter{Value: 0xdeadbeef})); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("Unregistered", func(t *testing.T) {
+		i := ie.NewVendorSpecificIE(vendorType, 0x0001, []byte{0x01})
+		if i.IsGrouped() {
+			t.Fatal("unregistered IE should not be grouped")
+		}
+		if got := i.VendorIEName(); got != "" {
+			t.Errorf("got %v want empty", got)
+		}
+		if got, want := i.Name(), "32769"; got != want {
+			t.Errorf("got %v want %v", got, want)
+		}
+		if _, err := i.VendorIE(); err == nil {
+			t.Error("expected error for unregistered IE")
+		}
+	})
+}
@@ -0,0 +1,220 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// View is a read-only view of an IE over the serialized bytes.
+//
+// Unlike *IE returned by Parse, View does not allocate anything nor decode the
+// child IEs of grouped IE in advance. The child IEs are decoded only when they are
+// accessed with Children or Find. This is useful when only a few IEs in a large
+// message are needed, or when the message needs to be inspected without allocations.
+//
+// View refers to the given bytes directly, so the bytes must not be modified while
+// the View is in use.
+type View struct {
+	b []byte // whole IE including Type and Length
+}
+
+// ParseView returns the View of the first IE in b.
+//
+// The rest of the bytes after the IE is ignored; use Iterate to go through all IEs.
+func ParseView(b []byte) (View, error) {
+	n, err := viewLen(b)
+	if err != nil {
+		return View{}, err
+	}
+	return View{b: b[:n]}, nil
+}
+
+// viewLen returns the length of the IE at the top of b, validating the length.
+func viewLen(b []byte) (int, error) {
+	l := len(b)
+	if l < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	n := 4 + int(binary.BigEndian.Uint16(b[2:4]))
+	if b[0]&0x80 != 0 {
+		if l < 6 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		if n < 6 {
+			return 0, ErrInvalidLength
+		}
+	}
+	if l < n {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return n, nil
+}
+
+// Type returns the IE type.
+func (v View) Type() uint16 {
+	return binary.BigEndian.Uint16(v.b[0:2])
+}
+
+// Length returns the value in Length field.
+func (v View) Length() uint16 {
+	return binary.BigEndian.Uint16(v.b[2:4])
+}
+
+// EnterpriseID returns the Enterprise ID if the IE is vendor-specific. Otherwise it returns 0.
+func (v View) EnterpriseID() uint16 {
+	if !v.IsVendorSpecific() {
+		return 0
+	}
+	return binary.BigEndian.Uint16(v.b[4:6])
+}
+
+// IsVendorSpecific reports whether an IE is vendor-specific or defined by 3gpp.
+func (v View) IsVendorSpecific() bool {
+	return v.b[0]&0x80 != 0
+}
+
+// IsGrouped reports whether an IE is grouped type or not, in the same way as (*IE).IsGrouped.
+func (v View) IsGrouped() bool {
+	return isGrouped(v.Type(), v.EnterpriseID())
+}
+
+// Payload returns the value part of the IE, excluding Type, Length and Enterprise ID.
+func (v View) Payload() []byte {
+	if v.IsVendorSpecific() {
+		return v.b[6:]
+	}
+	return v.b[4:]
+}
+
+// Bytes returns the whole serialized IE.
+func (v View) Bytes() []byte {
+	return v.b
+}
+
+// MarshalLen returns the serial length of the IE.
+func (v View) MarshalLen() int {
+	return len(v.b)
+}
+
+// Children returns the Iterator over the child IEs.
+//
+// For non-grouped IEs, the Iterator stops immediately with ErrInvalidType.
+func (v View) Children() Iterator {
+	if !v.IsGrouped() {
+		return Iterator{err: &InvalidTypeError{Type: v.Type()}}
+	}
+	return Iterate(v.Payload())
+}
+
+// Find returns the first child IE of the given type.
+func (v View) Find(typ uint16) (View, error) {
+	it := v.Children()
+	for it.Next() {
+		if c := it.View(); c.Type() == typ {
+			return c, nil
+		}
+	}
+	if err := it.Err(); err != nil {
+		return View{}, err
+	}
+	return View{}, ErrIENotFound
+}
+
+// ValueAsUint8 returns the value of IE as uint8.
+func (v View) ValueAsUint8() (uint8, error) {
+	p := v.Payload()
+	if len(p) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return p[0], nil
+}
+
+// ValueAsUint16 returns the value of IE as uint16.
+func (v View) ValueAsUint16() (uint16, error) {
+	p := v.Payload()
+	if len(p) < 2 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return binary.BigEndian.Uint16(p[0:2]), nil
+}
+
+// ValueAsUint32 returns the value of IE as uint32.
+func (v View) ValueAsUint32() (uint32, error) {
+	p := v.Payload()
+	if len(p) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return binary.BigEndian.Uint32(p[0:4]), nil
+}
+
+// ValueAsUint64 returns the value of IE as uint64.
+func (v View) ValueAsUint64() (uint64, error) {
+	p := v.Payload()
+	if len(p) < 8 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return binary.BigEndian.Uint64(p[0:8]), nil
+}
+
+// IE decodes the View into *IE, so that the helper methods on *IE can be used.
+//
+// This allocates in the same way as Parse does, and the returned *IE still refers
+// to the same bytes as the View.
+func (v View) IE() (*IE, error) {
+	return Parse(v.b)
+}
+
+// Iterator iterates over the IEs in the serialized bytes without allocations.
+//
+//	it := ie.Iterate(b)
+//	for it.Next() {
+//		v := it.View()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type Iterator struct {
+	b   []byte
+	cur View
+	err error
+}
+
+// Iterate returns the Iterator over the IEs in b.
+func Iterate(b []byte) Iterator {
+	return Iterator{b: b}
+}
+
+// Next advances the Iterator to the next IE, which will then be available
+// through the View method. It returns false when there is no more IE or an
+// error occurs.
+func (it *Iterator) Next() bool {
+	if it.err != nil || len(it.b) == 0 {
+		return false
+	}
+
+	n, err := viewLen(it.b)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.cur = View{b: it.b[:n]}
+	it.b = it.b[n:]
+	return true
+}
+
+// View returns the current IE.
+func (it *Iterator) View() View {
+	return it.cur
+}
+
+// Err returns the error occurred during the iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,185 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newViewTestPDR(id uint16) *ie.IE {
+	return ie.NewCreatePDR(
+		ie.NewPDRID(id),
+		ie.NewPrecedence(uint32(id)),
+		ie.NewPDI(
+			ie.NewSourceInterface(ie.SrcInterfaceAccess),
+			ie.NewFTEID(0x01, uint32(id), net.ParseIP("127.0.0.1"), nil, 0),
+			ie.NewNetworkInstance("some.instance.example"),
+		),
+		ie.NewFARID(uint32(id)),
+		ie.NewURRID(uint32(id)),
+		ie.NewQERID(uint32(id)),
+	)
+}
+
+func newViewTestPayload(tb testing.TB, n int) []byte {
+	tb.Helper()
+
+	var b []byte
+	for i := 1; i <= n; i++ {
+		s, err := newViewTestPDR(uint16(i)).Marshal()
+		if err != nil {
+			tb.Fatal(err)
+		}
+		b = append(b, s...)
+	}
+	return b
+}
+
+func TestView(t *testing.T) {
+	b := newViewTestPayload(t, 3)
+
+	t.Run("Iterate", func(t *testing.T) {
+		var ids []uint16
+		it := ie.Iterate(b)
+		for it.Next() {
+			v := it.View()
+			if got, want := v.Type(), uint16(ie.CreatePDR); got != want {
+				t.Fatalf("got %v want %v", got, want)
+			}
+			pdrID, err := v.Find(ie.PDRID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			id, err := pdrID.ValueAsUint16()
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, id)
+		}
+		if err := it.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(ids, []uint16{1, 2, 3}); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("Nested", func(t *testing.T) {
+		v, err := ie.ParseView(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pdi, err := v.Find(ie.PDI)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fteid, err := pdi.Find(ie.FTEID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		i, err := fteid.IE()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(i, ie.NewFTEID(0x01, 1, net.ParseIP("127.0.0.1"), nil, 0)); diff != "" {
+			t.Error(diff)
+		}
+
+		if _, err := pdi.Find(ie.UEIPAddress); !errors.Is(err, ie.ErrIENotFound) {
+			t.Errorf("got %v want %v", err, ie.ErrIENotFound)
+		}
+		if _, err := fteid.Find(ie.PDRID); err == nil {
+			t.Error("expected error for non-grouped IE")
+		}
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		if _, err := ie.ParseView(b[:3]); !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("got %v want %v", err, io.ErrUnexpectedEOF)
+		}
+
+		n := 0
+		it := ie.Iterate(b[:len(b)-1])
+		for it.Next() {
+			n++
+		}
+		if got, want := n, 2; got != want {
+			t.Errorf("got %v want %v", got, want)
+		}
+		if err := it.Err(); !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("got %v want %v", err, io.ErrUnexpectedEOF)
+		}
+	})
+
+	t.Run("NoAllocs", func(t *testing.T) {
+		allocs := testing.AllocsPerRun(100, func() {
+			walkViews(b)
+		})
+		if allocs != 0 {
+			t.Errorf("got %v allocs want 0", allocs)
+		}
+	})
+}
+
+// walkViews reads FTEID TEID of all CreatePDRs in b.
+func walkViews(b []byte) uint32 {
+	var sum uint32
+	it := ie.Iterate(b)
+	for it.Next() {
+		pdi, err := it.View().Find(ie.PDI)
+		if err != nil {
+			continue
+		}
+		fteid, err := pdi.Find(ie.FTEID)
+		if err != nil {
+			continue
+		}
+		p := fteid.Payload()
+		sum += uint32(p[1])<<24 | uint32(p[2])<<16 | uint32(p[3])<<8 | uint32(p[4])
+	}
+	return sum
+}
+
+func BenchmarkParseMultiIEs(b *testing.B) {
+	payload := newViewTestPayload(b, 500)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(payload)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ies, err := ie.ParseMultiIEs(payload)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for _, pdr := range ies {
+			for _, x := range pdr.ChildIEs {
+				if x.Type != ie.PDI {
+					continue
+				}
+				if _, err := x.FTEID(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkIterate(b *testing.B) {
+	payload := newViewTestPayload(b, 500)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(payload)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		walkViews(payload)
+	}
+}
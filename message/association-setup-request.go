@@ -84,105 +84,123 @@ func (m *AssociationSetupRequest) Marshal() ([]byte, error) {
 	return b, nil
 }
 
+// AppendBinary appends the byte sequence generated from a AssociationSetupRequest to b
+// and returns the extended buffer.
+func (m *AssociationSetupRequest) AppendBinary(b []byte) ([]byte, error) {
+	return appendBinary(b, m)
+}
+
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *AssociationSetupRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	offset, err := m.Header.marshalFixedTo(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
-	offset := 0
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.RecoveryTimeStamp; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.UPFunctionFeatures; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.CPFunctionFeatures; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	for _, i := range m.UserPlaneIPResourceInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	for _, i := range m.AlternativeSMFIPAddress {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.SMFSetID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.PFCPSessionRetentionInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	for _, i := range m.UEIPAddressPoolInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	for _, i := range m.GTPUPathQoSControlInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	for _, i := range m.ClockDriftControlInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.UPFInstanceID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.PFCPASReqFlags; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 
 	for _, ie := range m.IEs {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		n, err := ie.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += ie.MarshalLen()
+		offset += n
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return nil
 }
 
 // ParseAssociationSetupRequest decodes a given byte sequence as a AssociationSetupRequest.
@@ -207,7 +225,7 @@ func (m *AssociationSetupRequest) UnmarshalBinary(b []byte) error {
 
 	ies, err := ie.ParseMultiIEs(m.Header.Payload)
 	if err != nil {
-		return err
+		return newDecodeError(m.Header, err)
 	}
 
 	for _, i := range ies {
@@ -314,3 +332,31 @@ func (m *AssociationSetupRequest) MessageTypeName() string {
 func (m *AssociationSetupRequest) SEID() uint64 {
 	return m.Header.seid()
 }
+
+// Clone returns a deep copy of the AssociationSetupRequest.
+//
+// The returned message does not share any memory with the original, so it is
+// safe to use even after the buffer given to Parse is reused.
+func (m *AssociationSetupRequest) Clone() *AssociationSetupRequest {
+	if m == nil {
+		return nil
+	}
+
+	return &AssociationSetupRequest{
+		Header:                          m.Header.Clone(),
+		NodeID:                          m.NodeID.Clone(),
+		RecoveryTimeStamp:               m.RecoveryTimeStamp.Clone(),
+		UPFunctionFeatures:              m.UPFunctionFeatures.Clone(),
+		CPFunctionFeatures:              m.CPFunctionFeatures.Clone(),
+		UserPlaneIPResourceInformation:  cloneIEs(m.UserPlaneIPResourceInformation),
+		AlternativeSMFIPAddress:         cloneIEs(m.AlternativeSMFIPAddress),
+		SMFSetID:                        m.SMFSetID.Clone(),
+		PFCPSessionRetentionInformation: m.PFCPSessionRetentionInformation.Clone(),
+		UEIPAddressPoolInformation:      cloneIEs(m.UEIPAddressPoolInformation),
+		GTPUPathQoSControlInformation:   cloneIEs(m.GTPUPathQoSControlInformation),
+		ClockDriftControlInformation:    cloneIEs(m.ClockDriftControlInformation),
+		UPFInstanceID:                   m.UPFInstanceID.Clone(),
+		PFCPASReqFlags:                  m.PFCPASReqFlags.Clone(),
+		IEs:                             cloneIEs(m.IEs),
+	}
+}
@@ -78,93 +78,109 @@ func (m *AssociationUpdateRequest) Marshal() ([]byte, error) {
 	return b, nil
 }
 
+// AppendBinary appends the byte sequence generated from a AssociationUpdateRequest to b
+// and returns the extended buffer.
+func (m *AssociationUpdateRequest) AppendBinary(b []byte) ([]byte, error) {
+	return appendBinary(b, m)
+}
+
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *AssociationUpdateRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	offset, err := m.Header.marshalFixedTo(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
-	offset := 0
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.UPFunctionFeatures; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.CPFunctionFeatures; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.PFCPAssociationReleaseRequest; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.GracefulReleasePeriod; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.PFCPAUReqFlags; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	for _, i := range m.AlternativeSMFIPAddress {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	for _, i := range m.ClockDriftControlInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	for _, i := range m.UEIPAddressPoolInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	for _, i := range m.GTPUPathQoSControlInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	for _, i := range m.UEIPAddressUsageInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 
 	for _, ie := range m.IEs {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		n, err := ie.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += ie.MarshalLen()
+		offset += n
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return nil
 }
 
 // ParseAssociationUpdateRequest decodes a given byte sequence as a AssociationUpdateRequest.
@@ -189,7 +205,7 @@ func (m *AssociationUpdateRequest) UnmarshalBinary(b []byte) error {
 
 	ies, err := ie.ParseMultiIEs(m.Header.Payload)
 	if err != nil {
-		return err
+		return newDecodeError(m.Header, err)
 	}
 
 	for _, i := range ies {
@@ -286,3 +302,29 @@ func (m *AssociationUpdateRequest) MessageTypeName() string {
 func (m *AssociationUpdateRequest) SEID() uint64 {
 	return m.Header.seid()
 }
+
+// Clone returns a deep copy of the AssociationUpdateRequest.
+//
+// The returned message does not share any memory with the original, so it is
+// safe to use even after the buffer given to Parse is reused.
+func (m *AssociationUpdateRequest) Clone() *AssociationUpdateRequest {
+	if m == nil {
+		return nil
+	}
+
+	return &AssociationUpdateRequest{
+		Header:                        m.Header.Clone(),
+		NodeID:                        m.NodeID.Clone(),
+		UPFunctionFeatures:            m.UPFunctionFeatures.Clone(),
+		CPFunctionFeatures:            m.CPFunctionFeatures.Clone(),
+		PFCPAssociationReleaseRequest: m.PFCPAssociationReleaseRequest.Clone(),
+		GracefulReleasePeriod:         m.GracefulReleasePeriod.Clone(),
+		PFCPAUReqFlags:                m.PFCPAUReqFlags.Clone(),
+		AlternativeSMFIPAddress:       cloneIEs(m.AlternativeSMFIPAddress),
+		ClockDriftControlInformation:  cloneIEs(m.ClockDriftControlInformation),
+		UEIPAddressPoolInformation:    cloneIEs(m.UEIPAddressPoolInformation),
+		GTPUPathQoSControlInformation: cloneIEs(m.GTPUPathQoSControlInformation),
+		UEIPAddressUsageInformation:   cloneIEs(m.UEIPAddressUsageInformation),
+		IEs:                           cloneIEs(m.IEs),
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import "sync"
+
+const (
+	// defaultBufferSize is large enough for most of the messages not to grow the buffer.
+	defaultBufferSize = 1500
+
+	// maxPooledBufferSize is the capacity of buffers that PutBuffer keeps in the pool.
+	// The larger ones are left to GC not to keep a few huge buffers forever.
+	maxPooledBufferSize = 0x10000
+)
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, 0, defaultBufferSize)
+		return &b
+	},
+}
+
+// GetBuffer returns an empty buffer from the pool shared in this package.
+//
+// This is meant to be used with AppendBinary so that marshaling messages in the hot
+// path does not allocate anything. The buffer should be returned with PutBuffer when
+// it is no longer used.
+//
+//	buf := message.GetBuffer()
+//	defer message.PutBuffer(buf)
+//
+//	var err error
+//	*buf, err = msg.AppendBinary(*buf)
+//	if err != nil {
+//		// handle error
+//	}
+//	conn.Write(*buf)
+func GetBuffer() *[]byte {
+	return bufferPool.Get().(*[]byte)
+}
+
+// PutBuffer returns the buffer retrieved by GetBuffer to the pool.
+//
+// The buffer must not be used after calling this.
+func PutBuffer(b *[]byte) {
+	if b == nil || cap(*b) > maxPooledBufferSize {
+		return
+	}
+	*b = (*b)[:0]
+	bufferPool.Put(b)
+}
+
+// marshaler is the common interface of Header and the messages in this package.
+type marshaler interface {
+	MarshalTo([]byte) error
+	MarshalLen() int
+}
+
+// appendBinary appends the byte sequence generated from m to b, growing b only when
+// the capacity is not enough.
+func appendBinary(b []byte, m marshaler) ([]byte, error) {
+	n := len(b)
+	l := n + m.MarshalLen()
+	if cap(b) < l {
+		nb := make([]byte, n, l)
+		copy(nb, b)
+		b = nb
+	}
+
+	b = b[:l]
+	if err := m.MarshalTo(b[n:]); err != nil {
+		return b[:n], err
+	}
+	return b, nil
+}
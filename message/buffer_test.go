@@ -0,0 +1,162 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newBenchSessionEstablishmentRequest(pdrs int) *message.SessionEstablishmentRequest {
+	ies := []*ie.IE{
+		ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+		ie.NewFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil),
+	}
+	for i := 1; i <= pdrs; i++ {
+		ies = append(ies,
+			ie.NewCreatePDR(
+				ie.NewPDRID(uint16(i)),
+				ie.NewPrecedence(uint32(i)),
+				ie.NewPDI(
+					ie.NewSourceInterface(ie.SrcInterfaceAccess),
+					ie.NewFTEID(0x01, uint32(i), net.ParseIP("127.0.0.1"), nil, 0),
+					ie.NewNetworkInstance("some.instance.example"),
+				),
+				ie.NewFARID(uint32(i)),
+			),
+			ie.NewCreateFAR(
+				ie.NewFARID(uint32(i)),
+				ie.NewApplyAction(0x02),
+			),
+		)
+	}
+	return message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri, ies...)
+}
+
+func TestAppendBinary(t *testing.T) {
+	m := newBenchSessionEstablishmentRequest(3)
+	want, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Append", func(t *testing.T) {
+		prefix := []byte{0xde, 0xad}
+		got, err := m.AppendBinary(prefix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, append([]byte{0xde, 0xad}, want...)); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("Header", func(t *testing.T) {
+		got, err := m.Header.AppendBinary(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want[:16]); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("HeaderPayload", func(t *testing.T) {
+		// the messages are marshaled without building Header.Payload, which is
+		// set only by parsing.
+		m := newBenchSessionEstablishmentRequest(3)
+		if _, err := m.Marshal(); err != nil {
+			t.Fatal(err)
+		}
+		if m.Header.Payload != nil {
+			t.Errorf("got %x want nil", m.Header.Payload)
+		}
+
+		parsed, err := message.ParseSessionEstablishmentRequest(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(parsed.Header.Payload, want[16:]); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("ShortBuffer", func(t *testing.T) {
+		b := make([]byte, len(want)-1)
+		if err := m.MarshalTo(b); !errors.Is(err, io.ErrShortBuffer) {
+			t.Errorf("got %v want %v", err, io.ErrShortBuffer)
+		}
+	})
+
+	t.Run("Pooled", func(t *testing.T) {
+		buf := message.GetBuffer()
+		defer message.PutBuffer(buf)
+
+		var err error
+		*buf, err = m.AppendBinary(*buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(*buf, want); diff != "" {
+			t.Error(diff)
+		}
+
+		parsed, err := message.ParseSessionEstablishmentRequest(*buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(parsed.CreatePDR), 3; got != want {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("NoAllocs", func(t *testing.T) {
+		b := make([]byte, 0, len(want))
+		allocs := testing.AllocsPerRun(100, func() {
+			if _, err := m.AppendBinary(b[:0]); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("got %v allocs want 0", allocs)
+		}
+	})
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	m := newBenchSessionEstablishmentRequest(100)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(m.MarshalLen()))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.Marshal(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAppendBinaryPooled(b *testing.B) {
+	m := newBenchSessionEstablishmentRequest(100)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(m.MarshalLen()))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf := message.GetBuffer()
+		var err error
+		*buf, err = m.AppendBinary(*buf)
+		if err != nil {
+			b.Fatal(err)
+		}
+		message.PutBuffer(buf)
+	}
+}
@@ -0,0 +1,609 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+// BuildError is an error found when building a message with the builders.
+type BuildError struct {
+	// Path is the location of the error, e.g., "CreatePDR[PDRID=1]/PDI".
+	Path   string
+	Reason string
+}
+
+// Error returns message with the path and the reason.
+func (e *BuildError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("failed to build message: %s", e.Reason)
+	}
+	return fmt.Sprintf("failed to build message: %s: %s", e.Path, e.Reason)
+}
+
+func typeSet(ts ...uint16) map[uint16]bool {
+	m := make(map[uint16]bool, len(ts))
+	for _, t := range ts {
+		m[t] = true
+	}
+	return m
+}
+
+// The IEs allowed in each grouped IE, defined in TS 29.244 clause 7.5.2.
+var (
+	allowedInCreatePDR = typeSet(
+		ie.PDRID, ie.Precedence, ie.PDI, ie.OuterHeaderRemoval, ie.FARID, ie.URRID, ie.QERID,
+		ie.ActivatePredefinedRules, ie.ActivationTime, ie.DeactivationTime, ie.MARID,
+		ie.PacketReplicationAndDetectionCarryOnInformation, ie.IPMulticastAddressingInfo,
+		ie.UEIPAddressPoolIdentity, ie.MPTCPApplicableIndication, ie.TransportDelayReporting,
+	)
+	allowedInPDI = typeSet(
+		ie.SourceInterface, ie.FTEID, ie.LocalIngressTunnel, ie.NetworkInstance,
+		ie.RedundantTransmissionParameters, ie.UEIPAddress, ie.TrafficEndpointID, ie.SDFFilter,
+		ie.ApplicationID, ie.EthernetPDUSessionInformation, ie.EthernetPacketFilter, ie.QFI,
+		ie.FramedRoute, ie.FramedRouting, ie.FramedIPv6Route, ie.TGPPInterfaceType,
+		ie.IPMulticastAddressingInfo, ie.DNSQueryResponseFilter, ie.MBSSessionIdentifier, ie.AreaSessionID,
+	)
+	allowedInCreateFAR = typeSet(
+		ie.FARID, ie.ApplyAction, ie.ForwardingParameters, ie.DuplicatingParameters, ie.BARID,
+		ie.RedundantTransmissionParameters, ie.MBSMulticastParameters, ie.AddMBSUnicastParameters,
+	)
+	allowedInForwardingParameters = typeSet(
+		ie.DestinationInterface, ie.NetworkInstance, ie.RedirectInformation, ie.OuterHeaderCreation,
+		ie.TransportLevelMarking, ie.ForwardingPolicy, ie.HeaderEnrichment, ie.TrafficEndpointID,
+		ie.Proxying, ie.TGPPInterfaceType, ie.DataNetworkAccessIdentifier, ie.IPAddressAndPortNumberReplacement,
+	)
+	allowedInCreateURR = typeSet(
+		ie.URRID, ie.MeasurementMethod, ie.ReportingTriggers, ie.MeasurementPeriod, ie.VolumeThreshold,
+		ie.VolumeQuota, ie.EventThreshold, ie.EventQuota, ie.TimeThreshold, ie.TimeQuota,
+		ie.QuotaHoldingTime, ie.DroppedDLTrafficThreshold, ie.QuotaValidityTime, ie.MonitoringTime,
+		ie.SubsequentVolumeThreshold, ie.SubsequentTimeThreshold, ie.SubsequentVolumeQuota,
+		ie.SubsequentTimeQuota, ie.SubsequentEventThreshold, ie.SubsequentEventQuota,
+		ie.InactivityDetectionTime, ie.LinkedURRID, ie.MeasurementInformation, ie.TimeQuotaMechanism,
+		ie.AggregatedURRs, ie.FARID, ie.EthernetInactivityTimer, ie.AdditionalMonitoringTime,
+		ie.NumberOfReports, ie.ApplicationID, ie.SDFFilter, ie.UserPlaneInactivityTimer,
+	)
+	allowedInCreateQER = typeSet(
+		ie.QERID, ie.QERCorrelationID, ie.GateStatus, ie.MBR, ie.GBR, ie.PacketRate,
+		ie.PacketRateStatus, ie.DLFlowLevelMarking, ie.QFI, ie.RQI, ie.PagingPolicyIndicator,
+		ie.AveragingWindow, ie.QERControlIndications,
+	)
+	allowedInCreateBAR = typeSet(
+		ie.BARID, ie.DownlinkDataNotificationDelay, ie.SuggestedBufferingPacketsCount,
+		ie.MTEDTControlInformation,
+	)
+)
+
+// The IEs that can appear more than once in each grouped IE. The others are reported
+// as duplicated when they are given twice. No IE can be repeated in
+// ForwardingParameters, CreateQER and CreateBAR.
+var (
+	repeatableInCreatePDR = typeSet(
+		ie.URRID, ie.QERID, ie.ActivatePredefinedRules, ie.IPMulticastAddressingInfo, ie.UEIPAddressPoolIdentity,
+	)
+	repeatableInPDI = typeSet(
+		ie.UEIPAddress, ie.SDFFilter, ie.EthernetPacketFilter, ie.QFI, ie.FramedRoute,
+		ie.FramedIPv6Route, ie.IPMulticastAddressingInfo, ie.DNSQueryResponseFilter,
+	)
+	repeatableInCreateFAR = typeSet(
+		ie.DuplicatingParameters, ie.AddMBSUnicastParameters,
+	)
+	repeatableInCreateURR = typeSet(
+		ie.LinkedURRID, ie.AggregatedURRs, ie.AdditionalMonitoringTime, ie.ApplicationID, ie.SDFFilter,
+	)
+)
+
+// groupBuilder is the common part of the builders of grouped IEs.
+type groupBuilder struct {
+	allowed    map[uint16]bool
+	repeatable map[uint16]bool
+	ies        []*ie.IE
+	rejected   []uint16
+}
+
+// add appends the IEs if they are allowed, or records them to be reported on validation.
+func (g *groupBuilder) add(ies ...*ie.IE) {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		if g.allowed != nil && !g.allowed[i.Type] {
+			g.rejected = append(g.rejected, i.Type)
+			continue
+		}
+		g.ies = append(g.ies, i)
+	}
+}
+
+// set replaces the IE of the same type if any, or appends it otherwise.
+func (g *groupBuilder) set(i *ie.IE) {
+	for n, x := range g.ies {
+		if x.Type == i.Type {
+			g.ies[n] = i
+			return
+		}
+	}
+	g.add(i)
+}
+
+// validate returns the errors for the rejected IEs, and for the duplicated IEs and the
+// missing mandatory IEs in ies, which are the IEs to be encoded in the grouped IE.
+func (g *groupBuilder) validate(path string, ies []*ie.IE, mandatory ...uint16) []error {
+	var errs []error
+	for _, t := range g.rejected {
+		errs = append(errs, &BuildError{Path: path, Reason: ie.TypeName(t) + " is not allowed"})
+	}
+
+	counts := map[uint16]int{}
+	for _, i := range ies {
+		counts[i.Type]++
+		if counts[i.Type] == 2 && !g.repeatable[i.Type] {
+			errs = append(errs, &BuildError{Path: path, Reason: "duplicated " + ie.TypeName(i.Type)})
+		}
+	}
+	for _, t := range mandatory {
+		if counts[t] == 0 {
+			errs = append(errs, &BuildError{Path: path, Reason: "missing mandatory IE " + ie.TypeName(t)})
+		}
+	}
+	return errs
+}
+
+// validateGrouped validates the child IEs of the grouped IE given by Add in the same
+// way as the ones given to the builder of the grouped IE.
+func validateGrouped(path string, i *ie.IE, allowed, repeatable map[uint16]bool, mandatory ...uint16) []error {
+	children, err := i.ValueAsGrouped()
+	if err != nil {
+		return []error{&BuildError{Path: path, Reason: err.Error()}}
+	}
+
+	g := &groupBuilder{allowed: allowed, repeatable: repeatable}
+	g.add(children...)
+	return g.validate(path, g.ies, mandatory...)
+}
+
+func rulePath(name string, idType uint16, id uint64) string {
+	return name + "[" + ie.TypeName(idType) + "=" + strconv.FormatUint(id, 10) + "]"
+}
+
+// PDRBuilder builds a CreatePDR IE. See SessionEstablishmentBuilder.AddPDR.
+type PDRBuilder struct {
+	groupBuilder
+	id  uint16
+	pdi *PDIBuilder
+}
+
+// ID returns the PDR ID of the PDR.
+func (p *PDRBuilder) ID() uint16 {
+	return p.id
+}
+
+// PDRID overrides the PDR ID assigned automatically.
+func (p *PDRBuilder) PDRID(id uint16) *PDRBuilder {
+	p.id = id
+	p.set(ie.NewPDRID(id))
+	return p
+}
+
+// Precedence sets the Precedence.
+func (p *PDRBuilder) Precedence(prec uint32) *PDRBuilder {
+	p.set(ie.NewPrecedence(prec))
+	return p
+}
+
+// PDI sets the PDI built by fn.
+func (p *PDRBuilder) PDI(fn func(*PDIBuilder)) *PDRBuilder {
+	p.pdi = &PDIBuilder{groupBuilder{allowed: allowedInPDI, repeatable: repeatableInPDI}}
+	if fn != nil {
+		fn(p.pdi)
+	}
+	return p
+}
+
+// OuterHeaderRemoval sets the OuterHeaderRemoval.
+func (p *PDRBuilder) OuterHeaderRemoval(desc ie.OuterHeaderRemovalDescriptionValue, ext uint8) *PDRBuilder {
+	p.set(ie.NewOuterHeaderRemoval(desc, ext))
+	return p
+}
+
+// FARID sets the FAR ID of the FAR to be applied.
+func (p *PDRBuilder) FARID(id uint32) *PDRBuilder {
+	p.set(ie.NewFARID(id))
+	return p
+}
+
+// URRID adds the URR ID of the URR to be applied. This can be called multiple times.
+func (p *PDRBuilder) URRID(id uint32) *PDRBuilder {
+	p.add(ie.NewURRID(id))
+	return p
+}
+
+// QERID adds the QER ID of the QER to be applied. This can be called multiple times.
+func (p *PDRBuilder) QERID(id uint32) *PDRBuilder {
+	p.add(ie.NewQERID(id))
+	return p
+}
+
+// Add adds the IEs that have no dedicated method. The IEs not allowed in CreatePDR
+// are reported as errors by Build. A PDRID given by Add overrides the PDR ID as
+// PDRID does.
+func (p *PDRBuilder) Add(ies ...*ie.IE) *PDRBuilder {
+	for _, i := range ies {
+		if i != nil && i.Type == ie.PDRID {
+			if id, err := i.PDRID(); err == nil {
+				p.PDRID(id)
+				continue
+			}
+		}
+		p.add(i)
+	}
+	return p
+}
+
+func (p *PDRBuilder) build() (*ie.IE, []error) {
+	path := rulePath("CreatePDR", ie.PDRID, uint64(p.id))
+	ies := p.ies
+	var errs []error
+	if p.pdi != nil {
+		ies = append(append([]*ie.IE{}, p.ies...), ie.NewPDI(p.pdi.ies...))
+		errs = p.pdi.validate(path+"/PDI", p.pdi.ies, ie.SourceInterface)
+	}
+
+	// PDI can also be given by Add.
+	for _, i := range p.ies {
+		if i.Type == ie.PDI {
+			errs = append(errs, validateGrouped(path+"/PDI", i, allowedInPDI, repeatableInPDI, ie.SourceInterface)...)
+		}
+	}
+	errs = append(p.validate(path, ies, ie.PDRID, ie.Precedence, ie.PDI), errs...)
+	return ie.NewCreatePDR(ies...), errs
+}
+
+// PDIBuilder builds a PDI IE. See PDRBuilder.PDI.
+type PDIBuilder struct {
+	groupBuilder
+}
+
+// SourceInterface sets the SourceInterface.
+func (p *PDIBuilder) SourceInterface(intf ie.SourceInterfaceValue) *PDIBuilder {
+	p.set(ie.NewSourceInterface(intf))
+	return p
+}
+
+// FTEID sets the local F-TEID.
+func (p *PDIBuilder) FTEID(flags uint8, teid uint32, v4, v6 net.IP, chid uint8) *PDIBuilder {
+	p.set(ie.NewFTEID(flags, teid, v4, v6, chid))
+	return p
+}
+
+// NetworkInstance sets the NetworkInstance.
+func (p *PDIBuilder) NetworkInstance(instance string) *PDIBuilder {
+	p.set(ie.NewNetworkInstance(instance))
+	return p
+}
+
+// UEIPAddress adds the UEIPAddress. This can be called multiple times.
+func (p *PDIBuilder) UEIPAddress(flags uint8, v4, v6 string, v6d, v6pl uint8) *PDIBuilder {
+	p.add(ie.NewUEIPAddress(flags, v4, v6, v6d, v6pl))
+	return p
+}
+
+// SDFFilter adds the SDFFilter. This can be called multiple times.
+func (p *PDIBuilder) SDFFilter(fd, ttc, spi, fl string, fid uint32) *PDIBuilder {
+	p.add(ie.NewSDFFilter(fd, ttc, spi, fl, fid))
+	return p
+}
+
+// ApplicationID sets the ApplicationID.
+func (p *PDIBuilder) ApplicationID(id string) *PDIBuilder {
+	p.set(ie.NewApplicationID(id))
+	return p
+}
+
+// QFI adds the QFI. This can be called multiple times.
+func (p *PDIBuilder) QFI(qfi uint8) *PDIBuilder {
+	p.add(ie.NewQFI(qfi))
+	return p
+}
+
+// Add adds the IEs that have no dedicated method. The IEs not allowed in PDI
+// are reported as errors by Build.
+func (p *PDIBuilder) Add(ies ...*ie.IE) *PDIBuilder {
+	p.add(ies...)
+	return p
+}
+
+// FARBuilder builds a CreateFAR IE. See SessionEstablishmentBuilder.AddFAR.
+type FARBuilder struct {
+	groupBuilder
+	id uint32
+	fp *ForwardingParametersBuilder
+}
+
+// ID returns the FAR ID of the FAR.
+func (f *FARBuilder) ID() uint32 {
+	return f.id
+}
+
+// FARID overrides the FAR ID assigned automatically.
+func (f *FARBuilder) FARID(id uint32) *FARBuilder {
+	f.id = id
+	f.set(ie.NewFARID(id))
+	return f
+}
+
+// ApplyAction sets the ApplyAction.
+func (f *FARBuilder) ApplyAction(flags ie.ApplyActionFlags) *FARBuilder {
+	f.set(ie.NewApplyActionFlags(flags))
+	return f
+}
+
+// ForwardingParameters sets the ForwardingParameters built by fn.
+func (f *FARBuilder) ForwardingParameters(fn func(*ForwardingParametersBuilder)) *FARBuilder {
+	f.fp = &ForwardingParametersBuilder{groupBuilder{allowed: allowedInForwardingParameters}}
+	if fn != nil {
+		fn(f.fp)
+	}
+	return f
+}
+
+// BARID sets the BAR ID of the BAR to be applied.
+func (f *FARBuilder) BARID(id uint8) *FARBuilder {
+	f.set(ie.NewBARID(id))
+	return f
+}
+
+// Add adds the IEs that have no dedicated method. The IEs not allowed in CreateFAR
+// are reported as errors by Build. A FARID given by Add overrides the FAR ID as
+// FARID does.
+func (f *FARBuilder) Add(ies ...*ie.IE) *FARBuilder {
+	for _, i := range ies {
+		if i != nil && i.Type == ie.FARID {
+			if id, err := i.FARID(); err == nil {
+				f.FARID(id)
+				continue
+			}
+		}
+		f.add(i)
+	}
+	return f
+}
+
+func (f *FARBuilder) build() (*ie.IE, []error) {
+	path := rulePath("CreateFAR", ie.FARID, uint64(f.id))
+	ies := f.ies
+	var errs []error
+	if f.fp != nil {
+		ies = append(append([]*ie.IE{}, f.ies...), ie.NewForwardingParameters(f.fp.ies...))
+		errs = f.fp.validate(path+"/ForwardingParameters", f.fp.ies, ie.DestinationInterface)
+	}
+
+	// ForwardingParameters can also be given by Add.
+	for _, i := range f.ies {
+		if i.Type == ie.ForwardingParameters {
+			errs = append(errs, validateGrouped(path+"/ForwardingParameters", i, allowedInForwardingParameters, nil, ie.DestinationInterface)...)
+		}
+	}
+	errs = append(f.validate(path, ies, ie.FARID, ie.ApplyAction), errs...)
+	return ie.NewCreateFAR(ies...), errs
+}
+
+// ForwardingParametersBuilder builds a ForwardingParameters IE. See FARBuilder.ForwardingParameters.
+type ForwardingParametersBuilder struct {
+	groupBuilder
+}
+
+// DestinationInterface sets the DestinationInterface.
+func (p *ForwardingParametersBuilder) DestinationInterface(intf ie.DestinationInterfaceValue) *ForwardingParametersBuilder {
+	p.set(ie.NewDestinationInterface(intf))
+	return p
+}
+
+// NetworkInstance sets the NetworkInstance.
+func (p *ForwardingParametersBuilder) NetworkInstance(instance string) *ForwardingParametersBuilder {
+	p.set(ie.NewNetworkInstance(instance))
+	return p
+}
+
+// OuterHeaderCreation sets the OuterHeaderCreation.
+func (p *ForwardingParametersBuilder) OuterHeaderCreation(desc uint16, teid uint32, v4, v6 string, port uint16, ctag, stag uint32) *ForwardingParametersBuilder {
+	p.set(ie.NewOuterHeaderCreation(desc, teid, v4, v6, port, ctag, stag))
+	return p
+}
+
+// Add adds the IEs that have no dedicated method. The IEs not allowed in
+// ForwardingParameters are reported as errors by Build.
+func (p *ForwardingParametersBuilder) Add(ies ...*ie.IE) *ForwardingParametersBuilder {
+	p.add(ies...)
+	return p
+}
+
+// URRBuilder builds a CreateURR IE. See SessionEstablishmentBuilder.AddURR.
+type URRBuilder struct {
+	groupBuilder
+	id uint32
+}
+
+// ID returns the URR ID of the URR.
+func (u *URRBuilder) ID() uint32 {
+	return u.id
+}
+
+// URRID overrides the URR ID assigned automatically.
+func (u *URRBuilder) URRID(id uint32) *URRBuilder {
+	u.id = id
+	u.set(ie.NewURRID(id))
+	return u
+}
+
+// MeasurementMethod sets the MeasurementMethod.
+func (u *URRBuilder) MeasurementMethod(flags ie.MeasurementMethodFlags) *URRBuilder {
+	u.set(ie.NewMeasurementMethodFlags(flags))
+	return u
+}
+
+// ReportingTriggers sets the ReportingTriggers.
+func (u *URRBuilder) ReportingTriggers(flags ie.ReportingTriggersFlags) *URRBuilder {
+	u.set(ie.NewReportingTriggersFlags(flags))
+	return u
+}
+
+// VolumeThreshold sets the VolumeThreshold.
+func (u *URRBuilder) VolumeThreshold(flags uint8, tvol, uvol, dvol uint64) *URRBuilder {
+	u.set(ie.NewVolumeThreshold(flags, tvol, uvol, dvol))
+	return u
+}
+
+// TimeThreshold sets the TimeThreshold.
+func (u *URRBuilder) TimeThreshold(t time.Duration) *URRBuilder {
+	u.set(ie.NewTimeThreshold(t))
+	return u
+}
+
+// Add adds the IEs that have no dedicated method. The IEs not allowed in CreateURR
+// are reported as errors by Build. A URRID given by Add overrides the URR ID as
+// URRID does.
+func (u *URRBuilder) Add(ies ...*ie.IE) *URRBuilder {
+	for _, i := range ies {
+		if i != nil && i.Type == ie.URRID {
+			if id, err := i.URRID(); err == nil {
+				u.URRID(id)
+				continue
+			}
+		}
+		u.add(i)
+	}
+	return u
+}
+
+func (u *URRBuilder) build() (*ie.IE, []error) {
+	path := rulePath("CreateURR", ie.URRID, uint64(u.id))
+	return ie.NewCreateURR(u.ies...), u.validate(path, u.ies, ie.URRID, ie.MeasurementMethod, ie.ReportingTriggers)
+}
+
+// QERBuilder builds a CreateQER IE. See SessionEstablishmentBuilder.AddQER.
+type QERBuilder struct {
+	groupBuilder
+	id uint32
+}
+
+// ID returns the QER ID of the QER.
+func (q *QERBuilder) ID() uint32 {
+	return q.id
+}
+
+// QERID overrides the QER ID assigned automatically.
+func (q *QERBuilder) QERID(id uint32) *QERBuilder {
+	q.id = id
+	q.set(ie.NewQERID(id))
+	return q
+}
+
+// GateStatus sets the GateStatus.
+func (q *QERBuilder) GateStatus(ul, dl ie.GateStatusValue) *QERBuilder {
+	q.set(ie.NewGateStatus(ul, dl))
+	return q
+}
+
+// MBR sets the MBR.
+func (q *QERBuilder) MBR(ul, dl uint64) *QERBuilder {
+	q.set(ie.NewMBR(ul, dl))
+	return q
+}
+
+// GBR sets the GBR.
+func (q *QERBuilder) GBR(ul, dl uint64) *QERBuilder {
+	q.set(ie.NewGBR(ul, dl))
+	return q
+}
+
+// QFI sets the QFI.
+func (q *QERBuilder) QFI(qfi uint8) *QERBuilder {
+	q.set(ie.NewQFI(qfi))
+	return q
+}
+
+// QERCorrelationID sets the QERCorrelationID.
+func (q *QERBuilder) QERCorrelationID(id uint32) *QERBuilder {
+	q.set(ie.NewQERCorrelationID(id))
+	return q
+}
+
+// Add adds the IEs that have no dedicated method. The IEs not allowed in CreateQER
+// are reported as errors by Build. A QERID given by Add overrides the QER ID as
+// QERID does.
+func (q *QERBuilder) Add(ies ...*ie.IE) *QERBuilder {
+	for _, i := range ies {
+		if i != nil && i.Type == ie.QERID {
+			if id, err := i.QERID(); err == nil {
+				q.QERID(id)
+				continue
+			}
+		}
+		q.add(i)
+	}
+	return q
+}
+
+func (q *QERBuilder) build() (*ie.IE, []error) {
+	path := rulePath("CreateQER", ie.QERID, uint64(q.id))
+	return ie.NewCreateQER(q.ies...), q.validate(path, q.ies, ie.QERID, ie.GateStatus)
+}
+
+// BARBuilder builds a CreateBAR IE. See SessionEstablishmentBuilder.BAR.
+type BARBuilder struct {
+	groupBuilder
+	id uint8
+}
+
+// ID returns the BAR ID of the BAR.
+func (b *BARBuilder) ID() uint8 {
+	return b.id
+}
+
+// BARID overrides the BAR ID assigned automatically.
+func (b *BARBuilder) BARID(id uint8) *BARBuilder {
+	b.id = id
+	b.set(ie.NewBARID(id))
+	return b
+}
+
+// DownlinkDataNotificationDelay sets the DownlinkDataNotificationDelay.
+func (b *BARBuilder) DownlinkDataNotificationDelay(delay time.Duration) *BARBuilder {
+	b.set(ie.NewDownlinkDataNotificationDelay(delay))
+	return b
+}
+
+// SuggestedBufferingPacketsCount sets the SuggestedBufferingPacketsCount.
+func (b *BARBuilder) SuggestedBufferingPacketsCount(count uint8) *BARBuilder {
+	b.set(ie.NewSuggestedBufferingPacketsCount(count))
+	return b
+}
+
+// Add adds the IEs that have no dedicated method. The IEs not allowed in CreateBAR
+// are reported as errors by Build. A BARID given by Add overrides the BAR ID as
+// BARID does.
+func (b *BARBuilder) Add(ies ...*ie.IE) *BARBuilder {
+	for _, i := range ies {
+		if i != nil && i.Type == ie.BARID {
+			if id, err := i.BARID(); err == nil {
+				b.BARID(id)
+				continue
+			}
+		}
+		b.add(i)
+	}
+	return b
+}
+
+func (b *BARBuilder) build() (*ie.IE, []error) {
+	path := rulePath("CreateBAR", ie.BARID, uint64(b.id))
+	return ie.NewCreateBAR(b.ies...), b.validate(path, b.ies, ie.BARID)
+}
@@ -0,0 +1,83 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClone(t *testing.T) {
+	cases := []struct {
+		description string
+		structured  interface{ Marshal() ([]byte, error) }
+		clone       func(m message.Message) interface{ Marshal() ([]byte, error) }
+	}{
+		{
+			"SessionEstablishmentRequest",
+			newBenchSessionEstablishmentRequest(2),
+			func(m message.Message) interface{ Marshal() ([]byte, error) } {
+				return m.(*message.SessionEstablishmentRequest).Clone()
+			},
+		}, {
+			"HeartbeatRequest",
+			message.NewHeartbeatRequest(
+				seq, ie.NewRecoveryTimeStamp(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)), nil,
+			),
+			func(m message.Message) interface{ Marshal() ([]byte, error) } {
+				return m.(*message.HeartbeatRequest).Clone()
+			},
+		}, {
+			"Generic",
+			message.NewGeneric(0x64, seid, seq, ie.NewCreatePDR(ie.NewPDRID(1))),
+			func(m message.Message) interface{ Marshal() ([]byte, error) } {
+				return m.(*message.Generic).Clone()
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			want, err := c.structured.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			buf := append([]byte(nil), want...)
+			parsed, err := message.Parse(buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cloned := c.clone(parsed)
+
+			copied, err := message.Parse(buf, message.WithCopy())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// reuse the buffer, which corrupts parsed but not the others.
+			for n := range buf {
+				buf[n] = 0xff
+			}
+
+			for name, m := range map[string]interface{ Marshal() ([]byte, error) }{
+				"Clone":    cloned,
+				"WithCopy": copied.(interface{ Marshal() ([]byte, error) }),
+			} {
+				got, err := m.Marshal()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("%s: %s", name, diff)
+				}
+			}
+		})
+	}
+}
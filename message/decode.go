@@ -0,0 +1,241 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+// DecodeError indicates the failure in decoding a message, with the location of
+// the IE that failed. Err is the cause, e.g., io.ErrUnexpectedEOF or
+// ie.ErrInvalidLength, so that errors.Is works with DecodeError as well.
+type DecodeError struct {
+	MsgType uint8
+
+	// Offset is the position of the IE that failed from the beginning of the
+	// message, or the position where the message is inconsistent with the header.
+	Offset int
+
+	// Type and Path are the same as the ones in ie.DecodeError. They are zero
+	// values if the failure is not in the IEs.
+	Type uint16
+	Path string
+
+	Err error
+}
+
+// Error returns message with the location and the cause.
+func (e *DecodeError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("failed to decode %s at offset %d: %v", msgTypeName(e.MsgType), e.Offset, e.Err)
+	}
+	return fmt.Sprintf("failed to decode %s in %s at offset %d: %v", e.Path, msgTypeName(e.MsgType), e.Offset, e.Err)
+}
+
+// Unwrap returns the cause.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+func msgTypeName(msgType uint8) string {
+	if m := newBuiltinKnown(msgType); m != nil {
+		return m.MessageTypeName()
+	}
+	return "message type " + strconv.Itoa(int(msgType))
+}
+
+// newDecodeError returns the err from the IEs in the payload as *DecodeError.
+func newDecodeError(h *Header, err error) error {
+	return payloadError(h.Type, h.MarshalLen()-len(h.Payload), err)
+}
+
+// payloadError returns the err from the IEs in the payload that starts at hl as
+// *DecodeError.
+func payloadError(msgType uint8, hl int, err error) error {
+	e := &DecodeError{MsgType: msgType, Offset: hl, Err: err}
+
+	var de *ie.DecodeError
+	if errors.As(err, &de) {
+		e.Offset += de.Offset
+		e.Type, e.Path, e.Err = de.Type, de.Path, de.Err
+	}
+	return e
+}
+
+// Strict makes Parse return an error on any inconsistency in the message, i.e.,
+// the Length in the header not matching the given bytes, the length inconsistency
+// in the IEs at any depth, the duplicated IEs that can appear only once, and the
+// IEs that are not expected in the type of message.
+//
+// The IEs of unknown type are not considered unexpected in *Generic.
+func Strict() ParseOption {
+	return func(c *parseConfig) {
+		c.strict, c.lenient = true, false
+	}
+}
+
+// Lenient makes Parse skip the malformed IEs and keep decoding the rest, instead of
+// returning an error. The problems found, including the ones Strict returns as an
+// error, are appended to warnings, which can be nil if not needed.
+//
+// The bytes after the Length in the header are ignored. See ie.Lenient for how the
+// malformed IEs are skipped. Note that the given bytes are copied if anything is
+// dropped.
+func Lenient(warnings *[]error) ParseOption {
+	return func(c *parseConfig) {
+		c.strict, c.lenient = false, true
+		c.warnings = warnings
+	}
+}
+
+func (c parseConfig) warn(err error) {
+	if c.warnings != nil {
+		*c.warnings = append(*c.warnings, err)
+	}
+}
+
+// headerLen returns the length of the header, or 0 if b is too short.
+func headerLen(b []byte) int {
+	n := 8
+	if has1stBit(b[0]) {
+		n += 8
+	}
+	if len(b) < n {
+		return 0
+	}
+	return n
+}
+
+// prepare checks the framing of the message and the IEs in it before decoding. In
+// lenient mode, it returns the bytes without the malformed IEs.
+func (c parseConfig) prepare(b []byte) ([]byte, error) {
+	hl := headerLen(b)
+	if hl == 0 {
+		// let UnmarshalBinary return the error.
+		return b, nil
+	}
+
+	n := 4 + int(binary.BigEndian.Uint16(b[2:4]))
+	var lenErr error
+	switch {
+	case n < hl:
+		lenErr = &DecodeError{MsgType: b[1], Offset: 2, Err: ie.ErrInvalidLength}
+	case len(b) < n:
+		lenErr = &DecodeError{MsgType: b[1], Offset: len(b), Err: io.ErrUnexpectedEOF}
+	case len(b) > n:
+		lenErr = &DecodeError{MsgType: b[1], Offset: n, Err: ie.ErrInvalidLength}
+	}
+
+	if c.strict {
+		if lenErr != nil {
+			return nil, lenErr
+		}
+		if err := ie.Validate(b[hl:]); err != nil {
+			return nil, payloadError(b[1], hl, err)
+		}
+		return b, nil
+	}
+
+	if lenErr != nil {
+		c.warn(lenErr)
+		if len(b) > n && n >= hl {
+			b = b[:n]
+		}
+	}
+
+	var ws []error
+	payload := ie.Sanitize(b[hl:], &ws)
+	if len(ws) == 0 {
+		return b, nil
+	}
+	for _, w := range ws {
+		c.warn(payloadError(b[1], hl, w))
+	}
+
+	s := make([]byte, hl+len(payload))
+	copy(s, b[:hl])
+	copy(s[hl:], payload)
+	binary.BigEndian.PutUint16(s[2:4], uint16(len(s)-4))
+	return s, nil
+}
+
+// checkIEs checks the IEs decoded into m against the IEs in b, to find the
+// duplicated IEs that have overwritten the previous one, and the unexpected IEs.
+func (c parseConfig) checkIEs(m Message, b []byte) error {
+	hl := headerLen(b)
+	if hl == 0 {
+		return nil
+	}
+
+	type key struct{ typ, eid uint16 }
+	decoded := map[key]int{}
+	for _, i := range TopLevelIEs(m) {
+		decoded[key{i.Type, i.EnterpriseID}]++
+	}
+
+	unexpected := map[key]int{}
+	if _, ok := m.(*Generic); !ok {
+		v := reflect.ValueOf(m)
+		if v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Struct {
+			f := v.Elem().FieldByName("IEs")
+			if f.IsValid() && f.Type() == ieSliceType {
+				for _, i := range f.Interface().([]*ie.IE) {
+					if i != nil {
+						unexpected[key{i.Type, i.EnterpriseID}]++
+					}
+				}
+			}
+		}
+	}
+
+	counts := map[uint16]int{}
+	it := ie.Iterate(b[hl:])
+	for it.Next() {
+		counts[it.View().Type()]++
+	}
+
+	seen := map[uint16]int{}
+	offset := hl
+	it = ie.Iterate(b[hl:])
+	for it.Next() {
+		v := it.View()
+		k := key{v.Type(), v.EnterpriseID()}
+		idx := seen[k.typ]
+		seen[k.typ]++
+
+		var err error
+		switch {
+		case decoded[k] == 0:
+			err = ie.ErrDuplicatedIE
+		case unexpected[k] > 0:
+			decoded[k]--
+			unexpected[k]--
+			err = ie.ErrUnexpectedIE
+		default:
+			decoded[k]--
+		}
+
+		if err != nil {
+			path := ie.TypeName(k.typ)
+			if counts[k.typ] > 1 {
+				path += "[" + strconv.Itoa(idx) + "]"
+			}
+			err = &DecodeError{MsgType: b[1], Offset: offset, Type: k.typ, Path: path, Err: err}
+			if c.strict {
+				return err
+			}
+			c.warn(err)
+		}
+		offset += v.MarshalLen()
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// appendRawIE appends the raw bytes to the serialized message as an IE and updates
+// the Length in the header.
+func appendRawIE(b, raw []byte) []byte {
+	b = append(append([]byte{}, b...), raw...)
+	binary.BigEndian.PutUint16(b[2:4], uint16(len(b)-4))
+	return b
+}
+
+func TestDecodeModes(t *testing.T) {
+	ts := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	marshal := func(m interface{ Marshal() ([]byte, error) }) []byte {
+		t.Helper()
+		b, err := m.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	valid := marshal(newBenchSessionEstablishmentRequest(1))
+
+	// CreatePDR that contains a vendor-specific IE too short to have Enterprise ID.
+	badPDR := []byte{
+		0x00, 0x01, 0x00, 0x0b,
+		0x00, 0x38, 0x00, 0x02, 0x00, 0x05, // PDRID
+		0x80, 0x01, 0x00, 0x01, 0xff, // malformed
+	}
+	withBadPDR := appendRawIE(valid, badPDR)
+
+	cases := []struct {
+		description string
+		serialized  []byte
+		strictErr   error
+		warnings    int
+		check       func(t *testing.T, m message.Message)
+	}{
+		{
+			"Valid",
+			valid,
+			nil,
+			0,
+			nil,
+		}, {
+			"TrailingBytes",
+			append(append([]byte{}, valid...), 0xde, 0xad),
+			ie.ErrInvalidLength,
+			1,
+			func(t *testing.T, m message.Message) {
+				if !message.Equal(m, newBenchSessionEstablishmentRequest(1)) {
+					t.Error("trailing bytes are not ignored")
+				}
+			},
+		}, {
+			"TruncatedIE",
+			appendRawIE(valid, []byte{0x00, 0x38, 0x00, 0x02}),
+			io.ErrUnexpectedEOF,
+			1,
+			nil,
+		}, {
+			"TruncatedMessage",
+			valid[:len(valid)-3],
+			io.ErrUnexpectedEOF,
+			2,
+			nil,
+		}, {
+			"DuplicatedIE",
+			appendRawIE(
+				marshal(message.NewAssociationSetupRequest(
+					seq,
+					ie.NewNodeID("", "", "a.example"),
+					ie.NewRecoveryTimeStamp(ts),
+				)),
+				marshal(ie.NewNodeID("", "", "b.example")),
+			),
+			ie.ErrDuplicatedIE,
+			1,
+			nil,
+		}, {
+			"UnexpectedIE",
+			marshal(message.NewAssociationSetupRequest(
+				seq,
+				ie.NewNodeID("", "", "a.example"),
+				ie.NewRecoveryTimeStamp(ts),
+				ie.NewPDRID(1),
+			)),
+			ie.ErrUnexpectedIE,
+			1,
+			nil,
+		}, {
+			"BadChild",
+			withBadPDR,
+			io.ErrUnexpectedEOF,
+			1,
+			func(t *testing.T, m message.Message) {
+				req := m.(*message.SessionEstablishmentRequest)
+				if got, want := len(req.CreatePDR), 2; got != want {
+					t.Fatalf("got %d CreatePDR want %d", got, want)
+				}
+				id, err := req.CreatePDR[1].PDRID()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if id != 5 {
+					t.Errorf("got %d want 5", id)
+				}
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			t.Run("Strict", func(t *testing.T) {
+				if _, err := message.Parse(c.serialized, message.Strict()); !errors.Is(err, c.strictErr) {
+					t.Errorf("got %v want %v", err, c.strictErr)
+				}
+			})
+
+			t.Run("Lenient", func(t *testing.T) {
+				var warnings []error
+				m, err := message.Parse(c.serialized, message.Lenient(&warnings))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(warnings) != c.warnings {
+					t.Errorf("got %d warnings want %d: %v", len(warnings), c.warnings, warnings)
+				}
+				if c.check != nil {
+					c.check(t, m)
+				}
+			})
+		})
+	}
+
+	t.Run("Generic", func(t *testing.T) {
+		b := marshal(message.NewGeneric(0xff, seid, seq, ie.NewPDRID(1)))
+		if _, err := message.Parse(b, message.Strict()); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("KeepsInput", func(t *testing.T) {
+		orig := append([]byte{}, withBadPDR...)
+		if _, err := message.Parse(withBadPDR, message.Lenient(nil)); err != nil {
+			t.Fatal(err)
+		}
+		if string(orig) != string(withBadPDR) {
+			t.Error("the given bytes are modified")
+		}
+	})
+
+}
+
+func TestDecodeError(t *testing.T) {
+	b, err := newBenchSessionEstablishmentRequest(2).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// breaks the FTEID in the second CreatePDR.
+	fteid, err := ie.NewFTEID(0x01, 2, net.ParseIP("127.0.0.1"), nil, 0).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	offset := bytes.Index(b, fteid)
+	binary.BigEndian.PutUint16(b[offset+2:offset+4], 0xff00)
+
+	want := message.DecodeError{
+		MsgType: message.MsgTypeSessionEstablishmentRequest,
+		Offset:  offset,
+		Type:    ie.FTEID,
+		Path:    "CreatePDR[1]/PDI/FTEID",
+		Err:     io.ErrUnexpectedEOF,
+	}
+	for _, opts := range [][]message.ParseOption{nil, {message.Strict()}} {
+		_, err := message.Parse(b, opts...)
+
+		var de *message.DecodeError
+		if !errors.As(err, &de) {
+			t.Fatalf("got %T want *message.DecodeError", err)
+		}
+		if diff := cmp.Diff(*de, want, cmpopts.EquateErrors()); diff != "" {
+			t.Error(diff)
+		}
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("%v is not %v", err, io.ErrUnexpectedEOF)
+		}
+	}
+
+	if got, want := want.Error(), "failed to decode CreatePDR[1]/PDI/FTEID in Session Establishment Request at offset "+strconv.Itoa(offset)+": unexpected EOF"; got != want {
+		t.Errorf("got %q want %q", got, want)
+	}
+
+	if _, err := message.ParseSessionEstablishmentRequest(b); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("%v is not %v", err, io.ErrUnexpectedEOF)
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+// ChangeKind is the kind of a Change.
+type ChangeKind uint8
+
+// ChangeKind definitions.
+const (
+	ChangeAdded ChangeKind = iota + 1
+	ChangeRemoved
+	ChangeModified
+)
+
+// String returns the name of ChangeKind.
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeAdded:
+		return "added"
+	case ChangeRemoved:
+		return "removed"
+	case ChangeModified:
+		return "modified"
+	default:
+		return "unknown"
+	}
+}
+
+// Change is a difference between two messages found by Diff.
+type Change struct {
+	Kind ChangeKind
+
+	// Path is the location of the difference, e.g.,
+	// "CreateFAR[FARID=3]/ForwardingParameters/OuterHeaderCreation".
+	//
+	// A grouped IE that has a rule ID (e.g., PDRID in CreatePDR) is identified by
+	// the ID, and other repeated IEs are identified by the position among the IEs
+	// of the same type. The fields in the header are "Header/<FieldName>".
+	// The value of "Header/MessagePriority" is nil if the MP flag is not set.
+	Path string
+
+	// Old and New are the values before and after the change. They are *ie.IE for
+	// the IEs, and the value of the field for the header. Old is nil if the Kind is
+	// ChangeAdded, and New is nil if the Kind is ChangeRemoved.
+	Old, New interface{}
+}
+
+// String returns the Change in human-readable format.
+func (c *Change) String() string {
+	return fmt.Sprintf("%s %s: %v -> %v", c.Kind, c.Path, c.Old, c.New)
+}
+
+// DiffOption is an option to change the behavior of Diff and Equal.
+type DiffOption func(*diffConfig)
+
+type diffConfig struct {
+	ignoreSequence   bool
+	ignoreTimestamps bool
+}
+
+// IgnoreSequenceNumber makes Diff and Equal ignore the sequence numbers in the header.
+func IgnoreSequenceNumber() DiffOption {
+	return func(c *diffConfig) {
+		c.ignoreSequence = true
+	}
+}
+
+// IgnoreTimestamps makes Diff and Equal ignore the IEs that record when an event
+// happened or was observed, such as RecoveryTimeStamp, StartTime and EndTime, at
+// any depth. The times configured by the CP function, such as MonitoringTime and
+// ActivationTime, are still compared.
+func IgnoreTimestamps() DiffOption {
+	return func(c *diffConfig) {
+		c.ignoreTimestamps = true
+	}
+}
+
+var timestampIETypes = map[uint16]bool{
+	ie.RecoveryTimeStamp: true,
+	ie.StartTime:         true,
+	ie.EndTime:           true,
+	ie.TimeOfFirstPacket: true,
+	ie.TimeOfLastPacket:  true,
+	ie.EventTimeStamp:    true,
+}
+
+// keyTypes is the grouped IEs identified by the IE in them, e.g., CreatePDR by
+// PDRID. The other grouped IEs, e.g., PDI with ApplicationID, are not identified
+// by their children.
+var keyTypes = map[uint16]uint16{
+	ie.CreatePDR:  ie.PDRID,
+	ie.UpdatePDR:  ie.PDRID,
+	ie.RemovePDR:  ie.PDRID,
+	ie.CreatedPDR: ie.PDRID,
+	ie.UpdatedPDR: ie.PDRID,
+	ie.CreateFAR:  ie.FARID,
+	ie.UpdateFAR:  ie.FARID,
+	ie.RemoveFAR:  ie.FARID,
+	ie.CreateURR:  ie.URRID,
+	ie.UpdateURR:  ie.URRID,
+	ie.RemoveURR:  ie.URRID,
+	ie.QueryURR:   ie.URRID,
+	ie.UsageReportWithinSessionModificationResponse: ie.URRID,
+	ie.UsageReportWithinSessionDeletionResponse:     ie.URRID,
+	ie.UsageReportWithinSessionReportRequest:        ie.URRID,
+	ie.CreateQER:                                    ie.QERID,
+	ie.UpdateQER:                                    ie.QERID,
+	ie.RemoveQER:                                    ie.QERID,
+	ie.CreateBAR:                                    ie.BARID,
+	ie.UpdateBARWithinSessionModificationRequest:    ie.BARID,
+	ie.UpdateBARWithinSessionReportResponse:         ie.BARID,
+	ie.RemoveBAR:                                    ie.BARID,
+	ie.CreateMAR:                                    ie.MARID,
+	ie.UpdateMAR:                                    ie.MARID,
+	ie.RemoveMAR:                                    ie.MARID,
+	ie.CreateSRR:                                    ie.SRRID,
+	ie.UpdateSRR:                                    ie.SRRID,
+	ie.RemoveSRR:                                    ie.SRRID,
+	ie.CreateTrafficEndpoint:                        ie.TrafficEndpointID,
+	ie.UpdateTrafficEndpoint:                        ie.TrafficEndpointID,
+	ie.RemoveTrafficEndpoint:                        ie.TrafficEndpointID,
+	ie.CreatedTrafficEndpoint:                       ie.TrafficEndpointID,
+	ie.EthernetPacketFilter:                         ie.EthernetFilterID,
+	ie.AddMBSUnicastParameters:                      ie.MBSUnicastParametersID,
+	ie.RemoveMBSUnicastParameters:                   ie.MBSUnicastParametersID,
+	ie.ApplicationIDsPFDs:                           ie.ApplicationID,
+}
+
+// Equal reports whether the two messages are semantically equal, i.e., Diff
+// returns no changes.
+func Equal(a, b Message, opts ...DiffOption) bool {
+	return len(Diff(a, b, opts...)) == 0
+}
+
+// Diff compares the two messages at the IE level and returns the changes from a to b.
+//
+// The order of the IEs is not taken into account anywhere, in the messages and in
+// the grouped IEs, including the IEs of the same type, as TS 29.244 gives no
+// meaning to the order of IEs. Grouped IEs are compared recursively, so that the
+// changes are reported at the deepest level.
+//
+// The header is compared in the message type, SEID, sequence number and the
+// message priority. The other fields, such as the flags and the length, are
+// derived from them or from the IEs.
+func Diff(a, b Message, opts ...DiffOption) []*Change {
+	cfg := &diffConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	var changes []*Change
+	header := func(name string, x, y interface{}) {
+		if x != y {
+			changes = append(changes, &Change{Kind: ChangeModified, Path: "Header/" + name, Old: x, New: y})
+		}
+	}
+	header("Type", a.MessageType(), b.MessageType())
+	header("SEID", a.SEID(), b.SEID())
+	if !cfg.ignoreSequence {
+		header("SequenceNumber", a.Sequence(), b.Sequence())
+	}
+	header("MessagePriority", priorityOf(a), priorityOf(b))
+
+	return cfg.diffIEs(changes, "", TopLevelIEs(a), TopLevelIEs(b))
+}
+
+// priorityOf returns the message priority of m, or nil if the MP flag is not set.
+func priorityOf(m Message) interface{} {
+	p, ok := m.(interface {
+		HasMP() bool
+		MP() uint8
+	})
+	if !ok || !p.HasMP() {
+		return nil
+	}
+	return p.MP()
+}
+
+func (cfg *diffConfig) diffIEs(changes []*Change, path string, a, b []*ie.IE) []*Change {
+	ga, keysA := cfg.groupByKey(a)
+	gb, keysB := cfg.groupByKey(b)
+
+	for _, k := range keysA {
+		changes = cfg.diffGroup(changes, path, k, ga[k], gb[k])
+	}
+	for _, k := range keysB {
+		if _, ok := ga[k]; !ok {
+			changes = cfg.diffGroup(changes, path, k, nil, gb[k])
+		}
+	}
+	return changes
+}
+
+// diffGroup compares the IEs that have the same key. The exactly same IEs are paired
+// regardless of the order first, and then the rest are paired in order.
+func (cfg *diffConfig) diffGroup(changes []*Change, path, key string, a, b []*ie.IE) []*Change {
+	restA := make([]*ie.IE, 0, len(a))
+	matched := make([]bool, len(b))
+	for _, x := range a {
+		found := false
+		for n, y := range b {
+			if !matched[n] && cfg.diffIE(nil, "", x, y) == nil {
+				matched[n], found = true, true
+				break
+			}
+		}
+		if !found {
+			restA = append(restA, x)
+		}
+	}
+	restB := make([]*ie.IE, 0, len(b))
+	for n, y := range b {
+		if !matched[n] {
+			restB = append(restB, y)
+		}
+	}
+
+	many := len(a) > 1 || len(b) > 1
+	for n := 0; n < len(restA) || n < len(restB); n++ {
+		p := key
+		if many {
+			p += "[" + strconv.Itoa(n) + "]"
+		}
+		if path != "" {
+			p = path + "/" + p
+		}
+
+		switch {
+		case n >= len(restB):
+			changes = append(changes, &Change{Kind: ChangeRemoved, Path: p, Old: restA[n]})
+		case n >= len(restA):
+			changes = append(changes, &Change{Kind: ChangeAdded, Path: p, New: restB[n]})
+		default:
+			changes = cfg.diffIE(changes, p, restA[n], restB[n])
+		}
+	}
+	return changes
+}
+
+func (cfg *diffConfig) diffIE(changes []*Change, path string, a, b *ie.IE) []*Change {
+	if a.Type != b.Type || a.EnterpriseID != b.EnterpriseID || a.IsGrouped() != b.IsGrouped() {
+		return append(changes, &Change{Kind: ChangeModified, Path: path, Old: a, New: b})
+	}
+
+	if !a.IsGrouped() {
+		if !bytes.Equal(a.Payload, b.Payload) {
+			changes = append(changes, &Change{Kind: ChangeModified, Path: path, Old: a, New: b})
+		}
+		return changes
+	}
+
+	ca, errA := a.ValueAsGrouped()
+	cb, errB := b.ValueAsGrouped()
+	if errA != nil || errB != nil {
+		if !bytes.Equal(a.Payload, b.Payload) {
+			changes = append(changes, &Change{Kind: ChangeModified, Path: path, Old: a, New: b})
+		}
+		return changes
+	}
+	return cfg.diffIEs(changes, path, ca, cb)
+}
+
+// groupByKey groups the IEs by the key, which is the type name with the rule ID
+// if any, e.g., "CreateFAR[FARID=3]". The keys are returned in order of appearance.
+func (cfg *diffConfig) groupByKey(ies []*ie.IE) (map[string][]*ie.IE, []string) {
+	groups := make(map[string][]*ie.IE)
+	var keys []string
+	for _, i := range ies {
+		if i == nil || (cfg.ignoreTimestamps && timestampIETypes[i.Type]) {
+			continue
+		}
+
+		k := ieKey(i)
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], i)
+	}
+	return groups, keys
+}
+
+func ieKey(i *ie.IE) string {
+	name := i.Name()
+	if i.IsVendorSpecific() {
+		name += "@" + strconv.Itoa(int(i.EnterpriseID))
+	}
+	if !i.IsGrouped() {
+		return name
+	}
+
+	t, ok := keyTypes[i.Type]
+	if !ok || i.IsVendorSpecific() {
+		return name
+	}
+	children, err := i.ValueAsGrouped()
+	if err != nil {
+		return name
+	}
+	for _, c := range children {
+		if c.Type != t {
+			continue
+		}
+		if t == ie.ApplicationID {
+			return name + "[" + ie.TypeName(t) + "=" + string(c.Payload) + "]"
+		}
+
+		var v uint64
+		for _, x := range c.Payload {
+			v = v<<8 | uint64(x)
+		}
+		return name + "[" + ie.TypeName(t) + "=" + strconv.FormatUint(v, 10) + "]"
+	}
+	return name
+}
@@ -0,0 +1,199 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiff(t *testing.T) {
+	newPDR := func(id uint16, farID uint32) *ie.IE {
+		return ie.NewCreatePDR(
+			ie.NewPDRID(id),
+			ie.NewPrecedence(100),
+			ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceAccess)),
+			ie.NewFARID(farID),
+		)
+	}
+	newFAR := func(id uint32, teid uint32) *ie.IE {
+		return ie.NewCreateFAR(
+			ie.NewFARID(id),
+			ie.NewApplyAction(0x02),
+			ie.NewForwardingParameters(
+				ie.NewDestinationInterface(ie.DstInterfaceAccess),
+				ie.NewOuterHeaderCreation(0x0100, teid, "127.0.0.1", "", 0, 0, 0),
+			),
+		)
+	}
+	nodeID := ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org")
+	fseid := ie.NewFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil)
+	ts := func(sec int) *ie.IE {
+		return ie.NewRecoveryTimeStamp(time.Date(2019, time.January, 1, 0, 0, sec, 0, time.UTC))
+	}
+
+	base := message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri,
+		nodeID, fseid, newPDR(1, 3), newPDR(2, 4), newFAR(3, 0x11111111), newFAR(4, 0x22222222),
+	)
+
+	type change struct {
+		Kind message.ChangeKind
+		Path string
+	}
+	cases := []struct {
+		description string
+		other       message.Message
+		opts        []message.DiffOption
+		changes     []change
+	}{
+		{
+			"Reordered",
+			message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri,
+				fseid, nodeID, newFAR(4, 0x22222222), newPDR(2, 4), newFAR(3, 0x11111111), newPDR(1, 3),
+			),
+			nil,
+			nil,
+		}, {
+			"ModifiedNested",
+			message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri,
+				nodeID, fseid, newPDR(1, 3), newPDR(2, 4), newFAR(3, 0x33333333), newFAR(4, 0x22222222),
+			),
+			nil,
+			[]change{
+				{message.ChangeModified, "CreateFAR[FARID=3]/ForwardingParameters/OuterHeaderCreation"},
+			},
+		}, {
+			"AddedRemoved",
+			message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri,
+				nodeID, fseid, newPDR(1, 3), newPDR(5, 4), newFAR(3, 0x11111111), newFAR(4, 0x22222222),
+			),
+			nil,
+			[]change{
+				{message.ChangeRemoved, "CreatePDR[PDRID=2]"},
+				{message.ChangeAdded, "CreatePDR[PDRID=5]"},
+			},
+		}, {
+			"Sequence",
+			message.NewSessionEstablishmentRequest(mp, fo, seid, seq+1, pri,
+				nodeID, fseid, newPDR(1, 3), newPDR(2, 4), newFAR(3, 0x11111111), newFAR(4, 0x22222222),
+			),
+			nil,
+			[]change{
+				{message.ChangeModified, "Header/SequenceNumber"},
+			},
+		}, {
+			"IgnoreSequence",
+			message.NewSessionEstablishmentRequest(mp, fo, seid, seq+1, pri,
+				nodeID, fseid, newPDR(1, 3), newPDR(2, 4), newFAR(3, 0x11111111), newFAR(4, 0x22222222),
+			),
+			[]message.DiffOption{message.IgnoreSequenceNumber()},
+			nil,
+		}, {
+			"MessagePriority",
+			message.NewSessionEstablishmentRequest(1, fo, seid, seq, 0x50,
+				nodeID, fseid, newPDR(1, 3), newPDR(2, 4), newFAR(3, 0x11111111), newFAR(4, 0x22222222),
+			),
+			nil,
+			[]change{
+				{message.ChangeModified, "Header/MessagePriority"},
+			},
+		}, {
+			"Timestamp",
+			message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri,
+				nodeID, fseid, newPDR(1, 3), newPDR(2, 4), newFAR(3, 0x11111111), newFAR(4, 0x22222222), ts(1),
+			),
+			nil,
+			[]change{
+				{message.ChangeAdded, "RecoveryTimeStamp"},
+			},
+		}, {
+			"IgnoreTimestamps",
+			message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri,
+				nodeID, fseid, newPDR(1, 3), newPDR(2, 4), newFAR(3, 0x11111111), newFAR(4, 0x22222222), ts(1),
+			),
+			[]message.DiffOption{message.IgnoreTimestamps()},
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			var got []change
+			for _, x := range message.Diff(base, c.other, c.opts...) {
+				got = append(got, change{x.Kind, x.Path})
+			}
+			if diff := cmp.Diff(got, c.changes); diff != "" {
+				t.Error(diff)
+			}
+
+			if got, want := message.Equal(base, c.other, c.opts...), len(c.changes) == 0; got != want {
+				t.Errorf("got %v want %v", got, want)
+			}
+		})
+	}
+
+	t.Run("VendorIE", func(t *testing.T) {
+		ie.RegisterVendorIE(10415, 0x8001, &ie.VendorIEDefinition{Name: "VendorCounter"})
+		defer ie.RegisterVendorIE(10415, 0x8001, nil)
+
+		a := message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri, nodeID, fseid,
+			ie.NewVendorSpecificIE(0x8001, 10415, []byte{0x01}),
+		)
+		b := message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri, nodeID, fseid,
+			ie.NewVendorSpecificIE(0x8001, 10415, []byte{0x02}),
+		)
+
+		var got []change
+		for _, x := range message.Diff(a, b) {
+			got = append(got, change{x.Kind, x.Path})
+		}
+		want := []change{{message.ChangeModified, "VendorCounter@10415"}}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("ApplicationIDInPDI", func(t *testing.T) {
+		newAppPDR := func(app string) *ie.IE {
+			return ie.NewCreatePDR(
+				ie.NewPDRID(1),
+				ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceCore), ie.NewApplicationID(app)),
+			)
+		}
+		a := message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri, nodeID, fseid, newAppPDR("video"))
+		b := message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri, nodeID, fseid, newAppPDR("chat"))
+
+		var got []change
+		for _, x := range message.Diff(a, b) {
+			got = append(got, change{x.Kind, x.Path})
+		}
+		want := []change{{message.ChangeModified, "CreatePDR[PDRID=1]/PDI/ApplicationID"}}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("ConfiguredTime", func(t *testing.T) {
+		newURR := func(hour int) *ie.IE {
+			return ie.NewCreateURR(ie.NewURRID(1), ie.NewMonitoringTime(time.Date(2019, time.January, 1, hour, 0, 0, 0, time.UTC)))
+		}
+		a := message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri, nodeID, fseid, newURR(0))
+		b := message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri, nodeID, fseid, newURR(1))
+
+		var got []change
+		for _, x := range message.Diff(a, b, message.IgnoreTimestamps()) {
+			got = append(got, change{x.Kind, x.Path})
+		}
+		want := []change{{message.ChangeModified, "CreateURR[URRID=1]/MonitoringTime"}}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
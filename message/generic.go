@@ -56,26 +56,31 @@ func (m *Generic) Marshal() ([]byte, error) {
 	return b, nil
 }
 
+// AppendBinary appends the byte sequence generated from a Generic to b
+// and returns the extended buffer.
+func (m *Generic) AppendBinary(b []byte) ([]byte, error) {
+	return appendBinary(b, m)
+}
+
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *Generic) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	offset, err := m.Header.marshalFixedTo(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
-	offset := 0
 	for _, ie := range m.IEs {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		n, err := ie.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += ie.MarshalLen()
+		offset += n
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return nil
 }
 
 // ParseGeneric decodes a given byte sequence as a Generic.
@@ -100,7 +105,7 @@ func (m *Generic) UnmarshalBinary(b []byte) error {
 
 	m.IEs, err = ie.ParseMultiIEs(m.Header.Payload)
 	if err != nil {
-		return err
+		return newDecodeError(m.Header, err)
 	}
 	return nil
 }
@@ -143,3 +148,18 @@ func (m *Generic) AddIE(ies ...*ie.IE) {
 	m.IEs = append(m.IEs, ies...)
 	m.SetLength()
 }
+
+// Clone returns a deep copy of the Generic.
+//
+// The returned message does not share any memory with the original, so it is
+// safe to use even after the buffer given to Parse is reused.
+func (m *Generic) Clone() *Generic {
+	if m == nil {
+		return nil
+	}
+
+	return &Generic{
+		Header: m.Header.Clone(),
+		IEs:    cloneIEs(m.IEs),
+	}
+}
@@ -71,8 +71,36 @@ func (h *Header) Marshal() ([]byte, error) {
 	return b, nil
 }
 
+// AppendBinary appends the byte sequence generated from a Header instance to b
+// and returns the extended buffer.
+func (h *Header) AppendBinary(b []byte) ([]byte, error) {
+	return appendBinary(b, h)
+}
+
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (h *Header) MarshalTo(b []byte) error {
+	offset := h.putFixed(b)
+	copy(b[offset:h.MarshalLen()], h.Payload)
+
+	return nil
+}
+
+// marshalFixedTo is used by the messages to put the header directly in b, instead of
+// building Payload and copying it with MarshalTo. l is the length of the whole message.
+//
+// This sets the Length field and puts the header fields other than Payload in b, and
+// returns the offset where the IEs should be put.
+func (h *Header) marshalFixedTo(b []byte, l int) (int, error) {
+	if len(b) < l {
+		return 0, io.ErrShortBuffer
+	}
+
+	h.Length = uint16(l - 4)
+	return h.putFixed(b), nil
+}
+
+// putFixed puts the header fields other than Payload in b and returns the offset.
+func (h *Header) putFixed(b []byte) int {
 	b[0] = h.Flags
 	b[1] = h.Type
 	binary.BigEndian.PutUint16(b[2:4], h.Length)
@@ -83,11 +111,26 @@ func (h *Header) MarshalTo(b []byte) error {
 		offset += 8
 	}
 
-	copy(b[offset:offset+3], uint32To24(h.SequenceNumber))
+	b[offset] = uint8(h.SequenceNumber >> 16)
+	b[offset+1] = uint8(h.SequenceNumber >> 8)
+	b[offset+2] = uint8(h.SequenceNumber)
 	b[offset+3] = h.MessagePriority
-	copy(b[offset+4:h.MarshalLen()], h.Payload)
 
-	return nil
+	return offset + 4
+}
+
+// Clone returns a deep copy of the Header.
+func (h *Header) Clone() *Header {
+	if h == nil {
+		return nil
+	}
+
+	c := *h
+	if h.Payload != nil {
+		c.Payload = make([]byte, len(h.Payload))
+		copy(c.Payload, h.Payload)
+	}
+	return &c
 }
 
 // ParseHeader decodes given byte sequence as a PFCP header.
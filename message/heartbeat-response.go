@@ -41,33 +41,39 @@ func (m *HeartbeatResponse) Marshal() ([]byte, error) {
 	return b, nil
 }
 
+// AppendBinary appends the byte sequence generated from a HeartbeatResponse to b
+// and returns the extended buffer.
+func (m *HeartbeatResponse) AppendBinary(b []byte) ([]byte, error) {
+	return appendBinary(b, m)
+}
+
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *HeartbeatResponse) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	offset, err := m.Header.marshalFixedTo(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
-	offset := 0
 	if i := m.RecoveryTimeStamp; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 
 	for _, ie := range m.IEs {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		n, err := ie.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += ie.MarshalLen()
+		offset += n
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return nil
 }
 
 // ParseHeartbeatResponse decodes a given byte sequence as a HeartbeatResponse.
@@ -92,7 +98,7 @@ func (m *HeartbeatResponse) UnmarshalBinary(b []byte) error {
 
 	ies, err := ie.ParseMultiIEs(m.Header.Payload)
 	if err != nil {
-		return err
+		return newDecodeError(m.Header, err)
 	}
 
 	for _, i := range ies {
@@ -148,3 +154,19 @@ func (m *HeartbeatResponse) MessageTypeName() string {
 func (m *HeartbeatResponse) SEID() uint64 {
 	return m.Header.seid()
 }
+
+// Clone returns a deep copy of the HeartbeatResponse.
+//
+// The returned message does not share any memory with the original, so it is
+// safe to use even after the buffer given to Parse is reused.
+func (m *HeartbeatResponse) Clone() *HeartbeatResponse {
+	if m == nil {
+		return nil
+	}
+
+	return &HeartbeatResponse{
+		Header:            m.Header.Clone(),
+		RecoveryTimeStamp: m.RecoveryTimeStamp.Clone(),
+		IEs:               cloneIEs(m.IEs),
+	}
+}
@@ -12,23 +12,25 @@ import (
 
 // MessageType definitions.
 const (
-	MsgTypeHeartbeatRequest            uint8 = 1
-	MsgTypeHeartbeatResponse           uint8 = 2
-	MsgTypePFDManagementRequest        uint8 = 3
-	MsgTypePFDManagementResponse       uint8 = 4
-	MsgTypeAssociationSetupRequest     uint8 = 5
-	MsgTypeAssociationSetupResponse    uint8 = 6
-	MsgTypeAssociationUpdateRequest    uint8 = 7
-	MsgTypeAssociationUpdateResponse   uint8 = 8
-	MsgTypeAssociationReleaseRequest   uint8 = 9
-	MsgTypeAssociationReleaseResponse  uint8 = 10
-	MsgTypeVersionNotSupportedResponse uint8 = 11
-	MsgTypeNodeReportRequest           uint8 = 12
-	MsgTypeNodeReportResponse          uint8 = 13
-	MsgTypeSessionSetDeletionRequest   uint8 = 14
-	MsgTypeSessionSetDeletionResponse  uint8 = 15
-
-	// 16 to 49: For future use
+	MsgTypeHeartbeatRequest               uint8 = 1
+	MsgTypeHeartbeatResponse              uint8 = 2
+	MsgTypePFDManagementRequest           uint8 = 3
+	MsgTypePFDManagementResponse          uint8 = 4
+	MsgTypeAssociationSetupRequest        uint8 = 5
+	MsgTypeAssociationSetupResponse       uint8 = 6
+	MsgTypeAssociationUpdateRequest       uint8 = 7
+	MsgTypeAssociationUpdateResponse      uint8 = 8
+	MsgTypeAssociationReleaseRequest      uint8 = 9
+	MsgTypeAssociationReleaseResponse     uint8 = 10
+	MsgTypeVersionNotSupportedResponse    uint8 = 11
+	MsgTypeNodeReportRequest              uint8 = 12
+	MsgTypeNodeReportResponse             uint8 = 13
+	MsgTypeSessionSetDeletionRequest      uint8 = 14
+	MsgTypeSessionSetDeletionResponse     uint8 = 15
+	MsgTypeSessionSetModificationRequest  uint8 = 16
+	MsgTypeSessionSetModificationResponse uint8 = 17
+
+	// 18 to 49: For future use
 
 	MsgTypeSessionEstablishmentRequest  uint8 = 50
 	MsgTypeSessionEstablishmentResponse uint8 = 51
@@ -55,14 +57,105 @@ type Message interface {
 	SetSequenceNumber(seq uint32)
 }
 
+// ParseOption is an option to change the behavior of Parse.
+type ParseOption func(*parseConfig)
+
+type parseConfig struct {
+	copyInput bool
+	strict    bool
+	lenient   bool
+	warnings  *[]error
+}
+
+func newParseConfig(opts []ParseOption) parseConfig {
+	if len(opts) == 0 {
+		return parseConfig{}
+	}
+
+	c := &parseConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return *c
+}
+
+// WithCopy makes Parse copy the given bytes before decoding them.
+//
+// By default, the IEs in the returned Message refer to the given bytes directly,
+// so the buffer cannot be reused while the Message is in use. With this option,
+// the buffer can be reused right after Parse returns, at the cost of one allocation
+// of the same size as the message.
+func WithCopy() ParseOption {
+	return func(c *parseConfig) {
+		c.copyInput = true
+	}
+}
+
 // Parse parses the given bytes as Message.
-func Parse(b []byte) (Message, error) {
+//
+// The type of the returned Message is determined by the message type in the header.
+// If a function is registered for the type with Register(), the Message returned by
+// it is used. Otherwise, the built-in type is used, or *Generic if the type is unknown.
+//
+// Note that the returned Message refers to the given bytes directly unless WithCopy
+// is given. Use WithCopy, or Clone on the returned Message, when the buffer is reused.
+//
+// By default, the malformed parts of the message are tolerated as far as possible.
+// Give Strict or Lenient to detect them.
+func Parse(b []byte, opts ...ParseOption) (Message, error) {
 	if len(b) < 2 {
 		return nil, io.ErrUnexpectedEOF
 	}
 
+	cfg := newParseConfig(opts)
+	if cfg.copyInput {
+		c := make([]byte, len(b))
+		copy(c, b)
+		b = c
+	}
+
+	if cfg.strict || cfg.lenient {
+		var err error
+		if b, err = cfg.prepare(b); err != nil {
+			return nil, err
+		}
+	}
+
 	var m Message
-	switch b[1] {
+	if fn, ok := lookupRegistry(b[1]); ok {
+		m = fn()
+	} else {
+		m = newBuiltin(b[1])
+	}
+
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	if cfg.strict || cfg.lenient {
+		if err := cfg.checkIEs(m, b); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// newBuiltin returns a new Message of the built-in type for the given message type,
+// or *Generic if the type is unknown.
+func newBuiltin(msgType uint8) Message {
+	if m := newBuiltinKnown(msgType); m != nil {
+		return m
+	}
+
+	logger.Logf("Parse() got an unknown type of message(Type=%d), parsing with *Generic.", msgType)
+	return &Generic{}
+}
+
+// newBuiltinKnown returns a new Message of the built-in type for the given message
+// type, or nil if the type is unknown.
+func newBuiltinKnown(msgType uint8) Message {
+	var m Message
+	switch msgType {
 	case MsgTypeHeartbeatRequest:
 		m = &HeartbeatRequest{}
 	case MsgTypeHeartbeatResponse:
@@ -93,6 +186,10 @@ func Parse(b []byte) (Message, error) {
 		m = &SessionSetDeletionRequest{}
 	case MsgTypeSessionSetDeletionResponse:
 		m = &SessionSetDeletionResponse{}
+	case MsgTypeSessionSetModificationRequest:
+		m = &SessionSetModificationRequest{}
+	case MsgTypeSessionSetModificationResponse:
+		m = &SessionSetModificationResponse{}
 	case MsgTypeSessionEstablishmentRequest:
 		m = &SessionEstablishmentRequest{}
 	case MsgTypeSessionEstablishmentResponse:
@@ -109,13 +206,7 @@ func Parse(b []byte) (Message, error) {
 		m = &SessionReportRequest{}
 	case MsgTypeSessionReportResponse:
 		m = &SessionReportResponse{}
-	default:
-		logger.Logf("Parse() got an unknown type of message(Type=%d), parsing with *Generic.", b[1])
-		m = &Generic{}
 	}
 
-	if err := m.UnmarshalBinary(b); err != nil {
-		return nil, err
-	}
-	return m, nil
+	return m
 }
@@ -4,6 +4,20 @@
 
 package message
 
+import "github.com/aalayanahmad/go-pfcp/ie"
+
+func cloneIEs(ies []*ie.IE) []*ie.IE {
+	if ies == nil {
+		return nil
+	}
+
+	c := make([]*ie.IE, len(ies))
+	for n, i := range ies {
+		c[n] = i.Clone()
+	}
+	return c
+}
+
 func uint24To32(b []byte) uint32 {
 	if len(b) != 3 {
 		return 0
@@ -11,10 +25,6 @@ func uint24To32(b []byte) uint32 {
 	return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
 }
 
-func uint32To24(n uint32) []byte {
-	return []byte{uint8(n >> 16), uint8(n >> 8), uint8(n)}
-}
-
 /*
 func swap(raw []byte) []byte {
 	var swapped []byte
@@ -63,63 +63,74 @@ func (m *NodeReportRequest) Marshal() ([]byte, error) {
 	return b, nil
 }
 
+// AppendBinary appends the byte sequence generated from a NodeReportRequest to b
+// and returns the extended buffer.
+func (m *NodeReportRequest) AppendBinary(b []byte) ([]byte, error) {
+	return appendBinary(b, m)
+}
+
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *NodeReportRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	offset, err := m.Header.marshalFixedTo(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
-	offset := 0
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.NodeReportType; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.UserPlanePathFailureReport; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	if i := m.UserPlanePathRecoveryReport; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	for _, i := range m.ClockDriftReport {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 	for _, i := range m.GTPUPathQoSReport {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 
 	for _, ie := range m.IEs {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		n, err := ie.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += ie.MarshalLen()
+		offset += n
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return nil
 }
 
 // ParseNodeReportRequest decodes a given byte sequence as a NodeReportRequest.
@@ -144,7 +155,7 @@ func (m *NodeReportRequest) UnmarshalBinary(b []byte) error {
 
 	ies, err := ie.ParseMultiIEs(m.Header.Payload)
 	if err != nil {
-		return err
+		return newDecodeError(m.Header, err)
 	}
 
 	for _, i := range ies {
@@ -216,3 +227,24 @@ func (m *NodeReportRequest) MessageTypeName() string {
 func (m *NodeReportRequest) SEID() uint64 {
 	return m.Header.seid()
 }
+
+// Clone returns a deep copy of the NodeReportRequest.
+//
+// The returned message does not share any memory with the original, so it is
+// safe to use even after the buffer given to Parse is reused.
+func (m *NodeReportRequest) Clone() *NodeReportRequest {
+	if m == nil {
+		return nil
+	}
+
+	return &NodeReportRequest{
+		Header:                      m.Header.Clone(),
+		NodeID:                      m.NodeID.Clone(),
+		NodeReportType:              m.NodeReportType.Clone(),
+		UserPlanePathFailureReport:  m.UserPlanePathFailureReport.Clone(),
+		UserPlanePathRecoveryReport: m.UserPlanePathRecoveryReport.Clone(),
+		ClockDriftReport:            cloneIEs(m.ClockDriftReport),
+		GTPUPathQoSReport:           cloneIEs(m.GTPUPathQoSReport),
+		IEs:                         cloneIEs(m.IEs),
+	}
+}
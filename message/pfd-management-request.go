@@ -48,33 +48,39 @@ func (m *PFDManagementRequest) Marshal() ([]byte, error) {
 	return b, nil
 }
 
+// AppendBinary appends the byte sequence generated from a PFDManagementRequest to b
+// and returns the extended buffer.
+func (m *PFDManagementRequest) AppendBinary(b []byte) ([]byte, error) {
+	return appendBinary(b, m)
+}
+
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *PFDManagementRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	offset, err := m.Header.marshalFixedTo(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
-	offset := 0
 	for _, i := range m.ApplicationIDsPFDs {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += i.MarshalLen()
+		offset += n
 	}
 
 	for _, ie := range m.IEs {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		n, err := ie.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += ie.MarshalLen()
+		offset += n
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return nil
 }
 
 // ParsePFDManagementRequest decodes a given byte sequence as a PFDManagementRequest.
@@ -99,7 +105,7 @@ func (m *PFDManagementRequest) UnmarshalBinary(b []byte) error {
 
 	ies, err := ie.ParseMultiIEs(m.Header.Payload)
 	if err != nil {
-		return err
+		return newDecodeError(m.Header, err)
 	}
 
 	for _, i := range ies {
@@ -146,3 +152,19 @@ func (m *PFDManagementRequest) MessageTypeName() string {
 func (m *PFDManagementRequest) SEID() uint64 {
 	return m.Header.seid()
 }
+
+// Clone returns a deep copy of the PFDManagementRequest.
+//
+// The returned message does not share any memory with the original, so it is
+// safe to use even after the buffer given to Parse is reused.
+func (m *PFDManagementRequest) Clone() *PFDManagementRequest {
+	if m == nil {
+		return nil
+	}
+
+	return &PFDManagementRequest{
+		Header:             m.Header.Clone(),
+		ApplicationIDsPFDs: cloneIEs(m.ApplicationIDsPFDs),
+		IEs:                cloneIEs(m.IEs),
+	}
+}
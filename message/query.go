@@ -0,0 +1,75 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"reflect"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+var (
+	ieType      = reflect.TypeOf((*ie.IE)(nil))
+	ieSliceType = reflect.TypeOf([]*ie.IE(nil))
+)
+
+// TopLevelIEs returns all the IEs directly contained in the message, in the order
+// of the fields in the struct, which is the same as the order of serialization.
+//
+// The message should be a pointer to a struct that has the IEs in the fields of
+// *ie.IE or []*ie.IE type, like the ones in this package. The IEs in the embedded
+// structs are also collected, so this works with the types that extend the built-in
+// messages for Register(). The nil IEs are skipped.
+func TopLevelIEs(m Message) []*ie.IE {
+	v := reflect.ValueOf(m)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	return appendIEs(nil, v)
+}
+
+func appendIEs(ies []*ie.IE, v reflect.Value) []*ie.IE {
+	t := v.Type()
+	for n := 0; n < v.NumField(); n++ {
+		f, sf := v.Field(n), t.Field(n)
+		if !sf.IsExported() {
+			continue
+		}
+
+		switch {
+		case f.Type() == ieType:
+			if !f.IsNil() {
+				ies = append(ies, f.Interface().(*ie.IE))
+			}
+		case f.Type() == ieSliceType:
+			for _, i := range f.Interface().([]*ie.IE) {
+				if i != nil {
+					ies = append(ies, i)
+				}
+			}
+		case sf.Anonymous && f.Kind() == reflect.Struct:
+			ies = appendIEs(ies, f)
+		case sf.Anonymous && f.Kind() == reflect.Ptr && !f.IsNil() && f.Elem().Kind() == reflect.Struct:
+			ies = appendIEs(ies, f.Elem())
+		}
+	}
+	return ies
+}
+
+// Find returns all the IEs in the message that match the query, searching into
+// the child IEs of the grouped IEs. See ie.Query for the syntax of the query.
+//
+//	matches, err := message.Find(m, "CreatePDR[*]/PDI/FTEID")
+//
+// The first value of Index in each Match is the position in TopLevelIEs(m).
+func Find(m Message, query string) ([]*ie.Match, error) {
+	return ie.Find(TopLevelIEs(m), query)
+}
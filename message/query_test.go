@@ -0,0 +1,60 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFind(t *testing.T) {
+	m := newBenchSessionEstablishmentRequest(2)
+
+	matches, err := message.Find(m, "CreatePDR[*]/PDI/FTEID")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	for _, x := range matches {
+		paths = append(paths, x.Path)
+		f, err := x.IE.FTEID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.TEID == 0 {
+			t.Errorf("unexpected TEID in %s", x.Path)
+		}
+	}
+	if diff := cmp.Diff(paths, []string{"CreatePDR[0]/PDI/FTEID", "CreatePDR[1]/PDI/FTEID"}); diff != "" {
+		t.Error(diff)
+	}
+
+	if got, want := matches[1].Index[0], 3; got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestTopLevelIEs(t *testing.T) {
+	m := &vendorSessionReportRequest{
+		SessionReportRequest: *message.NewSessionReportRequest(
+			mp, fo, seid, seq, pri,
+			ie.NewReportType(0, 0, 0, 0, 1),
+			ie.NewPFCPSRReqFlags(0x01),
+		),
+		VendorIE: ie.NewVendorSpecificIE(0x8001, 0x1234, []byte{0xde, 0xad}),
+	}
+
+	var got []uint16
+	for _, i := range message.TopLevelIEs(m) {
+		got = append(got, i.Type)
+	}
+	if diff := cmp.Diff(got, []uint16{ie.ReportType, ie.PFCPSRReqFlags, 0x8001}); diff != "" {
+		t.Error(diff)
+	}
+}
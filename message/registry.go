@@ -0,0 +1,53 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import "sync"
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[uint8]func() Message{}
+)
+
+// Register registers a function that returns a new Message to be used by Parse()
+// to decode the message of the given type.
+//
+// This is useful when you want to handle the message types that are not supported
+// in this package (e.g., the ones in the private range), or when you want to replace
+// the built-in decoder with your own (e.g., vendor-extended SessionReportRequest).
+// The registered function takes precedence over the built-in types.
+//
+// Passing nil as fn removes the registration and Parse() falls back to the default
+// behavior for the type.
+func Register(msgType uint8, fn func() Message) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if fn == nil {
+		delete(registry, msgType)
+		return
+	}
+	registry[msgType] = fn
+}
+
+// IsRegistered reports whether a function is registered for the given message type
+// with Register().
+//
+// This does not take the built-in message types into account.
+func IsRegistered(msgType uint8) bool {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	_, ok := registry[msgType]
+	return ok
+}
+
+func lookupRegistry(msgType uint8) (func() Message, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	fn, ok := registry[msgType]
+	return fn, ok
+}
@@ -0,0 +1,116 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+)
+
+type privateMessage struct {
+	message.Generic
+}
+
+func (m *privateMessage) MessageTypeName() string {
+	return "Private Message"
+}
+
+type vendorSessionReportRequest struct {
+	message.SessionReportRequest
+	VendorIE *ie.IE
+}
+
+func (m *vendorSessionReportRequest) UnmarshalBinary(b []byte) error {
+	if err := m.SessionReportRequest.UnmarshalBinary(b); err != nil {
+		return err
+	}
+
+	ies := m.IEs[:0]
+	for _, i := range m.IEs {
+		if i.IsVendorSpecific() && i.EnterpriseID == 0x1234 {
+			m.VendorIE = i
+			continue
+		}
+		ies = append(ies, i)
+	}
+	m.IEs = ies
+	return nil
+}
+
+func TestRegister(t *testing.T) {
+	const msgTypePrivate uint8 = 200
+
+	t.Run("PrivateRange", func(t *testing.T) {
+		b, err := message.NewGenericWithoutSEID(msgTypePrivate, seq, ie.NewApplicationID("go-pfcp")).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, ok := mustParse(t, b).(*message.Generic); !ok {
+			t.Fatal("unregistered type should be parsed as *Generic")
+		}
+
+		message.Register(msgTypePrivate, func() message.Message { return &privateMessage{} })
+		defer message.Register(msgTypePrivate, nil)
+
+		if !message.IsRegistered(msgTypePrivate) {
+			t.Fatal("type should be registered")
+		}
+
+		m, ok := mustParse(t, b).(*privateMessage)
+		if !ok {
+			t.Fatal("registered type should be parsed as *privateMessage")
+		}
+		if got, want := m.MessageTypeName(), "Private Message"; got != want {
+			t.Errorf("got %v want %v", got, want)
+		}
+		if got, want := len(m.IEs), 1; got != want {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("Override", func(t *testing.T) {
+		b, err := message.NewSessionReportRequest(
+			mp, fo, seid, seq, pri,
+			ie.NewReportType(0, 0, 0, 0, 1),
+			ie.NewVendorSpecificIE(0x8001, 0x1234, []byte{0xde, 0xad}),
+		).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		message.Register(message.MsgTypeSessionReportRequest, func() message.Message {
+			return &vendorSessionReportRequest{}
+		})
+
+		m, ok := mustParse(t, b).(*vendorSessionReportRequest)
+		if !ok {
+			t.Fatal("overridden type should be parsed as *vendorSessionReportRequest")
+		}
+		if m.VendorIE == nil || m.ReportType == nil || len(m.IEs) != 0 {
+			t.Errorf("unexpected decoded message: %+v", m)
+		}
+
+		message.Register(message.MsgTypeSessionReportRequest, nil)
+		if message.IsRegistered(message.MsgTypeSessionReportRequest) {
+			t.Fatal("type should be unregistered")
+		}
+		if _, ok := mustParse(t, b).(*message.SessionReportRequest); !ok {
+			t.Fatal("unregistered type should fall back to the built-in one")
+		}
+	})
+}
+
+func mustParse(t *testing.T, b []byte) message.Message {
+	t.Helper()
+
+	m, err := message.Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
@@ -0,0 +1,342 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"errors"
+	"net"
+	"time"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+var allowedInSessionEstablishmentRequest = typeSet(
+	ie.NodeID, ie.FSEID, ie.CreatePDR, ie.CreateFAR, ie.CreateURR, ie.CreateQER, ie.CreateBAR,
+	ie.CreateTrafficEndpoint, ie.PDNType, ie.FQCSID, ie.UserPlaneInactivityTimer, ie.UserID,
+	ie.TraceInformation, ie.APNDNN, ie.CreateMAR, ie.PFCPSEReqFlags, ie.CreateBridgeInfoForTSC,
+	ie.CreateSRR, ie.ProvideATSSSControlInformation, ie.RecoveryTimeStamp, ie.SNSSAI,
+	ie.ProvideRDSConfigurationInformation,
+)
+
+var repeatableInSessionEstablishmentRequest = typeSet(
+	ie.CreatePDR, ie.CreateFAR, ie.CreateURR, ie.CreateQER, ie.CreateTrafficEndpoint, ie.FQCSID,
+	ie.CreateMAR, ie.CreateSRR,
+)
+
+// SessionEstablishmentBuilder builds a SessionEstablishmentRequest step by step.
+//
+// The builders of the rules only have the methods for the IEs allowed in them, and
+// the IEs given with Add are checked against the allowed ones. The rule IDs are
+// assigned automatically in order, unless they are set explicitly.
+//
+//	req, err := message.BuildSessionEstablishment(seq).
+//		NodeID("", "", "smf.example").
+//		CPFSEID(seid, net.ParseIP("127.0.0.1"), nil).
+//		AddFAR(func(f *message.FARBuilder) {
+//			farID = f.ID()
+//			f.ApplyAction(ie.ApplyActionFORW)
+//		}).
+//		AddPDR(func(p *message.PDRBuilder) {
+//			p.Precedence(100).FARID(farID).PDI(func(pdi *message.PDIBuilder) {
+//				pdi.SourceInterface(ie.SrcInterfaceAccess)
+//			})
+//		}).
+//		Build()
+type SessionEstablishmentBuilder struct {
+	groupBuilder
+	seq       uint32
+	seid      uint64
+	mp, pri   uint8
+	pdrs      []*PDRBuilder
+	fars      []*FARBuilder
+	urrs      []*URRBuilder
+	qers      []*QERBuilder
+	bar       *BARBuilder
+	usedPDRID map[uint16]bool
+	usedFARID map[uint32]bool
+	usedURRID map[uint32]bool
+	usedQERID map[uint32]bool
+	errs      []error
+}
+
+// BuildSessionEstablishment returns a new SessionEstablishmentBuilder.
+func BuildSessionEstablishment(seq uint32) *SessionEstablishmentBuilder {
+	return &SessionEstablishmentBuilder{
+		groupBuilder: groupBuilder{allowed: allowedInSessionEstablishmentRequest, repeatable: repeatableInSessionEstablishmentRequest},
+		seq:          seq,
+		usedPDRID:    map[uint16]bool{},
+		usedFARID:    map[uint32]bool{},
+		usedURRID:    map[uint32]bool{},
+		usedQERID:    map[uint32]bool{},
+	}
+}
+
+// SEID sets the SEID in the header, which is 0 by default.
+func (b *SessionEstablishmentBuilder) SEID(seid uint64) *SessionEstablishmentBuilder {
+	b.seid = seid
+	return b
+}
+
+// MessagePriority sets the MessagePriority in the header with the MP flag.
+func (b *SessionEstablishmentBuilder) MessagePriority(pri uint8) *SessionEstablishmentBuilder {
+	b.mp, b.pri = 1, pri
+	return b
+}
+
+// NodeID sets the NodeID.
+func (b *SessionEstablishmentBuilder) NodeID(ipv4, ipv6, fqdn string) *SessionEstablishmentBuilder {
+	b.set(ie.NewNodeID(ipv4, ipv6, fqdn))
+	return b
+}
+
+// CPFSEID sets the CP F-SEID.
+func (b *SessionEstablishmentBuilder) CPFSEID(seid uint64, v4, v6 net.IP) *SessionEstablishmentBuilder {
+	b.set(ie.NewFSEID(seid, v4, v6))
+	return b
+}
+
+// PDNType sets the PDNType.
+func (b *SessionEstablishmentBuilder) PDNType(typ ie.PDNTypeValue) *SessionEstablishmentBuilder {
+	b.set(ie.NewPDNType(typ))
+	return b
+}
+
+// UserPlaneInactivityTimer sets the UserPlaneInactivityTimer.
+func (b *SessionEstablishmentBuilder) UserPlaneInactivityTimer(period time.Duration) *SessionEstablishmentBuilder {
+	b.set(ie.NewUserPlaneInactivityTimer(period))
+	return b
+}
+
+// APNDNN sets the APNDNN.
+func (b *SessionEstablishmentBuilder) APNDNN(apn string) *SessionEstablishmentBuilder {
+	b.set(ie.NewAPNDNN(apn))
+	return b
+}
+
+// AddPDR adds a CreatePDR built by fn. The PDR ID is assigned automatically
+// before fn is called, and can be overridden in fn.
+func (b *SessionEstablishmentBuilder) AddPDR(fn func(*PDRBuilder)) *SessionEstablishmentBuilder {
+	id := uint16(1)
+	for b.usedPDRID[id] {
+		id++
+	}
+
+	p := &PDRBuilder{groupBuilder: groupBuilder{allowed: allowedInCreatePDR, repeatable: repeatableInCreatePDR}}
+	p.PDRID(id)
+	if fn != nil {
+		fn(p)
+	}
+
+	b.usedPDRID[p.id] = true
+	b.pdrs = append(b.pdrs, p)
+	return b
+}
+
+// AddFAR adds a CreateFAR built by fn. The FAR ID is assigned automatically
+// before fn is called, and can be overridden in fn.
+func (b *SessionEstablishmentBuilder) AddFAR(fn func(*FARBuilder)) *SessionEstablishmentBuilder {
+	id := uint32(1)
+	for b.usedFARID[id] {
+		id++
+	}
+
+	f := &FARBuilder{groupBuilder: groupBuilder{allowed: allowedInCreateFAR, repeatable: repeatableInCreateFAR}}
+	f.FARID(id)
+	if fn != nil {
+		fn(f)
+	}
+
+	b.usedFARID[f.id] = true
+	b.fars = append(b.fars, f)
+	return b
+}
+
+// AddURR adds a CreateURR built by fn. The URR ID is assigned automatically
+// before fn is called, and can be overridden in fn.
+func (b *SessionEstablishmentBuilder) AddURR(fn func(*URRBuilder)) *SessionEstablishmentBuilder {
+	id := uint32(1)
+	for b.usedURRID[id] {
+		id++
+	}
+
+	u := &URRBuilder{groupBuilder: groupBuilder{allowed: allowedInCreateURR, repeatable: repeatableInCreateURR}}
+	u.URRID(id)
+	if fn != nil {
+		fn(u)
+	}
+
+	b.usedURRID[u.id] = true
+	b.urrs = append(b.urrs, u)
+	return b
+}
+
+// AddQER adds a CreateQER built by fn. The QER ID is assigned automatically
+// before fn is called, and can be overridden in fn.
+func (b *SessionEstablishmentBuilder) AddQER(fn func(*QERBuilder)) *SessionEstablishmentBuilder {
+	id := uint32(1)
+	for b.usedQERID[id] {
+		id++
+	}
+
+	q := &QERBuilder{groupBuilder: groupBuilder{allowed: allowedInCreateQER}}
+	q.QERID(id)
+	if fn != nil {
+		fn(q)
+	}
+
+	b.usedQERID[q.id] = true
+	b.qers = append(b.qers, q)
+	return b
+}
+
+// BAR sets the CreateBAR built by fn. The BAR ID is 1 unless overridden in fn.
+func (b *SessionEstablishmentBuilder) BAR(fn func(*BARBuilder)) *SessionEstablishmentBuilder {
+	b.bar = &BARBuilder{groupBuilder: groupBuilder{allowed: allowedInCreateBAR}}
+	b.bar.BARID(1)
+	if fn != nil {
+		fn(b.bar)
+	}
+	return b
+}
+
+// Add adds the IEs that have no dedicated method. The IEs not allowed in
+// SessionEstablishmentRequest are reported as errors by Build.
+//
+// CreatePDR, CreateFAR, CreateURR, CreateQER and CreateBAR given by Add are handled
+// as if their child IEs are given to AddPDR and the others, so that the rule IDs and
+// the references are validated in the same way.
+func (b *SessionEstablishmentBuilder) Add(ies ...*ie.IE) *SessionEstablishmentBuilder {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case ie.CreatePDR, ie.CreateFAR, ie.CreateURR, ie.CreateQER, ie.CreateBAR:
+		default:
+			b.add(i)
+			continue
+		}
+
+		children, err := i.ValueAsGrouped()
+		if err != nil {
+			b.errs = append(b.errs, &BuildError{Path: ie.TypeName(i.Type), Reason: err.Error()})
+			continue
+		}
+		switch i.Type {
+		case ie.CreatePDR:
+			b.AddPDR(func(p *PDRBuilder) { p.Add(children...) })
+		case ie.CreateFAR:
+			b.AddFAR(func(f *FARBuilder) { f.Add(children...) })
+		case ie.CreateURR:
+			b.AddURR(func(u *URRBuilder) { u.Add(children...) })
+		case ie.CreateQER:
+			b.AddQER(func(q *QERBuilder) { q.Add(children...) })
+		case ie.CreateBAR:
+			b.BAR(func(bar *BARBuilder) { bar.Add(children...) })
+		}
+	}
+	return b
+}
+
+// Build validates the IEs and returns the SessionEstablishmentRequest.
+//
+// The validation checks the mandatory IEs, the IEs that must not be repeated, the
+// duplicated rule IDs, and the references to the rules from the other rules (e.g.,
+// FARID in CreatePDR). All the errors found are returned together as *BuildError
+// joined with errors.Join.
+func (b *SessionEstablishmentBuilder) Build() (*SessionEstablishmentRequest, error) {
+	errs := append(b.validate("", b.ies, ie.NodeID, ie.FSEID), b.errs...)
+	if len(b.pdrs) == 0 {
+		errs = append(errs, &BuildError{Reason: "missing mandatory IE CreatePDR"})
+	}
+	if len(b.fars) == 0 {
+		errs = append(errs, &BuildError{Reason: "missing mandatory IE CreateFAR"})
+	}
+
+	ies := append([]*ie.IE{}, b.ies...)
+	farIDs, urrIDs, qerIDs := map[uint32]bool{}, map[uint32]bool{}, map[uint32]bool{}
+	pdrIDs := map[uint16]bool{}
+
+	for _, f := range b.fars {
+		i, e := f.build()
+		errs = append(errs, e...)
+		if farIDs[f.id] {
+			errs = append(errs, &BuildError{Path: rulePath("CreateFAR", ie.FARID, uint64(f.id)), Reason: "duplicated FARID"})
+		}
+		farIDs[f.id] = true
+		ies = append(ies, i)
+	}
+	for _, u := range b.urrs {
+		i, e := u.build()
+		errs = append(errs, e...)
+		if urrIDs[u.id] {
+			errs = append(errs, &BuildError{Path: rulePath("CreateURR", ie.URRID, uint64(u.id)), Reason: "duplicated URRID"})
+		}
+		urrIDs[u.id] = true
+		ies = append(ies, i)
+	}
+	for _, q := range b.qers {
+		i, e := q.build()
+		errs = append(errs, e...)
+		if qerIDs[q.id] {
+			errs = append(errs, &BuildError{Path: rulePath("CreateQER", ie.QERID, uint64(q.id)), Reason: "duplicated QERID"})
+		}
+		qerIDs[q.id] = true
+		ies = append(ies, i)
+	}
+	if b.bar != nil {
+		i, e := b.bar.build()
+		errs = append(errs, e...)
+		ies = append(ies, i)
+	}
+
+	for _, f := range b.fars {
+		for _, i := range f.ies {
+			if i.Type != ie.BARID {
+				continue
+			}
+			if id, err := i.BARID(); err == nil && (b.bar == nil || b.bar.id != id) {
+				errs = append(errs, &BuildError{Path: rulePath("CreateFAR", ie.FARID, uint64(f.id)), Reason: "reference to unknown BARID"})
+			}
+		}
+	}
+
+	for _, p := range b.pdrs {
+		i, e := p.build()
+		errs = append(errs, e...)
+		path := rulePath("CreatePDR", ie.PDRID, uint64(p.id))
+		if pdrIDs[p.id] {
+			errs = append(errs, &BuildError{Path: path, Reason: "duplicated PDRID"})
+		}
+		pdrIDs[p.id] = true
+
+		for _, x := range p.ies {
+			var known bool
+			switch x.Type {
+			case ie.FARID:
+				id, err := x.FARID()
+				known = err == nil && farIDs[id]
+			case ie.URRID:
+				id, err := x.URRID()
+				known = err == nil && urrIDs[id]
+			case ie.QERID:
+				id, err := x.QERID()
+				known = err == nil && qerIDs[id]
+			default:
+				continue
+			}
+			if !known {
+				errs = append(errs, &BuildError{Path: path, Reason: "reference to unknown " + ie.TypeName(x.Type)})
+			}
+		}
+		ies = append(ies, i)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return NewSessionEstablishmentRequest(b.mp, 0, b.seid, b.seq, b.pri, ies...), nil
+}
@@ -0,0 +1,257 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+)
+
+func TestSessionEstablishmentBuilder(t *testing.T) {
+	newBuilder := func() *message.SessionEstablishmentBuilder {
+		return message.BuildSessionEstablishment(seq).
+			NodeID("", "", "go-pfcp.epc.3gppnetwork.org").
+			CPFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil)
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		var farID, qerID uint32
+		got, err := newBuilder().
+			AddFAR(func(f *message.FARBuilder) {
+				farID = f.ID()
+				f.ApplyAction(ie.ApplyActionFORW).ForwardingParameters(func(fp *message.ForwardingParametersBuilder) {
+					fp.DestinationInterface(ie.DstInterfaceCore).NetworkInstance("internet")
+				})
+			}).
+			AddQER(func(q *message.QERBuilder) {
+				qerID = q.ID()
+				q.GateStatus(0, 0).MBR(1000, 2000)
+			}).
+			AddPDR(func(p *message.PDRBuilder) {
+				p.Precedence(100).FARID(farID).QERID(qerID).PDI(func(pdi *message.PDIBuilder) {
+					pdi.SourceInterface(ie.SrcInterfaceAccess).
+						FTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0)
+				})
+			}).
+			AddPDR(func(p *message.PDRBuilder) {
+				p.Precedence(200).FARID(farID).PDI(func(pdi *message.PDIBuilder) {
+					pdi.SourceInterface(ie.SrcInterfaceCore)
+				})
+			}).
+			Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := message.NewSessionEstablishmentRequest(0, 0, 0, seq, 0,
+			ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+			ie.NewFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil),
+			ie.NewCreatePDR(
+				ie.NewPDRID(1),
+				ie.NewPrecedence(100),
+				ie.NewFARID(1),
+				ie.NewQERID(1),
+				ie.NewPDI(
+					ie.NewSourceInterface(ie.SrcInterfaceAccess),
+					ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
+				),
+			),
+			ie.NewCreatePDR(
+				ie.NewPDRID(2),
+				ie.NewPrecedence(200),
+				ie.NewFARID(1),
+				ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceCore)),
+			),
+			ie.NewCreateFAR(
+				ie.NewFARID(1),
+				ie.NewApplyAction(0x02),
+				ie.NewForwardingParameters(
+					ie.NewDestinationInterface(ie.DstInterfaceCore),
+					ie.NewNetworkInstance("internet"),
+				),
+			),
+			ie.NewCreateQER(
+				ie.NewQERID(1),
+				ie.NewGateStatus(0, 0),
+				ie.NewMBR(1000, 2000),
+			),
+		)
+		for _, c := range message.Diff(got, want) {
+			t.Error(c)
+		}
+	})
+
+	t.Run("AddPDI", func(t *testing.T) {
+		_, err := newBuilder().
+			AddFAR(func(f *message.FARBuilder) {
+				f.ApplyAction(ie.ApplyActionFORW).Add(ie.NewForwardingParameters(ie.NewDestinationInterface(ie.DstInterfaceCore)))
+			}).
+			AddPDR(func(p *message.PDRBuilder) {
+				p.Precedence(100).FARID(1).Add(ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceAccess)))
+			}).
+			Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("PDRIDByAdd", func(t *testing.T) {
+		var id uint16
+		_, err := newBuilder().
+			AddFAR(func(f *message.FARBuilder) { f.ApplyAction(ie.ApplyActionFORW) }).
+			AddPDR(func(p *message.PDRBuilder) {
+				p.Add(ie.NewPDRID(7)).Precedence(100).FARID(1).PDI(func(pdi *message.PDIBuilder) {
+					pdi.SourceInterface(ie.SrcInterfaceAccess)
+				})
+				id = p.ID()
+			}).
+			Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != 7 {
+			t.Errorf("got PDRID %d, want 7", id)
+		}
+	})
+
+	cases := []struct {
+		description string
+		builder     *message.SessionEstablishmentBuilder
+		errs        []string
+	}{
+		{
+			"MissingMandatory",
+			message.BuildSessionEstablishment(seq).
+				AddFAR(func(f *message.FARBuilder) { f.ApplyAction(ie.ApplyActionFORW) }).
+				AddPDR(func(p *message.PDRBuilder) { p.Precedence(100).PDI(nil) }),
+			[]string{
+				"missing mandatory IE NodeID",
+				"missing mandatory IE FSEID",
+				"CreatePDR[PDRID=1]/PDI: missing mandatory IE SourceInterface",
+			},
+		}, {
+			"NotAllowed",
+			newBuilder().
+				AddFAR(func(f *message.FARBuilder) { f.ApplyAction(ie.ApplyActionFORW).Add(ie.NewPDRID(1)) }).
+				AddPDR(func(p *message.PDRBuilder) {
+					p.Precedence(100).PDI(func(pdi *message.PDIBuilder) {
+						pdi.SourceInterface(ie.SrcInterfaceAccess).Add(ie.NewFARID(1))
+					})
+				}),
+			[]string{
+				"CreateFAR[FARID=1]: PDRID is not allowed",
+				"CreatePDR[PDRID=1]/PDI: FARID is not allowed",
+			},
+		}, {
+			"DanglingAndDuplicated",
+			newBuilder().
+				AddFAR(func(f *message.FARBuilder) { f.ApplyAction(ie.ApplyActionFORW) }).
+				AddFAR(func(f *message.FARBuilder) { f.FARID(1).ApplyAction(0x02) }).
+				AddPDR(func(p *message.PDRBuilder) {
+					p.Precedence(100).FARID(5).PDI(func(pdi *message.PDIBuilder) {
+						pdi.SourceInterface(ie.SrcInterfaceAccess)
+					})
+				}),
+			[]string{
+				"CreateFAR[FARID=1]: duplicated FARID",
+				"CreatePDR[PDRID=1]: reference to unknown FARID",
+			},
+		}, {
+			"DuplicatedByAdd",
+			newBuilder().
+				AddFAR(func(f *message.FARBuilder) {
+					f.ApplyAction(ie.ApplyActionFORW).
+						Add(ie.NewForwardingParameters(ie.NewDestinationInterface(ie.DstInterfaceCore))).
+						ForwardingParameters(func(fp *message.ForwardingParametersBuilder) {
+							fp.DestinationInterface(ie.DstInterfaceAccess)
+						})
+				}).
+				AddPDR(func(p *message.PDRBuilder) {
+					p.Precedence(100).Add(ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceCore))).
+						PDI(func(pdi *message.PDIBuilder) { pdi.SourceInterface(ie.SrcInterfaceAccess) })
+				}),
+			[]string{
+				"CreateFAR[FARID=1]: duplicated ForwardingParameters",
+				"CreatePDR[PDRID=1]: duplicated PDI",
+			},
+		}, {
+			"DuplicatedSingleton",
+			newBuilder().
+				AddFAR(func(f *message.FARBuilder) { f.ApplyAction(ie.ApplyActionFORW) }).
+				AddPDR(func(p *message.PDRBuilder) {
+					p.Precedence(100).FARID(1).Add(ie.NewPrecedence(1)).PDI(func(pdi *message.PDIBuilder) {
+						pdi.SourceInterface(ie.SrcInterfaceAccess).Add(ie.NewSourceInterface(ie.SrcInterfaceCore))
+					})
+				}).
+				Add(ie.NewNodeID("", "", "smf2.example")),
+			[]string{
+				"failed to build message: duplicated NodeID",
+				"CreatePDR[PDRID=1]: duplicated Precedence",
+				"CreatePDR[PDRID=1]/PDI: duplicated SourceInterface",
+			},
+		}, {
+			"GroupedByAdd",
+			newBuilder().
+				AddFAR(func(f *message.FARBuilder) {
+					f.ApplyAction(ie.ApplyActionFORW).Add(ie.NewForwardingParameters(ie.NewPDRID(1)))
+				}).
+				AddPDR(func(p *message.PDRBuilder) {
+					p.Precedence(100).FARID(1).Add(ie.NewPDI(ie.NewFARID(1)))
+				}),
+			[]string{
+				"CreateFAR[FARID=1]/ForwardingParameters: PDRID is not allowed",
+				"CreateFAR[FARID=1]/ForwardingParameters: missing mandatory IE DestinationInterface",
+				"CreatePDR[PDRID=1]/PDI: FARID is not allowed",
+				"CreatePDR[PDRID=1]/PDI: missing mandatory IE SourceInterface",
+			},
+		}, {
+			"RulesByAdd",
+			newBuilder().
+				AddFAR(func(f *message.FARBuilder) { f.ApplyAction(ie.ApplyActionFORW) }).
+				AddPDR(func(p *message.PDRBuilder) {
+					p.Precedence(100).FARID(1).PDI(func(pdi *message.PDIBuilder) {
+						pdi.SourceInterface(ie.SrcInterfaceAccess)
+					})
+				}).
+				Add(
+					ie.NewCreatePDR(
+						ie.NewPDRID(1), ie.NewPrecedence(200), ie.NewFARID(9),
+						ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceCore)),
+					),
+					ie.NewCreateFAR(ie.NewFARID(2), ie.NewBARID(3)),
+				),
+			[]string{
+				"CreatePDR[PDRID=1]: duplicated PDRID",
+				"CreatePDR[PDRID=1]: reference to unknown FARID",
+				"CreateFAR[FARID=2]: missing mandatory IE ApplyAction",
+				"CreateFAR[FARID=2]: reference to unknown BARID",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			_, err := c.builder.Build()
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			var be *message.BuildError
+			if !errors.As(err, &be) {
+				t.Errorf("error should be *BuildError: %v", err)
+			}
+			for _, want := range c.errs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error should contain %q: %v", want, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+// SessionSetModificationRequest is a SessionSetModificationRequest formed PFCP Header and its IEs above.
+type SessionSetModificationRequest struct {
+	*Header
+	AlternativeSMFIPAddress *ie.IE
+	FQCSID                  []*ie.IE
+	GroupID                 []*ie.IE
+	CPIPAddress             []*ie.IE
+	IEs                     []*ie.IE
+}
+
+// NewSessionSetModificationRequest creates a new SessionSetModificationRequest.
+func NewSessionSetModificationRequest(seq uint32, ies ...*ie.IE) *SessionSetModificationRequest {
+	m := &SessionSetModificationRequest{
+		Header: NewHeader(
+			1, 0, 0, 0,
+			MsgTypeSessionSetModificationRequest, 0, seq, 0,
+			nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.AlternativeSMFIPAddress:
+			m.AlternativeSMFIPAddress = i
+		case ie.FQCSID:
+			m.FQCSID = append(m.FQCSID, i)
+		case ie.GroupID:
+			m.GroupID = append(m.GroupID, i)
+		case ie.CPIPAddress:
+			m.CPIPAddress = append(m.CPIPAddress, i)
+		default:
+			m.IEs = append(m.IEs, i)
+		}
+	}
+
+	m.SetLength()
+	return m
+}
+
+// Marshal returns the byte sequence generated from a SessionSetModificationRequest.
+func (m *SessionSetModificationRequest) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// AppendBinary appends the byte sequence generated from a SessionSetModificationRequest to b
+// and returns the extended buffer.
+func (m *SessionSetModificationRequest) AppendBinary(b []byte) ([]byte, error) {
+	return appendBinary(b, m)
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (m *SessionSetModificationRequest) MarshalTo(b []byte) error {
+	offset, err := m.Header.marshalFixedTo(b, m.MarshalLen())
+	if err != nil {
+		return err
+	}
+
+	if i := m.AlternativeSMFIPAddress; i != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
+			return err
+		}
+		offset += n
+	}
+
+	for _, i := range m.FQCSID {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
+			return err
+		}
+		offset += n
+	}
+
+	for _, i := range m.GroupID {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
+			return err
+		}
+		offset += n
+	}
+
+	for _, i := range m.CPIPAddress {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
+			return err
+		}
+		offset += n
+	}
+
+	for _, ie := range m.IEs {
+		if ie == nil {
+			continue
+		}
+		n, err := ie.MarshalToN(b[offset:])
+		if err != nil {
+			return err
+		}
+		offset += n
+	}
+
+	return nil
+}
+
+// ParseSessionSetModificationRequest decodes a given byte sequence as a SessionSetModificationRequest.
+func ParseSessionSetModificationRequest(b []byte) (*SessionSetModificationRequest, error) {
+	m := &SessionSetModificationRequest{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a SessionSetModificationRequest.
+func (m *SessionSetModificationRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return newDecodeError(m.Header, err)
+	}
+
+	for _, i := range ies {
+		switch i.Type {
+		case ie.AlternativeSMFIPAddress:
+			m.AlternativeSMFIPAddress = i
+		case ie.FQCSID:
+			m.FQCSID = append(m.FQCSID, i)
+		case ie.GroupID:
+			m.GroupID = append(m.GroupID, i)
+		case ie.CPIPAddress:
+			m.CPIPAddress = append(m.CPIPAddress, i)
+		default:
+			m.IEs = append(m.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (m *SessionSetModificationRequest) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+
+	if i := m.AlternativeSMFIPAddress; i != nil {
+		l += i.MarshalLen()
+	}
+	for _, i := range m.FQCSID {
+		l += i.MarshalLen()
+	}
+	for _, i := range m.GroupID {
+		l += i.MarshalLen()
+	}
+	for _, i := range m.CPIPAddress {
+		l += i.MarshalLen()
+	}
+
+	for _, ie := range m.IEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *SessionSetModificationRequest) SetLength() {
+	m.Header.Length = uint16(m.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *SessionSetModificationRequest) MessageTypeName() string {
+	return "Session Set Modification Request"
+}
+
+// SEID returns the SEID in uint64.
+func (m *SessionSetModificationRequest) SEID() uint64 {
+	return m.Header.seid()
+}
+
+// Clone returns a deep copy of the SessionSetModificationRequest.
+//
+// The returned message does not share any memory with the original, so it is
+// safe to use even after the buffer given to Parse is reused.
+func (m *SessionSetModificationRequest) Clone() *SessionSetModificationRequest {
+	if m == nil {
+		return nil
+	}
+
+	return &SessionSetModificationRequest{
+		Header:                  m.Header.Clone(),
+		AlternativeSMFIPAddress: m.AlternativeSMFIPAddress.Clone(),
+		FQCSID:                  cloneIEs(m.FQCSID),
+		GroupID:                 cloneIEs(m.GroupID),
+		CPIPAddress:             cloneIEs(m.CPIPAddress),
+		IEs:                     cloneIEs(m.IEs),
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+// SessionSetModificationResponse is a SessionSetModificationResponse formed PFCP Header and its IEs above.
+type SessionSetModificationResponse struct {
+	*Header
+	NodeID      *ie.IE
+	Cause       *ie.IE
+	OffendingIE *ie.IE
+	IEs         []*ie.IE
+}
+
+// NewSessionSetModificationResponse creates a new SessionSetModificationResponse.
+func NewSessionSetModificationResponse(seq uint32, id, cause, offending *ie.IE, ies ...*ie.IE) *SessionSetModificationResponse {
+	m := &SessionSetModificationResponse{
+		Header: NewHeader(
+			1, 0, 0, 0,
+			MsgTypeSessionSetModificationResponse, 0, seq, 0,
+			nil,
+		),
+		NodeID:      id,
+		Cause:       cause,
+		OffendingIE: offending,
+		IEs:         ies,
+	}
+	m.SetLength()
+
+	return m
+}
+
+// Marshal returns the byte sequence generated from a SessionSetModificationResponse.
+func (m *SessionSetModificationResponse) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// AppendBinary appends the byte sequence generated from a SessionSetModificationResponse to b
+// and returns the extended buffer.
+func (m *SessionSetModificationResponse) AppendBinary(b []byte) ([]byte, error) {
+	return appendBinary(b, m)
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (m *SessionSetModificationResponse) MarshalTo(b []byte) error {
+	offset, err := m.Header.marshalFixedTo(b, m.MarshalLen())
+	if err != nil {
+		return err
+	}
+
+	if i := m.NodeID; i != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
+			return err
+		}
+		offset += n
+	}
+	if i := m.Cause; i != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
+			return err
+		}
+		offset += n
+	}
+	if i := m.OffendingIE; i != nil {
+		n, err := i.MarshalToN(b[offset:])
+		if err != nil {
+			return err
+		}
+		offset += n
+	}
+
+	for _, ie := range m.IEs {
+		if ie == nil {
+			continue
+		}
+		n, err := ie.MarshalToN(b[offset:])
+		if err != nil {
+			return err
+		}
+		offset += n
+	}
+
+	return nil
+}
+
+// ParseSessionSetModificationResponse decodes a given byte sequence as a SessionSetModificationResponse.
+func ParseSessionSetModificationResponse(b []byte) (*SessionSetModificationResponse, error) {
+	m := &SessionSetModificationResponse{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a SessionSetModificationResponse.
+func (m *SessionSetModificationResponse) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return newDecodeError(m.Header, err)
+	}
+
+	for _, i := range ies {
+		switch i.Type {
+		case ie.NodeID:
+			m.NodeID = i
+		case ie.Cause:
+			m.Cause = i
+		case ie.OffendingIE:
+			m.OffendingIE = i
+		default:
+			m.IEs = append(m.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (m *SessionSetModificationResponse) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+
+	if i := m.NodeID; i != nil {
+		l += i.MarshalLen()
+	}
+	if i := m.Cause; i != nil {
+		l += i.MarshalLen()
+	}
+	if i := m.OffendingIE; i != nil {
+		l += i.MarshalLen()
+	}
+
+	for _, ie := range m.IEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *SessionSetModificationResponse) SetLength() {
+	m.Header.Length = uint16(m.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *SessionSetModificationResponse) MessageTypeName() string {
+	return "Session Set Modification Response"
+}
+
+// SEID returns the SEID in uint64.
+func (m *SessionSetModificationResponse) SEID() uint64 {
+	return m.Header.seid()
+}
+
+// Clone returns a deep copy of the SessionSetModificationResponse.
+//
+// The returned message does not share any memory with the original, so it is
+// safe to use even after the buffer given to Parse is reused.
+func (m *SessionSetModificationResponse) Clone() *SessionSetModificationResponse {
+	if m == nil {
+		return nil
+	}
+
+	return &SessionSetModificationResponse{
+		Header:      m.Header.Clone(),
+		NodeID:      m.NodeID.Clone(),
+		Cause:       m.Cause.Clone(),
+		OffendingIE: m.OffendingIE.Clone(),
+		IEs:         cloneIEs(m.IEs),
+	}
+}
@@ -39,26 +39,31 @@ func (m *VersionNotSupportedResponse) Marshal() ([]byte, error) {
 	return b, nil
 }
 
+// AppendBinary appends the byte sequence generated from a VersionNotSupportedResponse to b
+// and returns the extended buffer.
+func (m *VersionNotSupportedResponse) AppendBinary(b []byte) ([]byte, error) {
+	return appendBinary(b, m)
+}
+
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *VersionNotSupportedResponse) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	offset, err := m.Header.marshalFixedTo(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
-	offset := 0
 	for _, ie := range m.IEs {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		n, err := ie.MarshalToN(b[offset:])
+		if err != nil {
 			return err
 		}
-		offset += ie.MarshalLen()
+		offset += n
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return nil
 }
 
 // ParseVersionNotSupportedResponse decodes a given byte sequence as a VersionNotSupportedResponse.
@@ -83,7 +88,7 @@ func (m *VersionNotSupportedResponse) UnmarshalBinary(b []byte) error {
 
 	ies, err := ie.ParseMultiIEs(m.Header.Payload)
 	if err != nil {
-		return err
+		return newDecodeError(m.Header, err)
 	}
 
 	m.IEs = append(m.IEs, ies...)
@@ -119,3 +124,18 @@ func (m *VersionNotSupportedResponse) MessageTypeName() string {
 func (m *VersionNotSupportedResponse) SEID() uint64 {
 	return m.Header.seid()
 }
+
+// Clone returns a deep copy of the VersionNotSupportedResponse.
+//
+// The returned message does not share any memory with the original, so it is
+// safe to use even after the buffer given to Parse is reused.
+func (m *VersionNotSupportedResponse) Clone() *VersionNotSupportedResponse {
+	if m == nil {
+		return nil
+	}
+
+	return &VersionNotSupportedResponse{
+		Header: m.Header.Clone(),
+		IEs:    cloneIEs(m.IEs),
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"math"
+	"time"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+)
+
+// BufferedPacket is a downlink packet buffered by Buffering.
+type BufferedPacket struct {
+	PDRID   uint32
+	FARID   uint32
+	Data    []byte
+	Arrival time.Time
+}
+
+// Buffering buffers the downlink packets of the FARs with the BUFF apply action
+// and notifies the CP function of them, as the UP function does following the
+// clause 5.2.4.1 of TS 29.244.
+//
+// The packets are buffered up to the SuggestedBufferingPacketsCount of the BAR
+// referred to by the FAR, or without limit if the FAR has no BAR or the BAR does
+// not have it. If the FAR has the NOCP apply action, the DownlinkDataReport is
+// generated for the first packet buffered, after the DownlinkDataNotificationDelay
+// of the BAR if it is given. No more reports are generated for the FAR until the
+// FAR is updated.
+//
+// The DLBufferingDuration and DLBufferingSuggestedPacketCount given in
+// SessionReportResponse start the extended buffering, in which no reports are
+// generated and the buffered packets are discarded when the duration expires.
+//
+// Buffering is not safe for concurrent use.
+type Buffering struct {
+	s       *Session
+	packets []*BufferedPacket
+	fars    map[uint32]*farBuffering
+	bars    map[uint32]*barBuffering
+}
+
+// farBuffering is the state of the notification for a FAR.
+type farBuffering struct {
+	far      *ie.IE
+	notified bool
+	pdrID    uint32
+	notifyAt time.Time
+}
+
+// barBuffering is the extended buffering requested for a BAR. until is zero
+// if the duration is infinite.
+type barBuffering struct {
+	until time.Time
+	limit uint16
+}
+
+// NewBuffering returns a Buffering for the FARs in s.
+func NewBuffering(s *Session) *Buffering {
+	b := &Buffering{
+		fars: map[uint32]*farBuffering{},
+		bars: map[uint32]*barBuffering{},
+	}
+	b.Update(s)
+	return b
+}
+
+// Update makes the Buffering follow the FARs and BARs in s, and returns the
+// packets to be forwarded. The packets of the FARs updated to have FORW without
+// BUFF are released in the order of arrival, and the ones of the FARs updated to
+// have neither of them or removed are discarded. The FARs updated are notified
+// again for the next packet buffered.
+func (b *Buffering) Update(s *Session) []*BufferedPacket {
+	b.s = s
+
+	// the FARs that stop buffering, and whether their packets are forwarded.
+	stopped := map[uint32]bool{}
+	for _, id := range sortedIDs(b.fars) {
+		fb := b.fars[id]
+		far := s.FARs[id]
+		if far == fb.far {
+			continue
+		}
+
+		action := applyActionOf(far)
+		if far == nil || !action.Has(ie.ApplyActionBUFF) {
+			stopped[id] = action.Has(ie.ApplyActionFORW)
+		}
+
+		if far == nil {
+			delete(b.fars, id)
+			continue
+		}
+		b.fars[id] = &farBuffering{far: far}
+	}
+
+	// a single pass keeps the order of arrival across the FARs.
+	var taken, released []*BufferedPacket
+	taken, b.packets = b.take(func(p *BufferedPacket) bool {
+		_, ok := stopped[p.FARID]
+		return ok
+	})
+	for _, p := range taken {
+		if stopped[p.FARID] {
+			released = append(released, p)
+		}
+	}
+
+	for id := range b.bars {
+		if s.BARs[id] == nil {
+			delete(b.bars, id)
+		}
+	}
+	return released
+}
+
+// Buffer buffers data of a downlink packet matched by the PDR at now, if the FAR
+// of the PDR has the BUFF apply action. The first value is false if the packet
+// is not buffered because the FAR does not buffer it or the buffer is full.
+//
+// The DownlinkDataReport IEs to be sent in SessionReportRequest with the DLDR
+// flag in ReportType are returned if any.
+func (b *Buffering) Buffer(pdr *ie.IE, data []byte, now time.Time) (bool, []*ie.IE) {
+	pdrID, _ := RuleID(RulePDR, pdr)
+	farIDs, _ := refsOf(pdr, ie.FARID)
+	if len(farIDs) == 0 {
+		return false, nil
+	}
+	farID := farIDs[0]
+
+	far := b.s.FARs[farID]
+	action := applyActionOf(far)
+	if !action.Has(ie.ApplyActionBUFF) {
+		return false, nil
+	}
+
+	fb := b.fars[farID]
+	if fb == nil || fb.far != far {
+		fb = &farBuffering{far: far}
+		b.fars[farID] = fb
+	}
+
+	b.expire(now)
+	var (
+		bar *ie.IE
+		ext *barBuffering
+	)
+	barID, hasBAR := barIDOf(far)
+	if hasBAR {
+		bar, ext = b.s.BARs[barID], b.bars[barID]
+	}
+
+	buffered := false
+	if limit, ok := b.limit(bar, ext); !ok || b.count(barID, hasBAR) < limit {
+		b.packets = append(b.packets, &BufferedPacket{PDRID: pdrID, FARID: farID, Data: data, Arrival: now})
+		buffered = true
+	}
+
+	if !action.Has(ie.ApplyActionNOCP) || fb.notified || ext != nil {
+		return buffered, nil
+	}
+	fb.notified, fb.pdrID = true, pdrID
+
+	var delay time.Duration
+	if bar != nil {
+		delay, _ = bar.DownlinkDataNotificationDelay()
+	}
+	if delay > 0 {
+		fb.notifyAt = now.Add(delay)
+		return buffered, nil
+	}
+	return buffered, []*ie.IE{newDownlinkDataReport(pdrID)}
+}
+
+// Tick returns the DownlinkDataReport IEs whose DownlinkDataNotificationDelay
+// has passed at now, in the order of the FAR ID. It also discards the packets
+// buffered for the extended buffering that has expired.
+func (b *Buffering) Tick(now time.Time) []*ie.IE {
+	var reports []*ie.IE
+	for _, id := range sortedIDs(b.fars) {
+		fb := b.fars[id]
+		if fb.notifyAt.IsZero() || now.Before(fb.notifyAt) {
+			continue
+		}
+		fb.notifyAt = time.Time{}
+		reports = append(reports, newDownlinkDataReport(fb.pdrID))
+	}
+
+	b.expire(now)
+	return reports
+}
+
+// expire ends the extended buffering that has expired at now, and discards the
+// packets buffered for it.
+func (b *Buffering) expire(now time.Time) {
+	for id, ext := range b.bars {
+		if ext.until.IsZero() || now.Before(ext.until) {
+			continue
+		}
+		delete(b.bars, id)
+		_, b.packets = b.take(func(p *BufferedPacket) bool {
+			barID, ok := barIDOf(b.s.FARs[p.FARID])
+			return ok && barID == id
+		})
+	}
+}
+
+// ApplyReportResponse applies the SessionReportResponse to the DownlinkDataReport
+// at now. The DROBU flag in PFCPSRRspFlags discards all the packets buffered, and
+// UpdateBAR with DLBufferingDuration starts the extended buffering of the BAR,
+// in which the packets are buffered up to DLBufferingSuggestedPacketCount if it
+// is given.
+func (b *Buffering) ApplyReportResponse(m *message.SessionReportResponse, now time.Time) {
+	if m.PFCPSRRspFlags != nil {
+		if v, err := m.PFCPSRRspFlags.PFCPSRRspFlags(); err == nil && v&0x01 != 0 {
+			b.packets = nil
+		}
+	}
+	if m.UpdateBAR == nil {
+		return
+	}
+
+	children, err := m.UpdateBAR.ValueAsGrouped()
+	if err != nil {
+		return
+	}
+
+	var (
+		barID    uint32
+		duration time.Duration
+		ext      = &barBuffering{}
+	)
+	for _, c := range children {
+		switch c.Type {
+		case ie.BARID:
+			barID = uintOf(c.Payload)
+		case ie.DLBufferingDuration:
+			duration, _ = c.DLBufferingDuration()
+		case ie.DLBufferingSuggestedPacketCount:
+			ext.limit, _ = c.DLBufferingSuggestedPacketCount()
+		}
+	}
+	if duration <= 0 {
+		delete(b.bars, barID)
+		return
+	}
+	// the infinite duration is held as the zero time.
+	if duration != math.MaxInt64 {
+		ext.until = now.Add(duration)
+	}
+	b.bars[barID] = ext
+}
+
+// Buffered returns the packets buffered in the order of arrival.
+func (b *Buffering) Buffered() []*BufferedPacket {
+	return append([]*BufferedPacket{}, b.packets...)
+}
+
+// limit returns the number of the packets that can be buffered for the BAR. The
+// second value is false if it is not limited.
+func (b *Buffering) limit(bar *ie.IE, ext *barBuffering) (int, bool) {
+	if ext != nil && ext.limit > 0 {
+		return int(ext.limit), true
+	}
+	if bar == nil {
+		return 0, false
+	}
+	n, err := bar.SuggestedBufferingPacketsCount()
+	if err != nil {
+		return 0, false
+	}
+	return int(n), true
+}
+
+// count returns the number of the packets buffered for the BAR, or for the FARs
+// without BAR if hasBAR is false.
+func (b *Buffering) count(barID uint32, hasBAR bool) int {
+	n := 0
+	for _, p := range b.packets {
+		id, ok := barIDOf(b.s.FARs[p.FARID])
+		if ok == hasBAR && id == barID {
+			n++
+		}
+	}
+	return n
+}
+
+// take returns the packets that satisfy fn and the rest.
+func (b *Buffering) take(fn func(*BufferedPacket) bool) ([]*BufferedPacket, []*BufferedPacket) {
+	var taken, rest []*BufferedPacket
+	for _, p := range b.packets {
+		if fn(p) {
+			taken = append(taken, p)
+		} else {
+			rest = append(rest, p)
+		}
+	}
+	return taken, rest
+}
+
+func newDownlinkDataReport(pdrID uint32) *ie.IE {
+	return ie.NewDownlinkDataReport(ie.NewPDRID(uint16(pdrID)))
+}
+
+// applyActionOf returns the ApplyAction in the FAR, or 0 if it is not present.
+func applyActionOf(far *ie.IE) ie.ApplyActionFlags {
+	if far == nil {
+		return 0
+	}
+	children, err := far.ValueAsGrouped()
+	if err != nil {
+		return 0
+	}
+	for _, c := range children {
+		if c.Type == ie.ApplyAction {
+			v, _ := c.ApplyActionFlags()
+			return v
+		}
+	}
+	return 0
+}
+
+// barIDOf returns the BARID in the FAR. The second value is false if it is not
+// present.
+func barIDOf(far *ie.IE) (uint32, bool) {
+	if far == nil {
+		return 0, false
+	}
+	children, err := far.ValueAsGrouped()
+	if err != nil {
+		return 0, false
+	}
+	for _, c := range children {
+		if c.Type == ie.BARID {
+			return uintOf(c.Payload), true
+		}
+	}
+	return 0, false
+}
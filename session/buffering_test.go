@@ -0,0 +1,195 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+	"github.com/aalayanahmad/go-pfcp/session"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newBufferingSession(t *testing.T, bar ...*ie.IE) (*session.Session, *ie.IE) {
+	t.Helper()
+
+	pdr := newRulePDR(1, ie.SrcInterfaceCore, 1)
+	return mustSession(t,
+		pdr,
+		ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyActionFlags(ie.ApplyActionBUFF|ie.ApplyActionNOCP), ie.NewBARID(1)),
+		ie.NewCreateBAR(append([]*ie.IE{ie.NewBARID(1)}, bar...)...),
+	), pdr
+}
+
+func updateFAR(t *testing.T, s *session.Session, action ie.ApplyActionFlags) {
+	t.Helper()
+
+	if err := s.Apply(newModification(ie.NewUpdateFAR(ie.NewFARID(1), ie.NewApplyActionFlags(action)))); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func dataOf(packets []*session.BufferedPacket) []string {
+	var data []string
+	for _, p := range packets {
+		data = append(data, string(p.Data))
+	}
+	return data
+}
+
+func TestBuffering(t *testing.T) {
+	s, pdr := newBufferingSession(t, ie.NewSuggestedBufferingPacketsCount(2))
+	b := session.NewBuffering(s)
+	ddr := []*ie.IE{ie.NewDownlinkDataReport(ie.NewPDRID(1))}
+
+	for n, c := range []struct {
+		data        string
+		wantOK      bool
+		wantReports []*ie.IE
+	}{
+		{"p1", true, ddr},
+		{"p2", true, nil},
+		{"p3", false, nil},
+	} {
+		ok, reports := b.Buffer(pdr, []byte(c.data), t0.Add(time.Duration(n)*time.Second))
+		if ok != c.wantOK {
+			t.Errorf("%s: got: %v, want: %v", c.data, ok, c.wantOK)
+		}
+		if diff := cmp.Diff(c.wantReports, reports); diff != "" {
+			t.Errorf("%s: %s", c.data, diff)
+		}
+	}
+
+	// the packets are released when the FAR is updated to forward them.
+	updateFAR(t, s, ie.ApplyActionFORW)
+	if diff := cmp.Diff([]string{"p1", "p2"}, dataOf(b.Update(s))); diff != "" {
+		t.Error(diff)
+	}
+	if got := b.Buffered(); len(got) != 0 {
+		t.Errorf("got %d packets buffered, want 0", len(got))
+	}
+	if ok, _ := b.Buffer(pdr, []byte("p4"), t0); ok {
+		t.Error("buffered with FORW")
+	}
+
+	// the FAR buffering again notifies again.
+	updateFAR(t, s, ie.ApplyActionBUFF|ie.ApplyActionNOCP)
+	b.Update(s)
+	if _, reports := b.Buffer(pdr, []byte("p5"), t0); !cmp.Equal(ddr, reports) {
+		t.Errorf("got: %v, want: %v", reports, ddr)
+	}
+
+	// the packets are discarded when the FAR is updated to drop them.
+	updateFAR(t, s, ie.ApplyActionDROP)
+	if got := b.Update(s); len(got) != 0 {
+		t.Errorf("got %d packets released, want 0", len(got))
+	}
+	if got := b.Buffered(); len(got) != 0 {
+		t.Errorf("got %d packets buffered, want 0", len(got))
+	}
+}
+
+func TestBufferingReleaseOrder(t *testing.T) {
+	newFAR := func(id uint32) *ie.IE {
+		return ie.NewCreateFAR(ie.NewFARID(id), ie.NewApplyActionFlags(ie.ApplyActionBUFF|ie.ApplyActionNOCP))
+	}
+	pdr1, pdr2 := newRulePDR(1, ie.SrcInterfaceCore, 1), newRulePDR(2, ie.SrcInterfaceCore, 2)
+	s := mustSession(t, pdr1, pdr2, newFAR(1), newFAR(2))
+	b := session.NewBuffering(s)
+
+	b.Buffer(pdr2, []byte("p1"), t0)
+	b.Buffer(pdr1, []byte("p2"), t0.Add(time.Second))
+	b.Buffer(pdr2, []byte("p3"), t0.Add(2*time.Second))
+
+	// the packets of the FARs released together keep the order of arrival.
+	forward := ie.NewApplyActionFlags(ie.ApplyActionFORW)
+	err := s.Apply(newModification(
+		ie.NewUpdateFAR(ie.NewFARID(1), forward),
+		ie.NewUpdateFAR(ie.NewFARID(2), forward),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"p1", "p2", "p3"}, dataOf(b.Update(s))); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestBufferingNotificationDelay(t *testing.T) {
+	s, pdr := newBufferingSession(t, ie.NewDownlinkDataNotificationDelay(100*time.Millisecond))
+	b := session.NewBuffering(s)
+
+	if _, reports := b.Buffer(pdr, []byte("p1"), t0); len(reports) != 0 {
+		t.Errorf("unexpected reports: %v", reports)
+	}
+	if reports := b.Tick(t0.Add(50 * time.Millisecond)); len(reports) != 0 {
+		t.Errorf("unexpected reports: %v", reports)
+	}
+	want := []*ie.IE{ie.NewDownlinkDataReport(ie.NewPDRID(1))}
+	if diff := cmp.Diff(want, b.Tick(t0.Add(100*time.Millisecond))); diff != "" {
+		t.Error(diff)
+	}
+	if reports := b.Tick(t0.Add(200 * time.Millisecond)); len(reports) != 0 {
+		t.Errorf("unexpected reports: %v", reports)
+	}
+
+	// the report pending is cancelled by the FAR updated.
+	updateFAR(t, s, ie.ApplyActionBUFF|ie.ApplyActionNOCP)
+	b.Update(s)
+	b.Buffer(pdr, []byte("p2"), t0.Add(time.Second))
+	updateFAR(t, s, ie.ApplyActionFORW)
+	if diff := cmp.Diff([]string{"p1", "p2"}, dataOf(b.Update(s))); diff != "" {
+		t.Error(diff)
+	}
+	if reports := b.Tick(t0.Add(2 * time.Second)); len(reports) != 0 {
+		t.Errorf("unexpected reports: %v", reports)
+	}
+}
+
+func TestBufferingReportResponse(t *testing.T) {
+	s, pdr := newBufferingSession(t, ie.NewSuggestedBufferingPacketsCount(1))
+	b := session.NewBuffering(s)
+
+	b.Buffer(pdr, []byte("p1"), t0)
+	b.ApplyReportResponse(message.NewSessionReportResponse(0, 0, 0x11111111, 1, 0,
+		ie.NewCause(ie.CauseRequestAccepted),
+		ie.NewUpdateBARWithinSessionReportResponse(
+			ie.NewBARID(1),
+			ie.NewDLBufferingDuration(10*time.Second),
+			ie.NewDLBufferingSuggestedPacketCount(3),
+		),
+	), t0.Add(time.Second))
+
+	// no reports during the extended buffering, up to the new count.
+	for n, want := range []bool{true, true, false} {
+		ok, reports := b.Buffer(pdr, []byte("p"), t0.Add(2*time.Second))
+		if ok != want {
+			t.Errorf("packet %d: got: %v, want: %v", n, ok, want)
+		}
+		if len(reports) != 0 {
+			t.Errorf("unexpected reports: %v", reports)
+		}
+	}
+	if got := len(b.Buffered()); got != 3 {
+		t.Errorf("got %d packets buffered, want 3", got)
+	}
+
+	// the packets are discarded when the duration expires.
+	b.Tick(t0.Add(11 * time.Second))
+	if got := len(b.Buffered()); got != 0 {
+		t.Errorf("got %d packets buffered, want 0", got)
+	}
+
+	b.Buffer(pdr, []byte("p2"), t0.Add(12*time.Second))
+	b.ApplyReportResponse(message.NewSessionReportResponse(0, 0, 0x11111111, 2, 0,
+		ie.NewCause(ie.CauseRequestAccepted),
+		ie.NewPFCPSRRspFlags(0x01),
+	), t0.Add(13*time.Second))
+	if got := len(b.Buffered()); got != 0 {
+		t.Errorf("got %d packets buffered after DROBU, want 0", got)
+	}
+}
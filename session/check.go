@@ -0,0 +1,234 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"fmt"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+)
+
+// ProblemKind is the kind of a Problem.
+type ProblemKind uint8
+
+// ProblemKind definitions.
+const (
+	ProblemDanglingReference ProblemKind = iota + 1
+	ProblemDuplicateID
+	ProblemOrphan
+	ProblemMissingID
+)
+
+// String returns the name of ProblemKind.
+func (k ProblemKind) String() string {
+	switch k {
+	case ProblemDanglingReference:
+		return "dangling reference"
+	case ProblemDuplicateID:
+		return "duplicate ID"
+	case ProblemOrphan:
+		return "orphan"
+	case ProblemMissingID:
+		return "missing ID"
+	default:
+		return "unknown"
+	}
+}
+
+// Problem is an inconsistency among the rules found by Check.
+type Problem struct {
+	Kind ProblemKind
+
+	// Type and ID are the rule that has the problem, e.g., the PDR that refers to
+	// the FAR which does not exist. ID is zero if the Kind is ProblemMissingID.
+	Type RuleType
+	ID   uint32
+
+	// RefType and RefID are the rule referred to but not defined. They are only
+	// set if the Kind is ProblemDanglingReference.
+	RefType RuleType
+	RefID   uint32
+}
+
+// Error returns the Problem in human-readable format.
+func (p *Problem) Error() string {
+	switch p.Kind {
+	case ProblemDanglingReference:
+		return fmt.Sprintf("%s %d refers to unknown %s %d", p.Type, p.ID, p.RefType, p.RefID)
+	case ProblemMissingID:
+		return fmt.Sprintf("%s without %s", p.Type, ie.TypeName(ruleKinds[p.Type].id))
+	default:
+		return fmt.Sprintf("%s %s %d", p.Kind, p.Type, p.ID)
+	}
+}
+
+// IsFailure reports whether the Problem should make the UP function reject the
+// request. The orphan rules are valid, though they have no effect.
+func (p *Problem) IsFailure() bool {
+	return p.Kind != ProblemOrphan
+}
+
+// FailedRuleID returns the FailedRuleID IE that identifies the rule that has the
+// Problem, or nil if the RuleType has no Rule ID Type defined (i.e., other than
+// PDR, FAR, QER, URR and BAR) or the rule has no ID.
+func (p *Problem) FailedRuleID() *ie.IE {
+	if p.Type > RuleBAR || p.Kind == ProblemMissingID {
+		return nil
+	}
+	return ie.NewFailedRuleID(ie.RuleIDTypeValue(p.Type), p.ID)
+}
+
+// Result returns the Cause value and the IE that the UP function should respond
+// with for the first failure in the problems found by Check, which are
+// CauseMandatoryIEMissing and the OffendingIE for the rule without ID, or
+// CauseRuleCreationModificationFailure and the FailedRuleID for the others.
+// It returns CauseRequestAccepted and nil if there is no failure.
+func Result(problems []*Problem) (ie.CauseValue, *ie.IE) {
+	for _, p := range problems {
+		if !p.IsFailure() {
+			continue
+		}
+		if p.Kind == ProblemMissingID {
+			return ie.CauseMandatoryIEMissing, ie.NewOffendingIE(ruleKinds[p.Type].id)
+		}
+		return ie.CauseRuleCreationModificationFailure, p.FailedRuleID()
+	}
+	return ie.CauseRequestAccepted, nil
+}
+
+// refTypes is the IEs that refer to the other rules, and the RuleType referred to.
+// The FAR ID for Quota Action in CreateURR is encoded as FARID.
+var refTypes = map[uint16]RuleType{
+	ie.FARID:             RuleFAR,
+	ie.QERID:             RuleQER,
+	ie.URRID:             RuleURR,
+	ie.LinkedURRID:       RuleURR,
+	ie.AggregatedURRID:   RuleURR,
+	ie.BARID:             RuleBAR,
+	ie.MARID:             RuleMAR,
+	ie.TrafficEndpointID: RuleTrafficEndpoint,
+}
+
+type ruleEntry struct {
+	t  RuleType
+	id uint32
+	i  *ie.IE
+}
+
+// Check returns the problems in the rules of the Session: the references to the
+// rules that do not exist (e.g., FARID in a PDR), and the orphan rules that no
+// other rule refers to. The problems are ordered by the RuleType and the ID.
+func (s *Session) Check() []*Problem {
+	var rules []ruleEntry
+	for _, t := range RuleTypes {
+		for _, id := range s.IDs(t) {
+			rules = append(rules, ruleEntry{t, id, s.Rule(t, id)})
+		}
+	}
+	return check(rules, nil)
+}
+
+// CheckMessage returns the problems in the rules created by the
+// SessionEstablishmentRequest in the same way as Session.Check, and the rules
+// without ID and the duplicate rule IDs in addition. The problems are ordered as
+// the IEs in the message.
+//
+// The other messages are not supported, as the rules in SessionModificationRequest
+// can refer to the existing ones; apply it to a copy of the Session and Check the
+// result instead.
+func CheckMessage(m message.Message) ([]*Problem, error) {
+	if _, ok := m.(*message.SessionEstablishmentRequest); !ok {
+		return nil, ErrUnsupportedMessage
+	}
+
+	var rules []ruleEntry
+	var problems []*Problem
+	seen := make([]map[uint32]bool, numRuleTypes)
+	for _, i := range message.TopLevelIEs(m) {
+		t, ok := ruleOf(i.Type)
+		if !ok || i.Type != ruleKinds[t].create {
+			continue
+		}
+		id, ok := RuleID(t, i)
+		if !ok {
+			problems = append(problems, &Problem{Kind: ProblemMissingID, Type: t})
+			continue
+		}
+
+		if seen[t] == nil {
+			seen[t] = map[uint32]bool{}
+		}
+		if seen[t][id] {
+			problems = append(problems, &Problem{Kind: ProblemDuplicateID, Type: t, ID: id})
+			continue
+		}
+		seen[t][id] = true
+		rules = append(rules, ruleEntry{t, id, i})
+	}
+	return check(rules, problems), nil
+}
+
+func check(rules []ruleEntry, problems []*Problem) []*Problem {
+	defined := make([]map[uint32]bool, numRuleTypes)
+	referred := make([]map[uint32]bool, numRuleTypes)
+	for t := range defined {
+		defined[t], referred[t] = map[uint32]bool{}, map[uint32]bool{}
+	}
+	for _, r := range rules {
+		defined[r.t][r.id] = true
+	}
+
+	for _, r := range rules {
+		children, err := r.i.ValueAsGrouped()
+		if err != nil {
+			continue
+		}
+		for _, c := range children {
+			// the ID of the rule itself is not a reference.
+			if c.Type == ruleKinds[r.t].id {
+				continue
+			}
+			walkRefs(c, func(t RuleType, id uint32) {
+				referred[t][id] = true
+				if !defined[t][id] {
+					problems = append(problems, &Problem{
+						Kind: ProblemDanglingReference, Type: r.t, ID: r.id, RefType: t, RefID: id,
+					})
+				}
+			})
+		}
+	}
+
+	for _, r := range rules {
+		if r.t == RulePDR || r.t == RuleSRR {
+			continue
+		}
+		if !referred[r.t][r.id] {
+			problems = append(problems, &Problem{Kind: ProblemOrphan, Type: r.t, ID: r.id})
+		}
+	}
+	return problems
+}
+
+// walkRefs calls fn with the rules referred to by i, including the ones in the
+// grouped IEs in it.
+func walkRefs(i *ie.IE, fn func(RuleType, uint32)) {
+	if t, ok := refTypes[i.Type]; ok {
+		fn(t, uintOf(i.Payload))
+		return
+	}
+	if !i.IsGrouped() {
+		return
+	}
+
+	children, err := i.ValueAsGrouped()
+	if err != nil {
+		return
+	}
+	for _, c := range children {
+		walkRefs(c, fn)
+	}
+}
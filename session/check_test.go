@@ -0,0 +1,157 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/session"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCheckMessage(t *testing.T) {
+	cases := []struct {
+		description string
+		ies         []*ie.IE
+		want        []*session.Problem
+		wantCause   ie.CauseValue
+		wantFailed  *ie.IE
+	}{
+		{
+			"Valid",
+			[]*ie.IE{
+				ie.NewCreatePDR(
+					ie.NewPDRID(1),
+					ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceAccess), ie.NewTrafficEndpointID(1)),
+					ie.NewFARID(1),
+					ie.NewQERID(1),
+					ie.NewURRID(1),
+					ie.NewURRID(2),
+				),
+				ie.NewCreatePDR(ie.NewPDRID(2), ie.NewMARID(1)),
+				ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyActionFlags(ie.ApplyActionBUFF), ie.NewBARID(1)),
+				ie.NewCreateFAR(ie.NewFARID(2), ie.NewApplyActionFlags(ie.ApplyActionFORW)),
+				ie.NewCreateQER(ie.NewQERID(1), ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen)),
+				ie.NewCreateURR(ie.NewURRID(1)),
+				ie.NewCreateURR(ie.NewURRID(2)),
+				ie.NewCreateBAR(ie.NewBARID(1)),
+				ie.NewCreateTrafficEndpoint(ie.NewTrafficEndpointID(1)),
+				ie.NewCreateMAR(
+					ie.NewMARID(1),
+					ie.NewTGPPAccessForwardingActionInformation(ie.NewFARID(2)),
+				),
+			},
+			nil,
+			ie.CauseRequestAccepted, nil,
+		}, {
+			"DanglingFARID",
+			[]*ie.IE{newPDR(1, 1), newPDR(2, 2), newFAR(1, 1)},
+			[]*session.Problem{
+				{Kind: session.ProblemDanglingReference, Type: session.RulePDR, ID: 2, RefType: session.RuleFAR, RefID: 2},
+			},
+			ie.CauseRuleCreationModificationFailure, ie.NewFailedRuleID(ie.RuleIDTypePDR, 2),
+		}, {
+			"DanglingBARID",
+			[]*ie.IE{
+				newPDR(1, 1),
+				ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyActionFlags(ie.ApplyActionBUFF), ie.NewBARID(3)),
+			},
+			[]*session.Problem{
+				{Kind: session.ProblemDanglingReference, Type: session.RuleFAR, ID: 1, RefType: session.RuleBAR, RefID: 3},
+			},
+			ie.CauseRuleCreationModificationFailure, ie.NewFailedRuleID(ie.RuleIDTypeFAR, 1),
+		}, {
+			"DuplicateFARID",
+			[]*ie.IE{newPDR(1, 1), newFAR(1, 1), newFAR(1, 2)},
+			[]*session.Problem{
+				{Kind: session.ProblemDuplicateID, Type: session.RuleFAR, ID: 1},
+			},
+			ie.CauseRuleCreationModificationFailure, ie.NewFailedRuleID(ie.RuleIDTypeFAR, 1),
+		}, {
+			"MissingFARID",
+			[]*ie.IE{newPDR(1, 1), newFAR(1, 1), ie.NewCreateFAR(ie.NewApplyActionFlags(ie.ApplyActionDROP))},
+			[]*session.Problem{
+				{Kind: session.ProblemMissingID, Type: session.RuleFAR},
+			},
+			ie.CauseMandatoryIEMissing, ie.NewOffendingIE(ie.FARID),
+		}, {
+			"DanglingLinkedURRID",
+			[]*ie.IE{
+				ie.NewCreatePDR(ie.NewPDRID(1), ie.NewFARID(1), ie.NewURRID(1)),
+				newFAR(1, 1),
+				ie.NewCreateURR(ie.NewURRID(1), ie.NewLinkedURRID(3)),
+			},
+			[]*session.Problem{
+				{Kind: session.ProblemDanglingReference, Type: session.RuleURR, ID: 1, RefType: session.RuleURR, RefID: 3},
+			},
+			ie.CauseRuleCreationModificationFailure, ie.NewFailedRuleID(ie.RuleIDTypeURR, 1),
+		}, {
+			"LinkedOnly",
+			[]*ie.IE{
+				ie.NewCreatePDR(ie.NewPDRID(1), ie.NewFARID(1), ie.NewURRID(1)),
+				newFAR(1, 1),
+				newFAR(2, 2),
+				ie.NewCreateURR(ie.NewURRID(1), ie.NewLinkedURRID(2), ie.NewFARID(2)),
+				ie.NewCreateURR(ie.NewURRID(2)),
+			},
+			nil,
+			ie.CauseRequestAccepted, nil,
+		}, {
+			"Orphan",
+			[]*ie.IE{
+				newPDR(1, 1),
+				newFAR(1, 1),
+				newFAR(2, 2),
+				ie.NewCreateQER(ie.NewQERID(5), ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen)),
+			},
+			[]*session.Problem{
+				{Kind: session.ProblemOrphan, Type: session.RuleFAR, ID: 2},
+				{Kind: session.ProblemOrphan, Type: session.RuleQER, ID: 5},
+			},
+			ie.CauseRequestAccepted, nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			got, err := session.CheckMessage(newEstablishment(c.ies...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Error(diff)
+			}
+
+			cause, failed := session.Result(got)
+			if cause != c.wantCause {
+				t.Errorf("wrong cause, got: %s, want: %s", cause, c.wantCause)
+			}
+			if diff := cmp.Diff(c.wantFailed, failed); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+
+	if _, err := session.CheckMessage(newModification()); !errors.Is(err, session.ErrUnsupportedMessage) {
+		t.Errorf("got %v, want ErrUnsupportedMessage", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	s := mustSession(t, newPDR(1, 1), newFAR(1, 1), newFAR(2, 2))
+	if err := s.Apply(newModification(ie.NewRemoveFAR(ie.NewFARID(1)))); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*session.Problem{
+		{Kind: session.ProblemDanglingReference, Type: session.RulePDR, ID: 1, RefType: session.RuleFAR, RefID: 1},
+		{Kind: session.ProblemOrphan, Type: session.RuleFAR, ID: 2},
+	}
+	if diff := cmp.Diff(want, s.Check()); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"math"
+	"net"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+// ClassifyOption is an option to change how Classify matches the packets.
+type ClassifyOption func(*classifyConfig)
+
+type classifyConfig struct {
+	appMatcher func(appID string, p *Packet) bool
+}
+
+// ApplicationMatcher makes Classify use fn to see if the packet belongs to the
+// application identified by ApplicationID in PDI, instead of comparing it with the
+// ApplicationID in Packet.
+func ApplicationMatcher(fn func(appID string, p *Packet) bool) ClassifyOption {
+	return func(c *classifyConfig) {
+		c.appMatcher = fn
+	}
+}
+
+// Classify returns the ID of the PDR that has the highest precedence (i.e., the
+// lowest Precedence value) among the ones whose PDI matches the packet. The second
+// value is false if no PDR matches. If the PDRs have the same precedence, the one
+// with the lowest ID is chosen.
+//
+// The packet matches the PDI if it matches all the IEs present in it, following
+// the clause 5.2.1 of TS 29.244:
+//
+//   - SourceInterface, NetworkInstance, QFI and LocalFTEID are compared with the
+//     ones in Packet. The TEID and the IP address in LocalFTEID are compared if
+//     they are present, i.e., the CH flag is not set.
+//   - UEIPAddress is compared with the source address of the packet, or the
+//     destination address if the SD flag is set. If there are UEIPAddress IEs for
+//     IPv4 and IPv6, the packet matches if any of them matches.
+//   - TrafficEndpointID makes the IEs in the traffic endpoint compared as above.
+//   - SDFFilter and EthernetPacketFilter match if any of them match. The "assigned"
+//     in the flow description is the address in UEIPAddress.
+//   - ApplicationID is compared with the ApplicationID in Packet, or with the
+//     function given by ApplicationMatcher.
+func (s *Session) Classify(p *Packet, opts ...ClassifyOption) (uint32, bool) {
+	cfg := &classifyConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	var (
+		found bool
+		best  uint32
+		prec  uint32 = math.MaxUint32
+	)
+	for _, id := range s.IDs(RulePDR) {
+		children, err := s.PDRs[id].ValueAsGrouped()
+		if err != nil {
+			continue
+		}
+
+		var pdi *ie.IE
+		pr := uint32(math.MaxUint32)
+		for _, c := range children {
+			switch c.Type {
+			case ie.PDI:
+				pdi = c
+			case ie.Precedence:
+				if v, err := c.Precedence(); err == nil {
+					pr = v
+				}
+			}
+		}
+		if pdi == nil || (found && pr >= prec) {
+			continue
+		}
+		if s.matchPDI(pdi, p, cfg) {
+			found, best, prec = true, id, pr
+		}
+	}
+	return best, found
+}
+
+func (s *Session) matchPDI(pdi *ie.IE, p *Packet, cfg *classifyConfig) bool {
+	children, err := pdi.ValueAsGrouped()
+	if err != nil {
+		return false
+	}
+
+	// the IEs in the traffic endpoints are matched as if they are in PDI.
+	ies := children
+	for _, c := range children {
+		if c.Type != ie.TrafficEndpointID {
+			continue
+		}
+		te := s.TrafficEndpoints[uintOf(c.Payload)]
+		if te == nil {
+			return false
+		}
+		tc, err := te.ValueAsGrouped()
+		if err != nil {
+			return false
+		}
+		ies = append(ies[:len(ies):len(ies)], tc...)
+	}
+
+	// the UE IP addresses of both versions can be given in separate IEs, so the
+	// packet matches if any of them matches.
+	var ueIPs []*ie.UEIPAddressFields
+	for _, c := range ies {
+		if c.Type != ie.UEIPAddress {
+			continue
+		}
+		f, err := c.UEIPAddress()
+		if err != nil {
+			return false
+		}
+		ueIPs = append(ueIPs, f)
+	}
+	if len(ueIPs) > 0 && !matchPacketUEIP(ueIPs, p) {
+		return false
+	}
+	assigned := func(ip net.IP) bool {
+		if len(ueIPs) == 0 {
+			return true
+		}
+		for _, f := range ueIPs {
+			if matchUEIP(f, ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var qfis, sdfs, ethFilters, qfiMatched, sdfMatched, ethMatched int
+	for _, c := range ies {
+		switch c.Type {
+		case ie.SourceInterface:
+			v, err := c.SourceInterface()
+			if err != nil || v != p.SourceInterface {
+				return false
+			}
+		case ie.NetworkInstance:
+			v, err := c.NetworkInstance()
+			if err != nil || v != p.NetworkInstance {
+				return false
+			}
+		case ie.FTEID:
+			f, err := c.FTEID()
+			if err != nil || !matchFTEID(f, p) {
+				return false
+			}
+		case ie.QFI:
+			qfis++
+			if v, err := c.QFI(); err == nil && v == p.QFI {
+				qfiMatched++
+			}
+		case ie.SDFFilter:
+			sdfs++
+			if s.matchSDFFilter(c, p, assigned) {
+				sdfMatched++
+			}
+		case ie.EthernetPacketFilter:
+			ethFilters++
+			if s.matchEthernetPacketFilter(c, p, assigned) {
+				ethMatched++
+			}
+		case ie.ApplicationID:
+			v, err := c.ApplicationID()
+			if err != nil {
+				return false
+			}
+			if cfg.appMatcher != nil {
+				if !cfg.appMatcher(v, p) {
+					return false
+				}
+			} else if v != p.ApplicationID {
+				return false
+			}
+		}
+	}
+	return (qfis == 0 || qfiMatched > 0) && (sdfs == 0 || sdfMatched > 0) && (ethFilters == 0 || ethMatched > 0)
+}
+
+// matchPacketUEIP reports whether the source address of the packet, or the
+// destination address if the SD flag is set, is any of the UE IP addresses.
+func matchPacketUEIP(ueIPs []*ie.UEIPAddressFields, p *Packet) bool {
+	for _, f := range ueIPs {
+		ip := p.SrcIP
+		if f.Flags&0x04 != 0 { // SD
+			ip = p.DstIP
+		}
+		if matchUEIP(f, ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchFTEID(f *ie.FTEIDFields, p *Packet) bool {
+	if f.HasCh() {
+		return true
+	}
+	if f.TEID != p.TEID {
+		return false
+	}
+	if p.LocalAddress == nil {
+		return true
+	}
+	if v4 := p.LocalAddress.To4(); v4 != nil {
+		return f.IPv4Address == nil || f.IPv4Address.Equal(v4)
+	}
+	return f.IPv6Address == nil || f.IPv6Address.Equal(p.LocalAddress)
+}
+
+// matchUEIP reports whether ip is the one in UEIPAddress. The IPv6 address is
+// compared as the prefix of the length given by IPv6PrefixLength, or /64.
+func matchUEIP(f *ie.UEIPAddressFields, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	if v4 := ip.To4(); v4 != nil {
+		return f.IPv4Address != nil && f.IPv4Address.Equal(v4)
+	}
+	if f.IPv6Address == nil {
+		return false
+	}
+
+	plen := 64
+	if f.Flags&0x40 != 0 { // IP6PL
+		plen = int(f.IPv6PrefixLength)
+	}
+	n := &net.IPNet{IP: f.IPv6Address.Mask(net.CIDRMask(plen, 128)), Mask: net.CIDRMask(plen, 128)}
+	return n.Contains(ip)
+}
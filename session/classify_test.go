@@ -0,0 +1,206 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/session"
+)
+
+func TestClassify(t *testing.T) {
+	mac1, _ := net.ParseMAC("00:00:5e:00:53:01")
+	mac2, _ := net.ParseMAC("00:00:5e:00:53:02")
+	mac3, _ := net.ParseMAC("00:00:5e:00:53:03")
+
+	s := mustSession(t,
+		// uplink, default
+		ie.NewCreatePDR(
+			ie.NewPDRID(1),
+			ie.NewPrecedence(255),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
+				ie.NewNetworkInstance("internet"),
+				ie.NewUEIPAddress(0x02, "10.0.0.1", "", 0, 0),
+			),
+			ie.NewFARID(1),
+		),
+		// uplink, DNS only
+		ie.NewCreatePDR(
+			ie.NewPDRID(2),
+			ie.NewPrecedence(100),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
+				ie.NewNetworkInstance("internet"),
+				ie.NewUEIPAddress(0x02, "10.0.0.1", "", 0, 0),
+				ie.NewQFI(9),
+				ie.NewSDFFilter("permit out 17 from 192.0.2.0/24 53 to assigned", "", "", "", 0),
+			),
+			ie.NewFARID(1),
+		),
+		// downlink, DSCP EF only
+		ie.NewCreatePDR(
+			ie.NewPDRID(3),
+			ie.NewPrecedence(100),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceCore),
+				ie.NewNetworkInstance("internet"),
+				ie.NewUEIPAddress(0x06, "10.0.0.1", "", 0, 0),
+				ie.NewSDFFilter("", string([]byte{0xb8, 0xfc}), "", "", 0),
+			),
+			ie.NewFARID(2),
+		),
+		// downlink, default, through traffic endpoint
+		ie.NewCreatePDR(
+			ie.NewPDRID(4),
+			ie.NewPrecedence(255),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceCore),
+				ie.NewTrafficEndpointID(1),
+			),
+			ie.NewFARID(2),
+		),
+		ie.NewCreateTrafficEndpoint(
+			ie.NewTrafficEndpointID(1),
+			ie.NewNetworkInstance("internet"),
+			ie.NewUEIPAddress(0x06, "10.0.0.1", "", 0, 0),
+		),
+		// application
+		ie.NewCreatePDR(
+			ie.NewPDRID(5),
+			ie.NewPrecedence(50),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceCore),
+				ie.NewUEIPAddress(0x06, "10.0.0.1", "", 0, 0),
+				ie.NewApplicationID("video"),
+			),
+			ie.NewFARID(2),
+		),
+		// ethernet
+		ie.NewCreatePDR(
+			ie.NewPDRID(6),
+			ie.NewPrecedence(10),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceSGiLANN6LAN),
+				ie.NewEthernetPacketFilter(
+					ie.NewEthernetFilterProperties(0x01),
+					ie.NewMACAddress(mac1, nil, mac2, nil),
+					ie.NewEthertype(0x0800),
+					ie.NewCTAG(0x04, 0, 0, 100),
+				),
+			),
+			ie.NewFARID(2),
+		),
+		// downlink, dual-stack
+		ie.NewCreatePDR(
+			ie.NewPDRID(7),
+			ie.NewPrecedence(255),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceCore),
+				ie.NewNetworkInstance("dual"),
+				ie.NewUEIPAddress(0x06, "10.0.0.1", "", 0, 0),
+				ie.NewUEIPAddress(0x05, "", "2001:db8::1", 0, 0),
+			),
+			ie.NewFARID(2),
+		),
+		ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyActionFlags(ie.ApplyActionFORW)),
+		ie.NewCreateFAR(ie.NewFARID(2), ie.NewApplyActionFlags(ie.ApplyActionFORW)),
+		ie.NewCreateTrafficEndpoint(ie.NewTrafficEndpointID(2)),
+	)
+
+	ul := func(qfi uint8, proto uint8, src string, sport uint16) *session.Packet {
+		return &session.Packet{
+			SourceInterface: ie.SrcInterfaceAccess,
+			NetworkInstance: "internet",
+			TEID:            0x11111111,
+			LocalAddress:    net.ParseIP("127.0.0.1"),
+			QFI:             qfi,
+			SrcIP:           net.ParseIP("10.0.0.1"),
+			DstIP:           net.ParseIP(src),
+			Protocol:        proto,
+			SrcPort:         40000,
+			DstPort:         sport,
+		}
+	}
+	dl := func(tos uint8, app string) *session.Packet {
+		return &session.Packet{
+			SourceInterface: ie.SrcInterfaceCore,
+			NetworkInstance: "internet",
+			SrcIP:           net.ParseIP("198.51.100.1"),
+			DstIP:           net.ParseIP("10.0.0.1"),
+			Protocol:        6,
+			SrcPort:         443,
+			DstPort:         40000,
+			TOS:             tos,
+			ApplicationID:   app,
+		}
+	}
+	eth := func(src, dst net.HardwareAddr, vid uint16) *session.Packet {
+		return &session.Packet{
+			SourceInterface: ie.SrcInterfaceSGiLANN6LAN,
+			SrcMAC:          src,
+			DstMAC:          dst,
+			Ethertype:       0x0800,
+			CTAG:            &session.VLANTag{PCP: 3, VID: vid},
+		}
+	}
+
+	cases := []struct {
+		description string
+		packet      *session.Packet
+		opts        []session.ClassifyOption
+		want        uint32
+		wantOK      bool
+	}{
+		{"UL/DNS", ul(9, 17, "192.0.2.53", 53), nil, 2, true},
+		{"UL/DNS/WrongQFI", ul(5, 17, "192.0.2.53", 53), nil, 1, true},
+		{"UL/DNS/WrongPort", ul(9, 17, "192.0.2.53", 5353), nil, 1, true},
+		{"UL/DNS/WrongProtocol", ul(9, 6, "192.0.2.53", 53), nil, 1, true},
+		{"UL/OtherServer", ul(9, 17, "198.51.100.1", 53), nil, 1, true},
+		{"UL/WrongTEID", func() *session.Packet { p := ul(9, 17, "192.0.2.53", 53); p.TEID = 1; return p }(), nil, 0, false},
+		{"UL/WrongUEIP", func() *session.Packet { p := ul(9, 17, "192.0.2.53", 53); p.SrcIP = net.ParseIP("10.0.0.2"); return p }(), nil, 0, false},
+		{"DL/EF", dl(0xb8, ""), nil, 3, true},
+		{"DL/EF/ECN", dl(0xb9, ""), nil, 3, true},
+		{"DL/BestEffort", dl(0x00, ""), nil, 4, true},
+		{"DL/Application", dl(0x00, "video"), nil, 5, true},
+		{
+			"DL/Application/Matcher",
+			dl(0x00, ""),
+			[]session.ClassifyOption{session.ApplicationMatcher(func(appID string, p *session.Packet) bool {
+				return appID == "video" && p.SrcPort == 443
+			})},
+			5, true,
+		},
+		{"DL/WrongNetworkInstance", func() *session.Packet { p := dl(0, ""); p.NetworkInstance = "ims"; return p }(), nil, 0, false},
+		{"DL/DualStack/IPv4", func() *session.Packet { p := dl(0, ""); p.NetworkInstance = "dual"; return p }(), nil, 7, true},
+		{"DL/DualStack/IPv6", func() *session.Packet {
+			p := dl(0, "")
+			p.NetworkInstance, p.SrcIP, p.DstIP = "dual", net.ParseIP("2001:db8:1::1"), net.ParseIP("2001:db8::1")
+			return p
+		}(), nil, 7, true},
+		{"DL/DualStack/WrongUEIP", func() *session.Packet {
+			p := dl(0, "")
+			p.NetworkInstance, p.SrcIP, p.DstIP = "dual", net.ParseIP("2001:db8:1::1"), net.ParseIP("2001:db8:2::1")
+			return p
+		}(), nil, 0, false},
+		{"Ethernet", eth(mac2, mac3, 100), nil, 6, true},
+		{"Ethernet/Bidirectional", eth(mac3, mac1, 100), nil, 6, true},
+		{"Ethernet/WrongMAC", eth(mac3, mac3, 100), nil, 0, false},
+		{"Ethernet/WrongVID", eth(mac1, mac3, 200), nil, 0, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			got, ok := s.Classify(c.packet, c.opts...)
+			if got != c.want || ok != c.wantOK {
+				t.Errorf("got: %d, %v, want: %d, %v", got, ok, c.want, c.wantOK)
+			}
+		})
+	}
+}
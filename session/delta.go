@@ -0,0 +1,221 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"bytes"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+)
+
+// createOrder is the RuleTypes in the order that the rules referred to by the
+// other rules come first, e.g., FAR (referred to by PDR) before PDR.
+var createOrder = []RuleType{
+	RuleBAR, RuleQER, RuleURR, RuleFAR, RuleTrafficEndpoint, RuleMAR, RuleSRR, RulePDR,
+}
+
+// updateTypes is the nested Update IEs for the IEs in the rules, e.g.,
+// UpdateForwardingParameters for ForwardingParameters. It is the reverse of
+// updateTargets.
+var updateTypes = func() map[uint16]uint16 {
+	m := make(map[uint16]uint16, len(updateTargets))
+	for upd, target := range updateTargets {
+		m[target] = upd
+	}
+	return m
+}()
+
+// Delta returns the IEs of SessionModificationRequest that change the rules in
+// old into the ones in new. The IEs are in dependency-safe order: all the Remove
+// IEs first, then the Create IEs and the Update IEs, each of them ordered so that
+// no rule refers to a rule that is not created yet, e.g., CreateFAR before
+// CreatePDR. The CP F-SEID is included first if it is changed.
+//
+// A changed rule is expressed by the Update IE with only the changed IEs in it,
+// using the nested Update IEs such as UpdateForwardingParameters. If the change
+// cannot be expressed by the Update IE (e.g., an optional IE is removed from the
+// rule), the rule is removed and created again.
+func Delta(old, new *Session) []*ie.IE {
+	var ies []*ie.IE
+	if new.CPFSEID != nil && (old.CPFSEID == nil || !bytes.Equal(old.CPFSEID.Payload, new.CPFSEID.Payload)) {
+		ies = append(ies, new.CPFSEID)
+	}
+
+	var removes, creates, updates []*ie.IE
+	recreated := make([]map[uint32]bool, numRuleTypes)
+	for _, t := range createOrder {
+		recreated[t] = map[uint32]bool{}
+		for _, id := range new.IDs(t) {
+			n := new.Rule(t, id)
+			o := old.Rule(t, id)
+			if o == nil {
+				creates = append(creates, n)
+				continue
+			}
+
+			upd, ok := newUpdate(t, o, n)
+			if !ok {
+				recreated[t][id] = true
+				creates = append(creates, n)
+				continue
+			}
+			if upd != nil {
+				updates = append(updates, upd)
+			}
+		}
+	}
+	for n := len(createOrder) - 1; n >= 0; n-- {
+		t := createOrder[n]
+		for _, id := range old.IDs(t) {
+			if new.Rule(t, id) == nil || recreated[t][id] {
+				removes = append(removes, newRemove(t, old.Rule(t, id)))
+			}
+		}
+	}
+
+	ies = append(ies, removes...)
+	ies = append(ies, creates...)
+	return append(ies, updates...)
+}
+
+// NewModificationRequest returns the SessionModificationRequest that has the IEs
+// returned by Delta in the same order. It is *message.Generic, as the typed
+// message.SessionModificationRequest encodes the IEs in the order of its fields,
+// i.e., CreatePDR before CreateFAR.
+func NewModificationRequest(old, new *Session, seid uint64, seq uint32) *message.Generic {
+	return message.NewGeneric(message.MsgTypeSessionModificationRequest, seid, seq, Delta(old, new)...)
+}
+
+// newRemove returns the Remove IE of the rule, e.g., RemovePDR.
+func newRemove(t RuleType, rule *ie.IE) *ie.IE {
+	children, _ := rule.ValueAsGrouped()
+	if n := indexOfType(children, ruleKinds[t].id); n >= 0 {
+		return ie.NewGroupedIE(ruleKinds[t].remove, children[n])
+	}
+	return ie.NewGroupedIE(ruleKinds[t].remove)
+}
+
+// newUpdate returns the Update IE that changes o into n, or nil if they are the
+// same. The second value is false if the change cannot be expressed by the Update IE.
+func newUpdate(t RuleType, o, n *ie.IE) (*ie.IE, bool) {
+	if bytes.Equal(o.Payload, n.Payload) {
+		return nil, true
+	}
+
+	changes, ok := diffChildren(o, n)
+	if !ok {
+		return nil, false
+	}
+	if len(changes) == 0 {
+		return nil, true
+	}
+
+	children, _ := n.ValueAsGrouped()
+	idx := indexOfType(children, ruleKinds[t].id)
+	if idx < 0 {
+		return nil, false
+	}
+	return ie.NewGroupedIE(ruleKinds[t].update, append([]*ie.IE{children[idx]}, changes...)...), true
+}
+
+// diffChildren returns the IEs to be put in the Update IE to change the children
+// of o into the ones of n, in the way merge applies them. o can be nil.
+func diffChildren(o, n *ie.IE) ([]*ie.IE, bool) {
+	var oc []*ie.IE
+	if o != nil {
+		var err error
+		if oc, err = o.ValueAsGrouped(); err != nil {
+			return nil, false
+		}
+	}
+	nc, err := n.ValueAsGrouped()
+	if err != nil {
+		return nil, false
+	}
+
+	oldByType, _ := groupByType(oc)
+	newByType, types := groupByType(nc)
+	for typ := range oldByType {
+		if _, ok := newByType[typ]; !ok && typ != ie.ActivatePredefinedRules {
+			return nil, false
+		}
+	}
+
+	var changes []*ie.IE
+	for _, typ := range types {
+		x, y := oldByType[typ], newByType[typ]
+
+		if typ == ie.ActivatePredefinedRules {
+			continue
+		}
+		if upd, ok := updateTypes[typ]; ok && len(x) <= 1 && len(y) == 1 {
+			var prev *ie.IE
+			if len(x) == 1 {
+				prev = x[0]
+			}
+			c, ok := diffChildren(prev, y[0])
+			if !ok {
+				return nil, false
+			}
+			if len(c) > 0 {
+				changes = append(changes, ie.NewGroupedIE(upd, c...))
+			}
+			continue
+		}
+		if !sameIEs(x, y) {
+			changes = append(changes, y...)
+		}
+	}
+
+	// the predefined rules are activated and deactivated one by one.
+	oldRules, newRules := oldByType[ie.ActivatePredefinedRules], newByType[ie.ActivatePredefinedRules]
+	for _, r := range oldRules {
+		if indexOf(newRules, r) < 0 {
+			changes = append(changes, ie.NewDeactivatePredefinedRules(string(r.Payload)))
+		}
+	}
+	for _, r := range newRules {
+		if indexOf(oldRules, r) < 0 {
+			changes = append(changes, r)
+		}
+	}
+	return changes, true
+}
+
+// groupByType groups the IEs by type. The types are returned in order of appearance.
+func groupByType(ies []*ie.IE) (map[uint16][]*ie.IE, []uint16) {
+	groups := map[uint16][]*ie.IE{}
+	var types []uint16
+	for _, i := range ies {
+		if _, ok := groups[i.Type]; !ok {
+			types = append(types, i.Type)
+		}
+		groups[i.Type] = append(groups[i.Type], i)
+	}
+	return groups, types
+}
+
+// sameIEs reports whether a and b have the same IEs regardless of the order.
+func sameIEs(a, b []*ie.IE) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	used := make([]bool, len(b))
+	for _, x := range a {
+		found := false
+		for n, y := range b {
+			if !used[n] && x.Type == y.Type && bytes.Equal(x.Payload, y.Payload) {
+				used[n], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session_test
+
+import (
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+	"github.com/aalayanahmad/go-pfcp/session"
+	"github.com/google/go-cmp/cmp"
+)
+
+func mustSession(t *testing.T, ies ...*ie.IE) *session.Session {
+	t.Helper()
+
+	s := session.New()
+	if err := s.Apply(newEstablishment(ies...)); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// rulesOf returns the rules in s as a message so that they can be compared with
+// message.Diff regardless of the order of the IEs.
+func rulesOf(s *session.Session) message.Message {
+	ies := []*ie.IE{s.NodeID, s.CPFSEID}
+	for _, t := range session.RuleTypes {
+		for _, id := range s.IDs(t) {
+			ies = append(ies, s.Rule(t, id))
+		}
+	}
+	return message.NewSessionEstablishmentRequest(0, 0, 0, 1, 0, ies...)
+}
+
+func TestDelta(t *testing.T) {
+	cases := []struct {
+		description string
+		old, new    []*ie.IE
+		want        []*ie.IE
+	}{
+		{
+			"NoChange",
+			[]*ie.IE{newPDR(1, 1), newFAR(1, 1)},
+			[]*ie.IE{newPDR(1, 1), newFAR(1, 1)},
+			nil,
+		}, {
+			"CreateAndRemove",
+			[]*ie.IE{newPDR(1, 1), newFAR(1, 1)},
+			[]*ie.IE{newPDR(2, 2), newFAR(2, 2)},
+			[]*ie.IE{
+				ie.NewRemovePDR(ie.NewPDRID(1)),
+				ie.NewRemoveFAR(ie.NewFARID(1)),
+				newFAR(2, 2),
+				newPDR(2, 2),
+			},
+		}, {
+			"UpdatePDRWithNewFAR",
+			[]*ie.IE{newPDR(1, 1), newFAR(1, 1)},
+			[]*ie.IE{newPDR(1, 2), newFAR(1, 1), newFAR(2, 2)},
+			[]*ie.IE{
+				newFAR(2, 2),
+				ie.NewUpdatePDR(ie.NewPDRID(1), ie.NewFARID(2)),
+			},
+		}, {
+			"UpdateForwardingParameters",
+			[]*ie.IE{newPDR(1, 1), newFAR(1, 1)},
+			[]*ie.IE{newPDR(1, 1), newFAR(1, 9)},
+			[]*ie.IE{
+				ie.NewUpdateFAR(
+					ie.NewFARID(1),
+					ie.NewUpdateForwardingParameters(
+						ie.NewOuterHeaderCreation(0x0100, 9, "127.0.0.1", "", 0, 0, 0),
+					),
+				),
+			},
+		}, {
+			"RemovedOptionalIE",
+			[]*ie.IE{newPDR(1, 1), newFAR(1, 1)},
+			[]*ie.IE{newPDR(1, 1), ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyActionFlags(ie.ApplyActionDROP))},
+			[]*ie.IE{
+				ie.NewRemoveFAR(ie.NewFARID(1)),
+				ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyActionFlags(ie.ApplyActionDROP)),
+			},
+		}, {
+			"PredefinedRules",
+			[]*ie.IE{
+				ie.NewCreatePDR(ie.NewPDRID(1), ie.NewActivatePredefinedRules("a")),
+				newFAR(1, 1),
+			},
+			[]*ie.IE{
+				ie.NewCreatePDR(ie.NewPDRID(1), ie.NewActivatePredefinedRules("b")),
+				newFAR(1, 1),
+			},
+			[]*ie.IE{
+				ie.NewUpdatePDR(
+					ie.NewPDRID(1),
+					ie.NewDeactivatePredefinedRules("a"),
+					ie.NewActivatePredefinedRules("b"),
+				),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			old, new := mustSession(t, c.old...), mustSession(t, c.new...)
+
+			got := session.Delta(old, new)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Error(diff)
+			}
+
+			// the request keeps the order given by Delta.
+			req := session.NewModificationRequest(old, new, 0, 2)
+			if diff := cmp.Diff(c.want, req.IEs); diff != "" {
+				t.Error(diff)
+			}
+
+			// applying the delta to old should result in new.
+			if err := old.Apply(req); err != nil {
+				t.Fatal(err)
+			}
+			if diff := message.Diff(rulesOf(new), rulesOf(old)); len(diff) != 0 {
+				t.Errorf("unexpected rules after applying the delta: %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package session provides the in-memory model of the rules in a PFCP session,
+// which is updated by applying the session related messages.
+package session
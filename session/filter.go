@@ -0,0 +1,134 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"net"
+	"sync"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+// flowTuple returns the values in the IP packet to be matched with the SDFFilter.
+// The flow description is in the downlink direction as specified in TS 29.212, so
+// the source and destination are swapped for the uplink packets.
+func flowTuple(p *Packet) *ie.FlowTuple {
+	t := &ie.FlowTuple{
+		SrcIP:     p.SrcIP,
+		DstIP:     p.DstIP,
+		Protocol:  p.Protocol,
+		SrcPort:   p.SrcPort,
+		DstPort:   p.DstPort,
+		TOS:       p.TOS,
+		SPI:       p.SPI,
+		FlowLabel: p.FlowLabel,
+	}
+	if p.SourceInterface == ie.SrcInterfaceAccess {
+		t.SrcIP, t.DstIP, t.SrcPort, t.DstPort = t.DstIP, t.SrcIP, t.DstPort, t.SrcPort
+	}
+	return t
+}
+
+// sdfFilter is the SDFFilter parsed by Classify.
+type sdfFilter struct {
+	fields *ie.SDFFilterFields
+	fd     *ie.FlowDescription
+	err    error
+}
+
+// ethernetPacketFilter is the EthernetPacketFilter parsed by Classify.
+type ethernetPacketFilter struct {
+	fields *ie.EthernetPacketFilterFields
+	fds    []*ie.FlowDescription
+	err    error
+}
+
+// filterCache holds the SDFFilters and EthernetPacketFilters parsed so far, so
+// that the flow descriptions are not parsed for every packet. They are indexed
+// by the IE, as the IEs held in Session are never modified in place.
+type filterCache struct {
+	mu   sync.Mutex
+	sdfs map[*ie.IE]*sdfFilter
+	eths map[*ie.IE]*ethernetPacketFilter
+}
+
+func newFilterCache() *filterCache {
+	return &filterCache{
+		sdfs: map[*ie.IE]*sdfFilter{},
+		eths: map[*ie.IE]*ethernetPacketFilter{},
+	}
+}
+
+// sdfFilter returns the parsed SDFFilter, parsing it if it is not in the cache.
+// The cache can be nil, e.g., in the Session not created with New.
+func (c *filterCache) sdfFilter(i *ie.IE) *sdfFilter {
+	if c != nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if f, ok := c.sdfs[i]; ok {
+			return f
+		}
+	}
+
+	f := &sdfFilter{}
+	f.fields, f.err = i.SDFFilter()
+	if f.err == nil && f.fields.HasFD() {
+		f.fd, f.err = f.fields.ParsedFlowDescription()
+	}
+	if c != nil {
+		c.sdfs[i] = f
+	}
+	return f
+}
+
+// ethernetPacketFilter returns the parsed EthernetPacketFilter in the same way
+// as sdfFilter.
+func (c *filterCache) ethernetPacketFilter(i *ie.IE) *ethernetPacketFilter {
+	if c != nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if f, ok := c.eths[i]; ok {
+			return f
+		}
+	}
+
+	f := &ethernetPacketFilter{}
+	f.fields, f.err = i.EthernetPacketFilterFields()
+	if f.err == nil {
+		f.fds, f.err = f.fields.ParsedFlowDescriptions()
+	}
+	if c != nil {
+		c.eths[i] = f
+	}
+	return f
+}
+
+// matchSDFFilter reports whether the packet matches all the components in the
+// SDFFilter.
+func (s *Session) matchSDFFilter(i *ie.IE, p *Packet, assigned func(net.IP) bool) bool {
+	f := s.filters.sdfFilter(i)
+	return f.err == nil && f.fields.MatchWith(f.fd, flowTuple(p), assigned)
+}
+
+// matchEthernetPacketFilter reports whether the packet matches the
+// EthernetPacketFilter.
+func (s *Session) matchEthernetPacketFilter(i *ie.IE, p *Packet, assigned func(net.IP) bool) bool {
+	f := s.filters.ethernetPacketFilter(i)
+	if f.err != nil {
+		return false
+	}
+
+	fr := &ie.EthernetFrame{
+		SrcMAC:    p.SrcMAC,
+		DstMAC:    p.DstMAC,
+		Ethertype: p.Ethertype,
+		CTAG:      p.CTAG,
+		STAG:      p.STAG,
+	}
+	if p.SrcIP != nil || p.DstIP != nil {
+		fr.IP = flowTuple(p)
+	}
+	return f.fields.MatchWith(f.fds, fr, assigned)
+}
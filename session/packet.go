@@ -0,0 +1,60 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"net"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+// Packet is the metadata of a packet decoded by the UP function, which is
+// classified by Session.Classify.
+//
+// The fields that are not known can be left zero, but the PDRs that require
+// them will not match.
+type Packet struct {
+	SourceInterface ie.SourceInterfaceValue
+	NetworkInstance string
+
+	// TEID and LocalAddress are the TEID and the destination address in the outer
+	// headers of the GTP-U packet, and QFI is the one in the PDU Session Container.
+	TEID         uint32
+	LocalAddress net.IP
+	QFI          uint8
+
+	// SrcIP to FlowLabel are from the (inner) IP packet. TOS is the Type of Service
+	// in IPv4 or the Traffic Class in IPv6, which includes DSCP in the upper 6 bits.
+	SrcIP     net.IP
+	DstIP     net.IP
+	Protocol  uint8
+	SrcPort   uint16
+	DstPort   uint16
+	TOS       uint8
+	SPI       uint32
+	FlowLabel uint32
+
+	// SrcMAC to STAG are from the Ethernet frame for Ethernet PDU sessions. CTAG
+	// and STAG are nil if the frame does not have them.
+	SrcMAC    net.HardwareAddr
+	DstMAC    net.HardwareAddr
+	Ethertype uint16
+	CTAG      *VLANTag
+	STAG      *VLANTag
+
+	// ApplicationID is the application the packet is known to belong to, if any.
+	// See also ApplicationMatcher.
+	ApplicationID string
+
+	// URL to CustomPFDContent are from the application layer, e.g., the HTTP
+	// request or the TLS SNI, and are matched with the PFDs by PFDs.Match.
+	URL                string
+	DomainName         string
+	DomainNameProtocol string
+	CustomPFDContent   string
+}
+
+// VLANTag is the VLAN tag (C-TAG or S-TAG) in an Ethernet frame.
+type VLANTag = ie.VLANTag
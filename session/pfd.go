@@ -0,0 +1,404 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+)
+
+// Error definitions for PFDs.
+var (
+	ErrMissingApplicationID = errors.New("missing ApplicationID")
+)
+
+// PFDError indicates that the PFDs of an application could not be provisioned.
+type PFDError struct {
+	ApplicationID string
+	Err           error
+}
+
+// Error returns message with the application and the cause.
+func (e *PFDError) Error() string {
+	return fmt.Sprintf("failed to apply PFDs of %q: %v", e.ApplicationID, e.Err)
+}
+
+// Unwrap returns the cause.
+func (e *PFDError) Unwrap() error {
+	return e.Err
+}
+
+// PFDs is the Packet Flow Descriptions provisioned in the UP function by the
+// PFDManagementRequest, which identify the applications referred to by the
+// ApplicationID in PDI, following the clause 5.1.2 of TS 29.244.
+//
+// The PFDs are held per application as the PFDContext IEs. An application has
+// a PFD for each PFDContext, and the packet belongs to the application if it
+// matches any of the PFDContents in any of them.
+//
+// PFDs is not safe for concurrent use.
+type PFDs struct {
+	apps map[string]*appPFDs
+}
+
+// appPFDs is the PFDs of an application, with the PFDContents decoded.
+type appPFDs struct {
+	contexts []*ie.IE
+	contents []*pfdContents
+}
+
+// pfdContents is a decoded PFDContents. The domain names are paired with the
+// protocols, which are empty if not given.
+type pfdContents struct {
+	flows     []*ie.FlowDescription
+	urls      []string
+	domains   [][2]string
+	customPFD string
+}
+
+// NewPFDs returns the PFDs with no application.
+func NewPFDs() *PFDs {
+	return &PFDs{apps: map[string]*appPFDs{}}
+}
+
+// Apply applies the PFDManagementRequest to the PFDs as described in the clause
+// 6.2.2 of TS 29.244. The PFDs of the application in each ApplicationIDsPFDs are
+// replaced with the PFDContexts in it, or deleted if it has no PFDContext. All
+// the PFDs are deleted if the message has no ApplicationIDsPFDs.
+//
+// The PFDs are not changed if any of the IEs are invalid. The error returned is
+// *PFDError, or ErrMissingApplicationID if an ApplicationIDsPFDs does not have
+// ApplicationID.
+func (f *PFDs) Apply(m *message.PFDManagementRequest) error {
+	if len(m.ApplicationIDsPFDs) == 0 {
+		f.apps = map[string]*appPFDs{}
+		return nil
+	}
+
+	apps := map[string]*appPFDs{}
+	for _, i := range m.ApplicationIDsPFDs {
+		appID, contexts, err := splitApplicationIDsPFDs(i)
+		if err != nil {
+			return err
+		}
+
+		if len(contexts) == 0 {
+			apps[appID] = nil
+			continue
+		}
+		a, err := newAppPFDs(contexts)
+		if err != nil {
+			return &PFDError{ApplicationID: appID, Err: err}
+		}
+		apps[appID] = a
+	}
+
+	for appID, a := range apps {
+		if a == nil {
+			delete(f.apps, appID)
+			continue
+		}
+		f.apps[appID] = a
+	}
+	return nil
+}
+
+// ApplicationIDs returns the IDs of the applications that have PFDs, in
+// ascending order.
+func (f *PFDs) ApplicationIDs() []string {
+	ids := make([]string, 0, len(f.apps))
+	for id := range f.apps {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// PFDContexts returns the PFDContext IEs of the application, or nil if it has
+// no PFDs.
+func (f *PFDs) PFDContexts(appID string) []*ie.IE {
+	a := f.apps[appID]
+	if a == nil {
+		return nil
+	}
+	return append([]*ie.IE{}, a.contexts...)
+}
+
+// Match reports whether the packet belongs to the application, i.e., it matches
+// any of the PFDContents of the application. Match can be given to
+// ApplicationMatcher to classify the packets with the PFDs.
+//
+// The PFDContents matches if the packet matches any of the components present
+// in it:
+//
+//   - FlowDescription and AdditionalFlowDescription match the IP packet in either
+//     direction, as the PFDs are not bound to a UE address.
+//   - URL and AdditionalURL match if Packet.URL starts with them, ignoring the
+//     scheme, e.g., "http://". The match should end at "/", ":", "?" or the end
+//     of Packet.URL, so that "example.com/a" does not match "example.com/ab".
+//   - DomainName and AdditionalDomainNameAndProtocol match Packet.DomainName or
+//     its subdomains, case-insensitively. The protocol is compared with
+//     Packet.DomainNameProtocol if both are given.
+//   - CustomPFDContent matches Packet.CustomPFDContent exactly.
+func (f *PFDs) Match(appID string, p *Packet) bool {
+	a := f.apps[appID]
+	if a == nil {
+		return false
+	}
+
+	t := flowTuple(p)
+	for _, c := range a.contents {
+		if c.match(p, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Lookup returns the IDs of the applications the packet belongs to, in ascending
+// order. See Match for how the packet is matched.
+func (f *PFDs) Lookup(p *Packet) []string {
+	var ids []string
+	for _, id := range f.ApplicationIDs() {
+		if f.Match(id, p) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (c *pfdContents) match(p *Packet, t *ie.FlowTuple) bool {
+	if p.SrcIP != nil || p.DstIP != nil {
+		r := &ie.FlowTuple{
+			SrcIP: t.DstIP, DstIP: t.SrcIP, Protocol: t.Protocol,
+			SrcPort: t.DstPort, DstPort: t.SrcPort,
+			TOS: t.TOS, SPI: t.SPI, FlowLabel: t.FlowLabel,
+		}
+		for _, fd := range c.flows {
+			if fd.Match(t, nil) || fd.Match(r, nil) {
+				return true
+			}
+		}
+	}
+
+	if p.URL != "" {
+		url := trimScheme(p.URL)
+		for _, u := range c.urls {
+			if hasURLPrefix(url, trimScheme(u)) {
+				return true
+			}
+		}
+	}
+
+	if p.DomainName != "" {
+		dn := normalizeDomainName(p.DomainName)
+		for _, d := range c.domains {
+			name := normalizeDomainName(d[0])
+			if name == "" || (dn != name && !strings.HasSuffix(dn, "."+name)) {
+				continue
+			}
+			if d[1] == "" || p.DomainNameProtocol == "" || strings.EqualFold(d[1], p.DomainNameProtocol) {
+				return true
+			}
+		}
+	}
+
+	return c.customPFD != "" && c.customPFD == p.CustomPFDContent
+}
+
+func newAppPFDs(contexts []*ie.IE) (*appPFDs, error) {
+	a := &appPFDs{contexts: contexts}
+	for _, x := range contexts {
+		if x.Type != ie.PFDContext {
+			return nil, &ie.InvalidTypeError{Type: x.Type}
+		}
+		children, err := x.ValueAsGrouped()
+		if err != nil {
+			return nil, err
+		}
+		for _, y := range children {
+			if y.Type != ie.PFDContents {
+				continue
+			}
+			c, err := newPFDContents(y)
+			if err != nil {
+				return nil, err
+			}
+			a.contents = append(a.contents, c)
+		}
+	}
+	return a, nil
+}
+
+func newPFDContents(i *ie.IE) (*pfdContents, error) {
+	v, err := i.PFDContents()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &pfdContents{}
+	var fds []string
+	if v.HasFD() {
+		fds = append(fds, v.FlowDescription)
+	}
+	if v.HasAFD() {
+		fds = append(fds, v.AdditionalFlowDescription...)
+	}
+	for _, s := range fds {
+		fd, err := ie.ParseFlowDescription(s)
+		if err != nil {
+			return nil, err
+		}
+		c.flows = append(c.flows, fd)
+	}
+
+	if v.HasURL() {
+		c.urls = append(c.urls, v.URL)
+	}
+	if v.HasAURL() {
+		c.urls = append(c.urls, v.AdditionalURL...)
+	}
+
+	if v.HasDN() {
+		var proto string
+		if v.HasDNP() {
+			proto = v.DomainNameProtocol
+		}
+		c.domains = append(c.domains, [2]string{v.DomainName, proto})
+	}
+	// AdditionalDomainNameAndProtocol is the domain names each followed by its
+	// protocol.
+	if v.HasADNP() {
+		l := v.AdditionalDomainNameAndProtocol
+		for n := 0; n < len(l); n += 2 {
+			var proto string
+			if n+1 < len(l) {
+				proto = l[n+1]
+			}
+			c.domains = append(c.domains, [2]string{l[n], proto})
+		}
+	}
+
+	if v.HasCP() {
+		c.customPFD = v.CustomPFDContent
+	}
+	return c, nil
+}
+
+// splitApplicationIDsPFDs returns the ApplicationID and the PFDContext IEs in
+// the ApplicationIDsPFDs.
+func splitApplicationIDsPFDs(i *ie.IE) (string, []*ie.IE, error) {
+	children, err := i.ValueAsGrouped()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var (
+		appID    string
+		found    bool
+		contexts []*ie.IE
+	)
+	for _, c := range children {
+		switch c.Type {
+		case ie.ApplicationID:
+			appID, err = c.ApplicationID()
+			if err != nil {
+				return "", nil, err
+			}
+			found = true
+		case ie.PFDContext:
+			contexts = append(contexts, c)
+		}
+	}
+	if !found {
+		return "", nil, ErrMissingApplicationID
+	}
+	return appID, contexts, nil
+}
+
+// trimScheme returns the URL without the scheme, e.g., "http://".
+func trimScheme(url string) string {
+	if n := strings.Index(url, "://"); n >= 0 {
+		return url[n+3:]
+	}
+	return url
+}
+
+// hasURLPrefix reports whether url starts with prefix at the boundary of the
+// components of the URL.
+func hasURLPrefix(url, prefix string) bool {
+	if !strings.HasPrefix(url, prefix) {
+		return false
+	}
+	if len(url) == len(prefix) || strings.HasSuffix(prefix, "/") {
+		return true
+	}
+	switch url[len(prefix)] {
+	case '/', ':', '?':
+		return true
+	}
+	return false
+}
+
+// normalizeDomainName returns the domain name in lower case without the trailing
+// dot.
+func normalizeDomainName(dn string) string {
+	return strings.ToLower(strings.TrimSuffix(dn, "."))
+}
+
+// PFDDelta returns the ApplicationIDsPFDs IEs to be sent in PFDManagementRequest
+// to change the PFDs from old into next, which map the application IDs to their
+// PFDContext IEs. The applications are in ascending order of the ID.
+//
+// The applications added or changed have all the PFDContexts in next, as they
+// replace the PFDs in the UP function, and the ones removed have only the
+// ApplicationID. The applications not changed are not included.
+//
+// Note that the PFDManagementRequest without ApplicationIDsPFDs deletes all the
+// PFDs, so it should not be sent if PFDDelta returns nothing.
+func PFDDelta(old, next map[string][]*ie.IE) []*ie.IE {
+	ids := map[string]struct{}{}
+	for id := range old {
+		ids[id] = struct{}{}
+	}
+	for id := range next {
+		ids[id] = struct{}{}
+	}
+	sorted := make([]string, 0, len(ids))
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Strings(sorted)
+
+	var ies []*ie.IE
+	for _, id := range sorted {
+		o, n := old[id], next[id]
+		switch {
+		case len(n) == 0 && len(o) == 0:
+		case len(n) == 0:
+			ies = append(ies, ie.NewApplicationIDsPFDs(ie.NewApplicationID(id)))
+		case !sameIEs(o, n):
+			ies = append(ies, ie.NewApplicationIDsPFDs(append([]*ie.IE{ie.NewApplicationID(id)}, n...)...))
+		}
+	}
+	return ies
+}
+
+// NewPFDManagementRequest returns the PFDManagementRequest that has the IEs
+// returned by PFDDelta. The second value is false and the request is nil if
+// the PFDs are not changed, as the request without ApplicationIDsPFDs would
+// delete all the PFDs in the UP function.
+func NewPFDManagementRequest(old, next map[string][]*ie.IE, seq uint32) (*message.PFDManagementRequest, bool) {
+	ies := PFDDelta(old, next)
+	if len(ies) == 0 {
+		return nil, false
+	}
+	return message.NewPFDManagementRequest(seq, ies...), true
+}
@@ -0,0 +1,243 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+	"github.com/aalayanahmad/go-pfcp/session"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newAppPFDs(appID string, contents ...*ie.IE) *ie.IE {
+	ies := []*ie.IE{ie.NewApplicationID(appID)}
+	for _, c := range contents {
+		ies = append(ies, ie.NewPFDContext(c))
+	}
+	return ie.NewApplicationIDsPFDs(ies...)
+}
+
+func mustPFDs(t *testing.T, ies ...*ie.IE) *session.PFDs {
+	t.Helper()
+
+	f := session.NewPFDs()
+	if err := f.Apply(message.NewPFDManagementRequest(1, ies...)); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestPFDsMatch(t *testing.T) {
+	f := mustPFDs(t,
+		newAppPFDs("video",
+			ie.NewPFDContents("permit out 6 from 198.51.100.0/24 443 to any", "", "", "", "", nil, nil, nil),
+			ie.NewPFDContents("", "http://video.example.com/stream", "", "", "", nil, []string{"cdn.example.net/v/"}, nil),
+		),
+		newAppPFDs("chat",
+			ie.NewPFDContents("", "", "chat.example.org", "", "https", nil, nil, []string{"im.example.org", "xmpp"}),
+		),
+		newAppPFDs("custom",
+			ie.NewPFDContents("", "", "", "rule-42", "", nil, nil, nil),
+		),
+	)
+
+	if diff := cmp.Diff([]string{"chat", "custom", "video"}, f.ApplicationIDs()); diff != "" {
+		t.Error(diff)
+	}
+
+	cases := []struct {
+		description string
+		packet      *session.Packet
+		want        []string
+	}{
+		{
+			"FD/downlink",
+			&session.Packet{
+				SourceInterface: ie.SrcInterfaceCore,
+				SrcIP:           net.ParseIP("198.51.100.1"), DstIP: net.ParseIP("10.0.0.1"),
+				Protocol: 6, SrcPort: 443, DstPort: 40000,
+			},
+			[]string{"video"},
+		}, {
+			"FD/uplink",
+			&session.Packet{
+				SourceInterface: ie.SrcInterfaceAccess,
+				SrcIP:           net.ParseIP("10.0.0.1"), DstIP: net.ParseIP("198.51.100.1"),
+				Protocol: 6, SrcPort: 40000, DstPort: 443,
+			},
+			[]string{"video"},
+		}, {
+			"FD/no match",
+			&session.Packet{
+				SourceInterface: ie.SrcInterfaceCore,
+				SrcIP:           net.ParseIP("203.0.113.1"), DstIP: net.ParseIP("10.0.0.1"),
+				Protocol: 6, SrcPort: 443, DstPort: 40000,
+			},
+			nil,
+		}, {
+			"URL",
+			&session.Packet{URL: "https://video.example.com/stream/1.ts"},
+			[]string{"video"},
+		}, {
+			"AdditionalURL",
+			&session.Packet{URL: "http://cdn.example.net/v/2.ts"},
+			[]string{"video"},
+		}, {
+			"URL/no match",
+			&session.Packet{URL: "http://video.example.com/live"},
+			nil,
+		}, {
+			"URL/query",
+			&session.Packet{URL: "http://video.example.com/stream?id=1"},
+			[]string{"video"},
+		}, {
+			"URL/partial component",
+			&session.Packet{URL: "http://video.example.com/streaming"},
+			nil,
+		}, {
+			"DomainName/subdomain",
+			&session.Packet{DomainName: "EU.Chat.example.org.", DomainNameProtocol: "https"},
+			[]string{"chat"},
+		}, {
+			"DomainName/protocol",
+			&session.Packet{DomainName: "chat.example.org", DomainNameProtocol: "xmpp"},
+			nil,
+		}, {
+			"DomainName/suffix",
+			&session.Packet{DomainName: "nochat.example.org"},
+			nil,
+		}, {
+			"AdditionalDomainNameAndProtocol",
+			&session.Packet{DomainName: "im.example.org", DomainNameProtocol: "xmpp"},
+			[]string{"chat"},
+		}, {
+			"CustomPFDContent",
+			&session.Packet{CustomPFDContent: "rule-42"},
+			[]string{"custom"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			if diff := cmp.Diff(c.want, f.Lookup(c.packet)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestPFDsApply(t *testing.T) {
+	video := ie.NewPFDContents("", "", "video.example.com", "", "", nil, nil, nil)
+	chat := ie.NewPFDContents("", "", "chat.example.org", "", "", nil, nil, nil)
+	f := mustPFDs(t, newAppPFDs("video", video), newAppPFDs("chat", chat))
+
+	// the PFDs of the application are replaced, and the others are kept.
+	newVideo := ie.NewPFDContents("", "", "tv.example.com", "", "", nil, nil, nil)
+	if err := f.Apply(message.NewPFDManagementRequest(2, newAppPFDs("video", newVideo))); err != nil {
+		t.Fatal(err)
+	}
+	if f.Match("video", &session.Packet{DomainName: "video.example.com"}) {
+		t.Error("matched the PFD replaced")
+	}
+	if !f.Match("video", &session.Packet{DomainName: "tv.example.com"}) {
+		t.Error("did not match the new PFD")
+	}
+	if diff := cmp.Diff([]*ie.IE{ie.NewPFDContext(chat)}, f.PFDContexts("chat")); diff != "" {
+		t.Error(diff)
+	}
+
+	// the invalid request changes nothing.
+	bad := ie.NewPFDContents("permit out 6 from any to", "", "", "", "", nil, nil, nil)
+	err := f.Apply(message.NewPFDManagementRequest(3, newAppPFDs("chat"), newAppPFDs("video", bad)))
+	var perr *session.PFDError
+	if !errors.As(err, &perr) || perr.ApplicationID != "video" {
+		t.Errorf("got: %v, want: *session.PFDError for video", err)
+	}
+	err = f.Apply(message.NewPFDManagementRequest(3, ie.NewApplicationIDsPFDs(ie.NewPFDContext(video))))
+	if !errors.Is(err, session.ErrMissingApplicationID) {
+		t.Errorf("got: %v, want: %v", err, session.ErrMissingApplicationID)
+	}
+	if diff := cmp.Diff([]string{"chat", "video"}, f.ApplicationIDs()); diff != "" {
+		t.Error(diff)
+	}
+
+	// the application without PFDContext is deleted.
+	if err := f.Apply(message.NewPFDManagementRequest(4, newAppPFDs("chat"))); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"video"}, f.ApplicationIDs()); diff != "" {
+		t.Error(diff)
+	}
+
+	// all the applications are deleted without ApplicationIDsPFDs.
+	if err := f.Apply(message.NewPFDManagementRequest(5)); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.ApplicationIDs(); len(got) != 0 {
+		t.Errorf("got: %v, want: none", got)
+	}
+}
+
+func TestPFDDelta(t *testing.T) {
+	video := ie.NewPFDContext(ie.NewPFDContents("", "", "video.example.com", "", "", nil, nil, nil))
+	tv := ie.NewPFDContext(ie.NewPFDContents("", "", "tv.example.com", "", "", nil, nil, nil))
+	chat := ie.NewPFDContext(ie.NewPFDContents("", "", "chat.example.org", "", "", nil, nil, nil))
+	mail := ie.NewPFDContext(ie.NewPFDContents("", "", "mail.example.org", "", "", nil, nil, nil))
+
+	old := map[string][]*ie.IE{
+		"video": {video},
+		"chat":  {chat},
+		"mail":  {mail},
+	}
+	next := map[string][]*ie.IE{
+		"video": {video, tv},
+		"chat":  {chat},
+		"web":   {tv},
+	}
+
+	want := []*ie.IE{
+		ie.NewApplicationIDsPFDs(ie.NewApplicationID("mail")),
+		ie.NewApplicationIDsPFDs(ie.NewApplicationID("video"), video, tv),
+		ie.NewApplicationIDsPFDs(ie.NewApplicationID("web"), tv),
+	}
+	got := session.PFDDelta(old, next)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+
+	// the delta applied to the UP function makes it have the new PFDs.
+	var ies []*ie.IE
+	for id, contexts := range old {
+		ies = append(ies, ie.NewApplicationIDsPFDs(append([]*ie.IE{ie.NewApplicationID(id)}, contexts...)...))
+	}
+	f := mustPFDs(t, ies...)
+	req, ok := session.NewPFDManagementRequest(old, next, 2)
+	if !ok {
+		t.Fatal("no request for the changed PFDs")
+	}
+	if err := f.Apply(req); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"chat", "video", "web"} {
+		if diff := cmp.Diff(next[id], f.PFDContexts(id)); diff != "" {
+			t.Errorf("%s: %s", id, diff)
+		}
+	}
+	if got := f.PFDContexts("mail"); got != nil {
+		t.Errorf("mail: got: %v, want: nil", got)
+	}
+
+	// no request is made for the same PFDs, as it would delete all of them.
+	if got := session.PFDDelta(next, next); len(got) != 0 {
+		t.Errorf("got: %v, want: none", got)
+	}
+	if req, ok := session.NewPFDManagementRequest(next, next, 3); ok || req != nil {
+		t.Errorf("got: %v, %v, want: nil, false", req, ok)
+	}
+}
@@ -0,0 +1,444 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"math"
+	"time"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+// DefaultAveragingWindow is the averaging window used for the MBR and GBR when
+// AveragingWindow is not present in QER, as defined in TS 23.501 clause 5.7.3.6.
+const DefaultAveragingWindow = 2000 * time.Millisecond
+
+// Verdict is the result of QoS.Check for a packet.
+type Verdict uint8
+
+// Verdict definitions.
+const (
+	// VerdictGuaranteed is given to the packet that passes within the GBR.
+	VerdictGuaranteed Verdict = iota
+	// VerdictPass is given to the packet that passes within the MBR but not
+	// within the GBR, or the packet of the QERs without GBR.
+	VerdictPass
+	VerdictGateClosed
+	VerdictMBRExceeded
+	VerdictPacketRateExceeded
+)
+
+// String returns the name of Verdict.
+func (v Verdict) String() string {
+	switch v {
+	case VerdictGuaranteed:
+		return "guaranteed"
+	case VerdictPass:
+		return "pass"
+	case VerdictGateClosed:
+		return "gate closed"
+	case VerdictMBRExceeded:
+		return "MBR exceeded"
+	case VerdictPacketRateExceeded:
+		return "packet rate exceeded"
+	default:
+		return "unknown"
+	}
+}
+
+// Passed reports whether the packet is allowed to pass.
+func (v Verdict) Passed() bool {
+	return v == VerdictGuaranteed || v == VerdictPass
+}
+
+// QoSOption is an option to change how QoS enforces the QERs.
+type QoSOption func(*qosConfig)
+
+type qosConfig struct {
+	group *CorrelationGroup
+}
+
+// SharedCorrelation makes the QoS use g for the QERs with QERCorrelationID, so
+// that the QERs of the different sessions with the same QERCorrelationID share
+// the rate limits, e.g., for APN-AMBR.
+func SharedCorrelation(g *CorrelationGroup) QoSOption {
+	return func(c *qosConfig) {
+		c.group = g
+	}
+}
+
+// CorrelationGroup holds the rate limits shared by the QERs with the same
+// QERCorrelationID.
+type CorrelationGroup struct {
+	meters map[uint32]*qerMeter
+}
+
+// NewCorrelationGroup returns an empty CorrelationGroup.
+func NewCorrelationGroup() *CorrelationGroup {
+	return &CorrelationGroup{meters: map[uint32]*qerMeter{}}
+}
+
+// QoS enforces the QERs in a Session on the packets, following the clause 5.2.1
+// of TS 29.244.
+//
+// The MBR and GBR of each direction are enforced with the token buckets of the
+// bit rate that can hold the octets sent in the AveragingWindow, or in
+// DefaultAveragingWindow if it is not present. The packets exceeding the MBR
+// are dropped, which means all the packets are dropped if the MBR is 0, and the
+// ones within the GBR are marked as VerdictGuaranteed. The PacketRate limits the
+// number of the packets in each time unit, which starts when the QER is created.
+//
+// The QERs with the same QERCorrelationID share the token buckets and the packet
+// counts, whose parameters are taken from the QER created or updated last. By
+// default they are shared within the Session, and SharedCorrelation extends it
+// to the other sessions.
+//
+// QoS is not safe for concurrent use, including the QoS sharing a
+// CorrelationGroup.
+type QoS struct {
+	qers  map[uint32]*qerState
+	group *CorrelationGroup
+}
+
+type qerState struct {
+	qer   *ie.IE
+	gate  [2]ie.GateStatusValue
+	meter *qerMeter
+}
+
+// qerMeter is the rate limits of a QER, or the QERs with the same
+// QERCorrelationID. The index is 0 for uplink and 1 for downlink.
+type qerMeter struct {
+	mbr, gbr [2]*tokenBucket
+	rate     [2]*packetWindow
+}
+
+// tokenBucket is a token bucket whose tokens are the octets.
+type tokenBucket struct {
+	rate   float64 // octets per second
+	depth  float64
+	tokens float64
+	last   time.Time
+}
+
+// packetWindow counts the packets in the time unit of PacketRate.
+type packetWindow struct {
+	limit uint16
+	unit  time.Duration
+	start time.Time
+	count uint16
+}
+
+// NewQoS returns a QoS that enforces the QERs in s from now.
+func NewQoS(s *Session, now time.Time, opts ...QoSOption) *QoS {
+	cfg := &qosConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.group == nil {
+		cfg.group = NewCorrelationGroup()
+	}
+
+	q := &QoS{qers: map[uint32]*qerState{}, group: cfg.group}
+	q.Update(s, now)
+	return q
+}
+
+// Update makes the QoS follow the QERs in s. The token buckets of the QERs
+// modified keep the tokens up to the new size, and the packet counts are kept
+// as long as the PacketRate is not changed.
+func (q *QoS) Update(s *Session, now time.Time) {
+	for id := range q.qers {
+		if s.QERs[id] == nil {
+			delete(q.qers, id)
+		}
+	}
+
+	for _, id := range s.IDs(RuleQER) {
+		x := q.qers[id]
+		if x == nil {
+			x = &qerState{}
+			q.qers[id] = x
+		} else if x.qer == s.QERs[id] {
+			continue
+		}
+		q.configure(x, s.QERs[id], now)
+	}
+}
+
+// Check decides whether a packet of size octets matched by the PDR at now is
+// allowed to pass the QERs referred to by the PDR, and consumes the tokens and
+// the packet counts if it passes. The direction is uplink if the SourceInterface
+// in the PDI is Access, and downlink otherwise.
+//
+// The packet passes only if it passes all the QERs. If it is dropped, the
+// Verdict tells the reason for the first QER that drops it in the order in the
+// PDR. The packet is VerdictGuaranteed if any of the QERs has the GBR and it is
+// within all the GBRs present.
+func (q *QoS) Check(pdr *ie.IE, size int, now time.Time) Verdict {
+	ids, uplink := refsOf(pdr, ie.QERID)
+	d := 1
+	if uplink {
+		d = 0
+	}
+
+	var meters []*qerMeter
+	for _, id := range ids {
+		x := q.qers[id]
+		if x == nil {
+			continue
+		}
+		if x.gate[d] == ie.GateStatusClosed {
+			return VerdictGateClosed
+		}
+
+		m := x.meter
+		if w := m.rate[d]; w != nil && !w.allows(now) {
+			return VerdictPacketRateExceeded
+		}
+		if b := m.mbr[d]; b != nil && !b.allows(size, now) {
+			return VerdictMBRExceeded
+		}
+
+		// the shared meter is consumed only once.
+		dup := false
+		for _, y := range meters {
+			dup = dup || y == m
+		}
+		if !dup {
+			meters = append(meters, m)
+		}
+	}
+
+	hasGBR, withinGBR := false, true
+	for _, m := range meters {
+		if w := m.rate[d]; w != nil {
+			w.count++
+		}
+		if b := m.mbr[d]; b != nil {
+			b.take(size)
+		}
+		if b := m.gbr[d]; b != nil {
+			hasGBR = true
+			if b.allows(size, now) {
+				b.take(size)
+			} else {
+				withinGBR = false
+			}
+		}
+	}
+
+	if hasGBR && withinGBR {
+		return VerdictGuaranteed
+	}
+	return VerdictPass
+}
+
+// PacketRateStatus returns the PacketRateStatus IE of the QER at now, with the
+// number of the packets allowed in the rest of the current time unit. The
+// validity time is the end of the current time unit, or the earlier one if both
+// directions are limited. It returns nil if the QER is unknown or does not have
+// PacketRate.
+func (q *QoS) PacketRateStatus(id uint32, now time.Time) *ie.IE {
+	x := q.qers[id]
+	if x == nil {
+		return nil
+	}
+
+	var (
+		flags     uint8
+		remaining [2]uint16
+		validity  time.Time
+	)
+	for d, w := range x.meter.rate {
+		if w == nil {
+			continue
+		}
+		w.advance(now)
+		flags |= 1 << d
+		remaining[d] = w.limit - w.count
+		if end := w.start.Add(w.unit); validity.IsZero() || end.Before(validity) {
+			validity = end
+		}
+	}
+	if flags == 0 {
+		return nil
+	}
+	return ie.NewPacketRateStatus(flags, remaining[0], 0, remaining[1], 0, validity)
+}
+
+// QueryPacketRateStatus returns the PacketRateStatusReportWithinSessionModificationResponse
+// IEs of the QERs, as requested by QueryPacketRateStatusWithinSessionModificationRequest.
+// The QERs that are unknown or do not have PacketRate are ignored.
+func (q *QoS) QueryPacketRateStatus(now time.Time, ids ...uint32) []*ie.IE {
+	var reports []*ie.IE
+	for _, id := range ids {
+		if s := q.PacketRateStatus(id, now); s != nil {
+			reports = append(reports, ie.NewPacketRateStatusReportWithinSessionModificationResponse(ie.NewQERID(id), s))
+		}
+	}
+	return reports
+}
+
+// configure reads the parameters in the QER.
+func (q *QoS) configure(x *qerState, qer *ie.IE, now time.Time) {
+	x.qer = qer
+	x.gate = [2]ie.GateStatusValue{}
+
+	var (
+		mbr, gbr   *ie.IE
+		rate       *ie.PacketRateFields
+		window     = DefaultAveragingWindow
+		correlated bool
+		corrID     uint32
+	)
+	children, _ := qer.ValueAsGrouped()
+	for _, c := range children {
+		switch c.Type {
+		case ie.GateStatus:
+			if ul, dl, err := c.GateStatusULDL(); err == nil {
+				x.gate = [2]ie.GateStatusValue{ul, dl}
+			}
+		case ie.MBR:
+			mbr = c
+		case ie.GBR:
+			gbr = c
+		case ie.PacketRate:
+			rate, _ = c.PacketRate()
+		case ie.AveragingWindow:
+			if v, err := c.AveragingWindow(); err == nil {
+				window = time.Duration(v) * time.Millisecond
+			}
+		case ie.QERCorrelationID:
+			if v, err := c.QERCorrelationID(); err == nil {
+				correlated, corrID = true, v
+			}
+		}
+	}
+
+	switch {
+	case correlated:
+		if q.group.meters[corrID] == nil {
+			q.group.meters[corrID] = &qerMeter{}
+		}
+		x.meter = q.group.meters[corrID]
+	case x.meter == nil || x.shared(q.group):
+		x.meter = &qerMeter{}
+	}
+
+	m := x.meter
+	if mbr != nil {
+		ul, _ := mbr.MBRUL()
+		dl, _ := mbr.MBRDL()
+		m.mbr[0] = m.mbr[0].configure(ul, window, now)
+		m.mbr[1] = m.mbr[1].configure(dl, window, now)
+	} else {
+		m.mbr = [2]*tokenBucket{}
+	}
+	if gbr != nil {
+		ul, _ := gbr.GBRUL()
+		dl, _ := gbr.GBRDL()
+		m.gbr[0] = m.gbr[0].configure(ul, window, now)
+		m.gbr[1] = m.gbr[1].configure(dl, window, now)
+	} else {
+		m.gbr = [2]*tokenBucket{}
+	}
+
+	var limits [2]*packetWindow
+	if rate != nil {
+		if rate.Flags&0x01 != 0 {
+			limits[0] = &packetWindow{limit: rate.UplinkPacketRate, unit: timeUnit(rate.UplinkTimeUnit)}
+		}
+		if rate.Flags&0x02 != 0 {
+			limits[1] = &packetWindow{limit: rate.DownlinkPacketRate, unit: timeUnit(rate.DownlinkTimeUnit)}
+		}
+	}
+	for d, w := range limits {
+		cur := m.rate[d]
+		switch {
+		case w == nil:
+			m.rate[d] = nil
+		case cur != nil && cur.limit == w.limit && cur.unit == w.unit:
+		default:
+			w.start = now
+			m.rate[d] = w
+		}
+	}
+}
+
+// shared reports whether the meter of the QER is the one in the group, which
+// the QER no longer uses.
+func (x *qerState) shared(g *CorrelationGroup) bool {
+	for _, m := range g.meters {
+		if m == x.meter {
+			return true
+		}
+	}
+	return false
+}
+
+// configure returns the bucket with the new rate in kbps. The new bucket starts
+// full, and the existing one keeps the tokens up to the new depth.
+func (b *tokenBucket) configure(kbps uint64, window time.Duration, now time.Time) *tokenBucket {
+	rate := float64(kbps) * 1000 / 8
+	depth := rate * window.Seconds()
+	if b == nil {
+		return &tokenBucket{rate: rate, depth: depth, tokens: depth, last: now}
+	}
+
+	b.refill(now)
+	b.rate, b.depth = rate, depth
+	b.tokens = math.Min(b.tokens, depth)
+	return b
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if now.After(b.last) {
+		b.tokens = math.Min(b.depth, b.tokens+b.rate*now.Sub(b.last).Seconds())
+		b.last = now
+	}
+}
+
+// allows reports whether the bucket has the tokens for size octets at now.
+func (b *tokenBucket) allows(size int, now time.Time) bool {
+	b.refill(now)
+	return b.tokens >= float64(size)
+}
+
+func (b *tokenBucket) take(size int) {
+	b.tokens -= float64(size)
+}
+
+// advance moves the window to the time unit that contains now.
+func (w *packetWindow) advance(now time.Time) {
+	if w.unit <= 0 || now.Before(w.start.Add(w.unit)) {
+		return
+	}
+	w.start = w.start.Add(now.Sub(w.start) / w.unit * w.unit)
+	w.count = 0
+}
+
+// allows reports whether another packet is allowed in the time unit at now.
+func (w *packetWindow) allows(now time.Time) bool {
+	w.advance(now)
+	return w.count < w.limit
+}
+
+// timeUnit returns the duration of the Time Unit in PacketRate. The undefined
+// values are interpreted as a minute.
+func timeUnit(u ie.TimeUnitValue) time.Duration {
+	switch u {
+	case ie.TimeUnit6Minutes:
+		return 6 * time.Minute
+	case ie.TimeUnitHour:
+		return time.Hour
+	case ie.TimeUnitDay:
+		return 24 * time.Hour
+	case ie.TimeUnitWeek:
+		return 7 * 24 * time.Hour
+	default:
+		return time.Minute
+	}
+}
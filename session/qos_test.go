@@ -0,0 +1,206 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/session"
+	"github.com/google/go-cmp/cmp"
+)
+
+// newRulePDR returns a CreatePDR matching the packets from src, which refers to
+// the FAR and the other rules given as refs, e.g., QERID and URRID.
+func newRulePDR(id uint16, src ie.SourceInterfaceValue, farID uint32, refs ...*ie.IE) *ie.IE {
+	return ie.NewCreatePDR(append([]*ie.IE{
+		ie.NewPDRID(id),
+		ie.NewPrecedence(100),
+		ie.NewPDI(ie.NewSourceInterface(src)),
+		ie.NewFARID(farID),
+	}, refs...)...)
+}
+
+func TestQoS(t *testing.T) {
+	ul := newRulePDR(1, ie.SrcInterfaceAccess, 1, ie.NewQERID(1))
+	dl := newRulePDR(2, ie.SrcInterfaceCore, 1, ie.NewQERID(1))
+
+	type step struct {
+		at   time.Duration
+		pdr  *ie.IE
+		size int
+		want session.Verdict
+	}
+	cases := []struct {
+		description string
+		qer         *ie.IE
+		steps       []step
+	}{
+		{
+			"MBR",
+			// 1000 octets per second, 2000 octets in the default averaging window.
+			ie.NewCreateQER(ie.NewQERID(1), ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen), ie.NewMBR(8, 0)),
+			[]step{
+				{0, ul, 1500, session.VerdictPass},
+				{0, ul, 600, session.VerdictMBRExceeded},
+				{0, ul, 500, session.VerdictPass},
+				{time.Second, ul, 1000, session.VerdictPass},
+				{time.Second, ul, 1, session.VerdictMBRExceeded},
+				{10 * time.Second, ul, 2000, session.VerdictPass},
+				{10 * time.Second, dl, 1, session.VerdictMBRExceeded},
+			},
+		}, {
+			"MBR/AveragingWindow",
+			ie.NewCreateQER(ie.NewQERID(1), ie.NewMBR(8, 8), ie.NewAveragingWindow(500)),
+			[]step{
+				{0, dl, 500, session.VerdictPass},
+				{0, dl, 1, session.VerdictMBRExceeded},
+				{time.Second, dl, 501, session.VerdictMBRExceeded},
+			},
+		}, {
+			"GBR",
+			ie.NewCreateQER(ie.NewQERID(1), ie.NewMBR(16, 16), ie.NewGBR(8, 8)),
+			[]step{
+				{0, ul, 1500, session.VerdictGuaranteed},
+				{0, ul, 1000, session.VerdictPass},
+				{0, ul, 500, session.VerdictGuaranteed},
+				{0, ul, 1001, session.VerdictMBRExceeded},
+				{0, ul, 1000, session.VerdictPass},
+			},
+		}, {
+			"GateStatus",
+			ie.NewCreateQER(ie.NewQERID(1), ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusClosed)),
+			[]step{
+				{0, ul, 1500, session.VerdictPass},
+				{0, dl, 1500, session.VerdictGateClosed},
+			},
+		}, {
+			"PacketRate",
+			ie.NewCreateQER(ie.NewQERID(1), ie.NewPacketRate(0x01, ie.TimeUnitMinute, 2, 0, 0)),
+			[]step{
+				{0, ul, 100, session.VerdictPass},
+				{30 * time.Second, ul, 100, session.VerdictPass},
+				{59 * time.Second, ul, 100, session.VerdictPacketRateExceeded},
+				{59 * time.Second, dl, 100, session.VerdictPass},
+				{60 * time.Second, ul, 100, session.VerdictPass},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			q := session.NewQoS(mustSession(t, ul, dl, newFAR(1, 1), c.qer), t0)
+
+			for n, s := range c.steps {
+				if got := q.Check(s.pdr, s.size, t0.Add(s.at)); got != s.want {
+					t.Errorf("step %d: got: %v, want: %v", n, got, s.want)
+				}
+			}
+		})
+	}
+}
+
+func TestQoSPacketRateStatus(t *testing.T) {
+	ul := newRulePDR(1, ie.SrcInterfaceAccess, 1, ie.NewQERID(1))
+	s := mustSession(t, ul, newFAR(1, 1),
+		ie.NewCreateQER(ie.NewQERID(1), ie.NewPacketRate(0x03, ie.TimeUnitMinute, 10, ie.TimeUnitHour, 100)),
+		ie.NewCreateQER(ie.NewQERID(2), ie.NewMBR(8, 8)),
+	)
+	q := session.NewQoS(s, t0)
+
+	q.Check(ul, 100, t0)
+	q.Check(ul, 100, t0.Add(10*time.Second))
+
+	got := q.QueryPacketRateStatus(t0.Add(30*time.Second), 1, 2, 3)
+	want := []*ie.IE{
+		ie.NewPacketRateStatusReportWithinSessionModificationResponse(
+			ie.NewQERID(1),
+			ie.NewPacketRateStatus(0x03, 8, 0, 100, 0, t0.Add(time.Minute)),
+		),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+
+	want = []*ie.IE{
+		ie.NewPacketRateStatusReportWithinSessionModificationResponse(
+			ie.NewQERID(1),
+			ie.NewPacketRateStatus(0x03, 10, 0, 100, 0, t0.Add(3*time.Minute)),
+		),
+	}
+	if diff := cmp.Diff(want, q.QueryPacketRateStatus(t0.Add(150*time.Second), 1)); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestQoSCorrelation(t *testing.T) {
+	newSession := func() (*session.Session, *ie.IE) {
+		ul := newRulePDR(1, ie.SrcInterfaceAccess, 1, ie.NewQERID(1))
+		return mustSession(t, ul, newFAR(1, 1),
+			ie.NewCreateQER(ie.NewQERID(1), ie.NewQERCorrelationID(7), ie.NewMBR(8, 8)),
+		), ul
+	}
+
+	s1, ul1 := newSession()
+	s2, ul2 := newSession()
+
+	// not shared by default.
+	q1, q2 := session.NewQoS(s1, t0), session.NewQoS(s2, t0)
+	if got := q1.Check(ul1, 1500, t0); got != session.VerdictPass {
+		t.Errorf("got: %v, want: %v", got, session.VerdictPass)
+	}
+	if got := q2.Check(ul2, 1500, t0); got != session.VerdictPass {
+		t.Errorf("got: %v, want: %v", got, session.VerdictPass)
+	}
+
+	g := session.NewCorrelationGroup()
+	q1, q2 = session.NewQoS(s1, t0, session.SharedCorrelation(g)), session.NewQoS(s2, t0, session.SharedCorrelation(g))
+	if got := q1.Check(ul1, 1500, t0); got != session.VerdictPass {
+		t.Errorf("got: %v, want: %v", got, session.VerdictPass)
+	}
+	if got := q2.Check(ul2, 1500, t0); got != session.VerdictMBRExceeded {
+		t.Errorf("got: %v, want: %v", got, session.VerdictMBRExceeded)
+	}
+
+	// the QER created again without QERCorrelationID no longer shares the rate.
+	if err := s2.Apply(newModification(ie.NewRemoveQER(ie.NewQERID(1)), ie.NewCreateQER(ie.NewQERID(1), ie.NewMBR(8, 8)))); err != nil {
+		t.Fatal(err)
+	}
+	q2.Update(s2, t0)
+	if got := q2.Check(ul2, 1500, t0); got != session.VerdictPass {
+		t.Errorf("got: %v, want: %v", got, session.VerdictPass)
+	}
+}
+
+func TestQoSUpdate(t *testing.T) {
+	ul := newRulePDR(1, ie.SrcInterfaceAccess, 1, ie.NewQERID(1))
+	s := mustSession(t, ul, newFAR(1, 1), ie.NewCreateQER(ie.NewQERID(1), ie.NewMBR(8, 8)))
+	q := session.NewQoS(s, t0)
+
+	if got := q.Check(ul, 1500, t0); got != session.VerdictPass {
+		t.Errorf("got: %v, want: %v", got, session.VerdictPass)
+	}
+
+	// the tokens left are kept with the new rate.
+	if err := s.Apply(newModification(ie.NewUpdateQER(ie.NewQERID(1), ie.NewMBR(80, 80)))); err != nil {
+		t.Fatal(err)
+	}
+	q.Update(s, t0)
+	if got := q.Check(ul, 501, t0); got != session.VerdictMBRExceeded {
+		t.Errorf("got: %v, want: %v", got, session.VerdictMBRExceeded)
+	}
+	if got := q.Check(ul, 10500, t0.Add(time.Second)); got != session.VerdictPass {
+		t.Errorf("got: %v, want: %v", got, session.VerdictPass)
+	}
+
+	if err := s.Apply(newModification(ie.NewUpdateQER(ie.NewQERID(1), ie.NewGateStatus(ie.GateStatusClosed, ie.GateStatusOpen)))); err != nil {
+		t.Fatal(err)
+	}
+	q.Update(s, t0.Add(time.Second))
+	if got := q.Check(ul, 1, t0.Add(time.Second)); got != session.VerdictGateClosed {
+		t.Errorf("got: %v, want: %v", got, session.VerdictGateClosed)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+// RuleType is the type of a rule in a session.
+type RuleType uint8
+
+// RuleType definitions.
+//
+// The values of PDR to BAR are the same as the Rule ID Type in FailedRuleID IE.
+const (
+	RulePDR RuleType = iota
+	RuleFAR
+	RuleQER
+	RuleURR
+	RuleBAR
+	RuleMAR
+	RuleSRR
+	RuleTrafficEndpoint
+
+	numRuleTypes
+)
+
+// RuleTypes is all the RuleTypes, in the order of the fields in Session.
+var RuleTypes = []RuleType{
+	RulePDR, RuleFAR, RuleQER, RuleURR, RuleBAR, RuleMAR, RuleSRR, RuleTrafficEndpoint,
+}
+
+// ruleKind describes the IEs used for a type of rule.
+type ruleKind struct {
+	name   string
+	id     uint16
+	create uint16
+	update uint16
+	remove uint16
+}
+
+var ruleKinds = [numRuleTypes]ruleKind{
+	RulePDR:             {"PDR", ie.PDRID, ie.CreatePDR, ie.UpdatePDR, ie.RemovePDR},
+	RuleFAR:             {"FAR", ie.FARID, ie.CreateFAR, ie.UpdateFAR, ie.RemoveFAR},
+	RuleQER:             {"QER", ie.QERID, ie.CreateQER, ie.UpdateQER, ie.RemoveQER},
+	RuleURR:             {"URR", ie.URRID, ie.CreateURR, ie.UpdateURR, ie.RemoveURR},
+	RuleBAR:             {"BAR", ie.BARID, ie.CreateBAR, ie.UpdateBARWithinSessionModificationRequest, ie.RemoveBAR},
+	RuleMAR:             {"MAR", ie.MARID, ie.CreateMAR, ie.UpdateMAR, ie.RemoveMAR},
+	RuleSRR:             {"SRR", ie.SRRID, ie.CreateSRR, ie.UpdateSRR, ie.RemoveSRR},
+	RuleTrafficEndpoint: {"TrafficEndpoint", ie.TrafficEndpointID, ie.CreateTrafficEndpoint, ie.UpdateTrafficEndpoint, ie.RemoveTrafficEndpoint},
+}
+
+// String returns the name of the RuleType, e.g., "PDR".
+func (t RuleType) String() string {
+	if t >= numRuleTypes {
+		return fmt.Sprintf("RuleType(%d)", uint8(t))
+	}
+	return ruleKinds[t].name
+}
+
+// IDType returns the type of the IE that identifies the rule, e.g., ie.PDRID.
+func (t RuleType) IDType() uint16 {
+	if t >= numRuleTypes {
+		return 0
+	}
+	return ruleKinds[t].id
+}
+
+// ruleOf returns the RuleType that the IE type creates, updates or removes.
+func ruleOf(itype uint16) (RuleType, bool) {
+	for n, k := range ruleKinds {
+		switch itype {
+		case k.create, k.update, k.remove:
+			return RuleType(n), true
+		}
+	}
+	return 0, false
+}
+
+// Error definitions.
+var (
+	ErrRuleExists   = errors.New("rule already exists")
+	ErrRuleNotFound = errors.New("rule not found")
+	ErrMissingID    = errors.New("missing rule ID")
+
+	ErrUnsupportedMessage = errors.New("unsupported message")
+)
+
+// RuleError indicates that a rule could not be created, updated or removed.
+type RuleError struct {
+	Type RuleType
+	ID   uint32
+	Err  error
+}
+
+// Error returns message with the rule and the cause.
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("failed to apply %s %d: %v", e.Type, e.ID, e.Err)
+}
+
+// Unwrap returns the cause.
+func (e *RuleError) Unwrap() error {
+	return e.Err
+}
+
+// RuleID returns the ID of the rule in the grouped IE, e.g., PDRID in CreatePDR.
+// The second value is false if the IE does not have the ID.
+func RuleID(t RuleType, i *ie.IE) (uint32, bool) {
+	if i == nil || t >= numRuleTypes {
+		return 0, false
+	}
+
+	children, err := i.ValueAsGrouped()
+	if err != nil {
+		return 0, false
+	}
+	for _, c := range children {
+		if c.Type != ruleKinds[t].id || len(c.Payload) == 0 || len(c.Payload) > 4 {
+			continue
+		}
+
+		return uintOf(c.Payload), true
+	}
+	return 0, false
+}
+
+// uintOf returns the rule ID encoded in b in big endian.
+func uintOf(b []byte) uint32 {
+	var v uint32
+	for _, x := range b {
+		v = v<<8 | uint32(x)
+	}
+	return v
+}
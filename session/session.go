@@ -0,0 +1,390 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+)
+
+// Session is the set of rules in a PFCP session, as the UP function sees it.
+//
+// The rules are indexed by the rule ID, and each of them is held as the Create
+// IE of the rule (e.g., CreatePDR), which reflects all the updates applied so far.
+// The IEs given to Apply are copied, and the IEs held in Session are never
+// modified in place, so they can be shared with the other Sessions. The IEs
+// returned by Rule and Rules should not be modified either.
+type Session struct {
+	NodeID  *ie.IE
+	CPFSEID *ie.IE
+
+	PDRs             map[uint32]*ie.IE
+	FARs             map[uint32]*ie.IE
+	QERs             map[uint32]*ie.IE
+	URRs             map[uint32]*ie.IE
+	BARs             map[uint32]*ie.IE
+	MARs             map[uint32]*ie.IE
+	SRRs             map[uint32]*ie.IE
+	TrafficEndpoints map[uint32]*ie.IE
+
+	filters *filterCache
+}
+
+// New returns a Session with no rules.
+func New() *Session {
+	s := &Session{filters: newFilterCache()}
+	s.reset()
+	return s
+}
+
+func (s *Session) reset() {
+	s.PDRs = map[uint32]*ie.IE{}
+	s.FARs = map[uint32]*ie.IE{}
+	s.QERs = map[uint32]*ie.IE{}
+	s.URRs = map[uint32]*ie.IE{}
+	s.BARs = map[uint32]*ie.IE{}
+	s.MARs = map[uint32]*ie.IE{}
+	s.SRRs = map[uint32]*ie.IE{}
+	s.TrafficEndpoints = map[uint32]*ie.IE{}
+}
+
+// Rules returns the rules of the given type indexed by the rule ID.
+// The map returned is the one in Session, not a copy.
+func (s *Session) Rules(t RuleType) map[uint32]*ie.IE {
+	switch t {
+	case RulePDR:
+		return s.PDRs
+	case RuleFAR:
+		return s.FARs
+	case RuleQER:
+		return s.QERs
+	case RuleURR:
+		return s.URRs
+	case RuleBAR:
+		return s.BARs
+	case RuleMAR:
+		return s.MARs
+	case RuleSRR:
+		return s.SRRs
+	case RuleTrafficEndpoint:
+		return s.TrafficEndpoints
+	default:
+		return nil
+	}
+}
+
+func (s *Session) setRules(t RuleType, rules map[uint32]*ie.IE) {
+	switch t {
+	case RulePDR:
+		s.PDRs = rules
+	case RuleFAR:
+		s.FARs = rules
+	case RuleQER:
+		s.QERs = rules
+	case RuleURR:
+		s.URRs = rules
+	case RuleBAR:
+		s.BARs = rules
+	case RuleMAR:
+		s.MARs = rules
+	case RuleSRR:
+		s.SRRs = rules
+	case RuleTrafficEndpoint:
+		s.TrafficEndpoints = rules
+	}
+}
+
+// Rule returns the rule of the given type and ID, or nil if it does not exist.
+func (s *Session) Rule(t RuleType, id uint32) *ie.IE {
+	return s.Rules(t)[id]
+}
+
+// IDs returns the IDs of the rules of the given type in ascending order.
+func (s *Session) IDs(t RuleType) []uint32 {
+	rules := s.Rules(t)
+	ids := make([]uint32, 0, len(rules))
+	for id := range rules {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Clone returns a copy of the Session. The IEs are shared, as they are never
+// modified in place.
+func (s *Session) Clone() *Session {
+	c := &Session{NodeID: s.NodeID, CPFSEID: s.CPFSEID, filters: newFilterCache()}
+	for _, t := range RuleTypes {
+		rules := make(map[uint32]*ie.IE, len(s.Rules(t)))
+		for id, i := range s.Rules(t) {
+			rules[id] = i
+		}
+		c.setRules(t, rules)
+	}
+	return c
+}
+
+// Apply applies the SessionEstablishmentRequest or SessionModificationRequest to
+// the Session, following the clause 7.5.4 of TS 29.244.
+// They can also be given as *message.Generic, e.g., the one returned by
+// NewModificationRequest.
+//
+// SessionEstablishmentRequest replaces all the rules with the ones created in it.
+// SessionModificationRequest removes, creates and then updates the rules, so that
+// a rule can be re-created with the same ID and an updated rule can refer to the
+// rules created in the same message. An Update IE only replaces the IEs present in
+// it, and the nested Update IEs such as UpdateForwardingParameters are merged into
+// the existing ones in the same way.
+//
+// If any of the rules cannot be applied, the error is returned as *RuleError and
+// the Session is left unchanged.
+func (s *Session) Apply(m message.Message) error {
+	next := s.Clone()
+
+	switch messageTypeOf(m) {
+	case message.MsgTypeSessionEstablishmentRequest:
+		next.reset()
+		if err := next.applyAll(message.TopLevelIEs(m), opCreate); err != nil {
+			return err
+		}
+	case message.MsgTypeSessionModificationRequest:
+		ies := message.TopLevelIEs(m)
+		for _, op := range []int{opRemove, opCreate, opUpdate} {
+			if err := next.applyAll(ies, op); err != nil {
+				return err
+			}
+		}
+	default:
+		return ErrUnsupportedMessage
+	}
+
+	*s = *next
+	return nil
+}
+
+// messageTypeOf returns the type of the messages supported by Apply, which are
+// the typed ones and *message.Generic, or 0 for the others.
+func messageTypeOf(m message.Message) uint8 {
+	switch m.(type) {
+	case *message.SessionEstablishmentRequest, *message.SessionModificationRequest, *message.Generic:
+		return m.MessageType()
+	default:
+		return 0
+	}
+}
+
+const (
+	opRemove = iota
+	opCreate
+	opUpdate
+)
+
+// applyAll applies the IEs of the given operation in ies.
+func (s *Session) applyAll(ies []*ie.IE, op int) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		if op == opCreate {
+			switch i.Type {
+			case ie.NodeID:
+				s.NodeID = i.Clone()
+				continue
+			case ie.FSEID:
+				s.CPFSEID = i.Clone()
+				continue
+			}
+		}
+
+		t, ok := ruleOf(i.Type)
+		if !ok {
+			continue
+		}
+		k := ruleKinds[t]
+
+		var err error
+		switch {
+		case op == opRemove && i.Type == k.remove:
+			err = s.remove(t, i)
+		case op == opCreate && i.Type == k.create:
+			err = s.create(t, i)
+		case op == opUpdate && i.Type == k.update:
+			err = s.update(t, i)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Session) create(t RuleType, i *ie.IE) error {
+	id, ok := RuleID(t, i)
+	if !ok {
+		return &RuleError{Type: t, Err: ErrMissingID}
+	}
+
+	rules := s.Rules(t)
+	if _, ok := rules[id]; ok {
+		return &RuleError{Type: t, ID: id, Err: ErrRuleExists}
+	}
+	// copy not to be changed by the caller who edits the IE after Apply.
+	rules[id] = i.Clone()
+	return nil
+}
+
+func (s *Session) remove(t RuleType, i *ie.IE) error {
+	id, ok := RuleID(t, i)
+	if !ok {
+		return &RuleError{Type: t, Err: ErrMissingID}
+	}
+
+	rules := s.Rules(t)
+	if _, ok := rules[id]; !ok {
+		return &RuleError{Type: t, ID: id, Err: ErrRuleNotFound}
+	}
+	delete(rules, id)
+	return nil
+}
+
+func (s *Session) update(t RuleType, i *ie.IE) error {
+	id, ok := RuleID(t, i)
+	if !ok {
+		return &RuleError{Type: t, Err: ErrMissingID}
+	}
+
+	rules := s.Rules(t)
+	cur, ok := rules[id]
+	if !ok {
+		return &RuleError{Type: t, ID: id, Err: ErrRuleNotFound}
+	}
+
+	merged, err := merge(cur, i.Clone())
+	if err != nil {
+		return &RuleError{Type: t, ID: id, Err: err}
+	}
+	rules[id] = merged
+	return nil
+}
+
+// updateTargets is the nested Update IEs that are merged into the IE of the
+// other type, e.g., UpdateForwardingParameters into ForwardingParameters.
+var updateTargets = map[uint16]uint16{
+	ie.UpdateForwardingParameters:                     ie.ForwardingParameters,
+	ie.UpdateDuplicatingParameters:                    ie.DuplicatingParameters,
+	ie.UpdateTGPPAccessForwardingActionInformation:    ie.TGPPAccessForwardingActionInformation,
+	ie.UpdateNonTGPPAccessForwardingActionInformation: ie.NonTGPPAccessForwardingActionInformation,
+}
+
+// transientIETypes is the IEs in the Update IEs that instruct the UP function
+// rather than being a part of the rule.
+var transientIETypes = map[uint16]bool{
+	ie.PFCPSMReqFlags: true,
+}
+
+// merge returns the IE of the same type as cur, whose children are updated by
+// the children of upd.
+//
+// The children in cur are replaced by the children of the same type in upd at the
+// same position, and the ones not present in upd are kept as they are.
+func merge(cur, upd *ie.IE) (*ie.IE, error) {
+	cc, err := cur.ValueAsGrouped()
+	if err != nil {
+		return nil, err
+	}
+	// copy not to modify the children of cur through append.
+	children := append([]*ie.IE{}, cc...)
+
+	updates, err := upd.ValueAsGrouped()
+	if err != nil {
+		return nil, err
+	}
+
+	// the position to insert the next child of the type replaced.
+	replaced := map[uint16]int{}
+	for _, u := range updates {
+		if transientIETypes[u.Type] {
+			continue
+		}
+
+		switch u.Type {
+		case ie.ActivatePredefinedRules:
+			if indexOf(children, u) < 0 {
+				children = append(children, u)
+			}
+			continue
+		case ie.DeactivatePredefinedRules:
+			a := &ie.IE{Type: ie.ActivatePredefinedRules, Payload: u.Payload}
+			if n := indexOf(children, a); n >= 0 {
+				children = append(children[:n], children[n+1:]...)
+			}
+			continue
+		}
+
+		if target, ok := updateTargets[u.Type]; ok {
+			n := indexOfType(children, target)
+			if n < 0 {
+				m, err := merge(ie.NewGroupedIE(target), u)
+				if err != nil {
+					return nil, err
+				}
+				children = append(children, m)
+				continue
+			}
+
+			m, err := merge(children[n], u)
+			if err != nil {
+				return nil, err
+			}
+			children[n] = m
+			continue
+		}
+
+		pos, ok := replaced[u.Type]
+		if !ok {
+			if pos = indexOfType(children, u.Type); pos < 0 {
+				pos = len(children)
+			}
+			children = dropType(children, u.Type)
+		}
+		children = append(children[:pos], append([]*ie.IE{u}, children[pos:]...)...)
+		replaced[u.Type] = pos + 1
+	}
+
+	return ie.NewGroupedIE(cur.Type, children...), nil
+}
+
+func indexOf(ies []*ie.IE, x *ie.IE) int {
+	for n, i := range ies {
+		if i.Type == x.Type && bytes.Equal(i.Payload, x.Payload) {
+			return n
+		}
+	}
+	return -1
+}
+
+func indexOfType(ies []*ie.IE, itype uint16) int {
+	for n, i := range ies {
+		if i.Type == itype {
+			return n
+		}
+	}
+	return -1
+}
+
+// dropType returns a new slice without the IEs of the given type.
+func dropType(ies []*ie.IE, itype uint16) []*ie.IE {
+	ret := make([]*ie.IE, 0, len(ies))
+	for _, i := range ies {
+		if i.Type != itype {
+			ret = append(ret, i)
+		}
+	}
+	return ret
+}
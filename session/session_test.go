@@ -0,0 +1,293 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/message"
+	"github.com/aalayanahmad/go-pfcp/session"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newPDR(id uint16, farID uint32) *ie.IE {
+	return ie.NewCreatePDR(
+		ie.NewPDRID(id),
+		ie.NewPrecedence(100),
+		ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceAccess)),
+		ie.NewFARID(farID),
+	)
+}
+
+func newFAR(id uint32, teid uint32) *ie.IE {
+	return ie.NewCreateFAR(
+		ie.NewFARID(id),
+		ie.NewApplyActionFlags(ie.ApplyActionFORW),
+		ie.NewForwardingParameters(
+			ie.NewDestinationInterface(ie.DstInterfaceAccess),
+			ie.NewOuterHeaderCreation(0x0100, teid, "127.0.0.1", "", 0, 0, 0),
+		),
+	)
+}
+
+func newEstablishment(ies ...*ie.IE) message.Message {
+	ies = append([]*ie.IE{
+		ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+		ie.NewFSEID(0x11111111, nil, nil),
+	}, ies...)
+	return message.NewSessionEstablishmentRequest(0, 0, 0, 1, 0, ies...)
+}
+
+func newModification(ies ...*ie.IE) message.Message {
+	return message.NewSessionModificationRequest(0, 0, 0x22222222, 2, 0, ies...)
+}
+
+func TestApply(t *testing.T) {
+	cases := []struct {
+		description string
+		msgs        []message.Message
+		ruleType    session.RuleType
+		id          uint32
+		want        *ie.IE
+		wantIDs     []uint32
+	}{
+		{
+			"Establishment",
+			[]message.Message{newEstablishment(newPDR(1, 1), newFAR(1, 1), newFAR(2, 2))},
+			session.RuleFAR, 2,
+			newFAR(2, 2),
+			[]uint32{1, 2},
+		}, {
+			"Establishment/Reset",
+			[]message.Message{
+				newEstablishment(newPDR(1, 1), newFAR(1, 1), newFAR(2, 2)),
+				newEstablishment(newPDR(1, 3), newFAR(3, 3)),
+			},
+			session.RuleFAR, 3,
+			newFAR(3, 3),
+			[]uint32{3},
+		}, {
+			"Modification/Create",
+			[]message.Message{
+				newEstablishment(newPDR(1, 1), newFAR(1, 1)),
+				newModification(newFAR(2, 2)),
+			},
+			session.RuleFAR, 2,
+			newFAR(2, 2),
+			[]uint32{1, 2},
+		}, {
+			"Modification/Remove",
+			[]message.Message{
+				newEstablishment(newPDR(1, 1), newFAR(1, 1), newFAR(2, 2)),
+				newModification(ie.NewRemoveFAR(ie.NewFARID(2))),
+			},
+			session.RuleFAR, 2,
+			nil,
+			[]uint32{1},
+		}, {
+			"Modification/RemoveAndCreate",
+			[]message.Message{
+				newEstablishment(newPDR(1, 1), newFAR(1, 1)),
+				newModification(newFAR(1, 5), ie.NewRemoveFAR(ie.NewFARID(1))),
+			},
+			session.RuleFAR, 1,
+			newFAR(1, 5),
+			[]uint32{1},
+		}, {
+			"Modification/UpdatePDR",
+			[]message.Message{
+				newEstablishment(newPDR(1, 1), newFAR(1, 1)),
+				newModification(
+					ie.NewUpdatePDR(ie.NewPDRID(1), ie.NewFARID(2)),
+					newFAR(2, 2),
+				),
+			},
+			session.RulePDR, 1,
+			ie.NewCreatePDR(
+				ie.NewPDRID(1),
+				ie.NewPrecedence(100),
+				ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceAccess)),
+				ie.NewFARID(2),
+			),
+			[]uint32{1},
+		}, {
+			"Modification/UpdateForwardingParameters",
+			[]message.Message{
+				newEstablishment(newPDR(1, 1), newFAR(1, 1)),
+				newModification(
+					ie.NewUpdateFAR(
+						ie.NewFARID(1),
+						ie.NewUpdateForwardingParameters(
+							ie.NewOuterHeaderCreation(0x0100, 9, "127.0.0.2", "", 0, 0, 0),
+							ie.NewPFCPSMReqFlagSet(ie.PFCPSMReqFlagsSNDEM),
+						),
+					),
+				),
+			},
+			session.RuleFAR, 1,
+			ie.NewCreateFAR(
+				ie.NewFARID(1),
+				ie.NewApplyActionFlags(ie.ApplyActionFORW),
+				ie.NewForwardingParameters(
+					ie.NewDestinationInterface(ie.DstInterfaceAccess),
+					ie.NewOuterHeaderCreation(0x0100, 9, "127.0.0.2", "", 0, 0, 0),
+				),
+			),
+			[]uint32{1},
+		}, {
+			"Modification/UpdateForwardingParameters/NoForwardingParameters",
+			[]message.Message{
+				newEstablishment(newPDR(1, 1), ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyActionFlags(ie.ApplyActionDROP))),
+				newModification(
+					ie.NewUpdateFAR(
+						ie.NewFARID(1),
+						ie.NewApplyActionFlags(ie.ApplyActionFORW),
+						ie.NewUpdateForwardingParameters(ie.NewDestinationInterface(ie.DstInterfaceCore)),
+					),
+				),
+			},
+			session.RuleFAR, 1,
+			ie.NewCreateFAR(
+				ie.NewFARID(1),
+				ie.NewApplyActionFlags(ie.ApplyActionFORW),
+				ie.NewForwardingParameters(ie.NewDestinationInterface(ie.DstInterfaceCore)),
+			),
+			[]uint32{1},
+		}, {
+			"Modification/PredefinedRules",
+			[]message.Message{
+				newEstablishment(
+					ie.NewCreatePDR(
+						ie.NewPDRID(1),
+						ie.NewActivatePredefinedRules("a"),
+						ie.NewActivatePredefinedRules("b"),
+					),
+					newFAR(1, 1),
+				),
+				newModification(
+					ie.NewUpdatePDR(
+						ie.NewPDRID(1),
+						ie.NewDeactivatePredefinedRules("a"),
+						ie.NewActivatePredefinedRules("c"),
+					),
+				),
+			},
+			session.RulePDR, 1,
+			ie.NewCreatePDR(
+				ie.NewPDRID(1),
+				ie.NewActivatePredefinedRules("b"),
+				ie.NewActivatePredefinedRules("c"),
+			),
+			[]uint32{1},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			s := session.New()
+			for _, m := range c.msgs {
+				if err := s.Apply(m); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if diff := cmp.Diff(c.want, s.Rule(c.ruleType, c.id)); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(c.wantIDs, s.IDs(c.ruleType)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestApplyError(t *testing.T) {
+	cases := []struct {
+		description string
+		msg         message.Message
+		want        *session.RuleError
+	}{
+		{
+			"DuplicatedFAR",
+			newModification(newFAR(1, 2)),
+			&session.RuleError{Type: session.RuleFAR, ID: 1, Err: session.ErrRuleExists},
+		}, {
+			"UnknownFAR/Update",
+			newModification(ie.NewUpdateFAR(ie.NewFARID(3), ie.NewApplyActionFlags(ie.ApplyActionDROP))),
+			&session.RuleError{Type: session.RuleFAR, ID: 3, Err: session.ErrRuleNotFound},
+		}, {
+			"UnknownPDR/Remove",
+			newModification(newFAR(2, 2), ie.NewRemovePDR(ie.NewPDRID(2))),
+			&session.RuleError{Type: session.RulePDR, ID: 2, Err: session.ErrRuleNotFound},
+		}, {
+			"MissingID",
+			newModification(ie.NewCreateQER(ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen))),
+			&session.RuleError{Type: session.RuleQER, Err: session.ErrMissingID},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			s := session.New()
+			if err := s.Apply(newEstablishment(newPDR(1, 1), newFAR(1, 1))); err != nil {
+				t.Fatal(err)
+			}
+
+			err := s.Apply(c.msg)
+			var re *session.RuleError
+			if !errors.As(err, &re) {
+				t.Fatalf("got %v, want *RuleError", err)
+			}
+			if diff := cmp.Diff(*c.want, *re, cmp.Comparer(func(x, y error) bool { return x == y })); diff != "" {
+				t.Error(diff)
+			}
+
+			// the Session should be left unchanged.
+			if diff := cmp.Diff([]uint32{1}, s.IDs(session.RuleFAR)); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(newFAR(1, 1), s.Rule(session.RuleFAR, 1)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+
+	if err := session.New().Apply(message.NewHeartbeatRequest(1, nil, nil)); !errors.Is(err, session.ErrUnsupportedMessage) {
+		t.Errorf("got %v, want ErrUnsupportedMessage", err)
+	}
+}
+
+func TestApplyCopy(t *testing.T) {
+	pdr := ie.NewCreatePDR(
+		ie.NewPDRID(1),
+		ie.NewPrecedence(100),
+		ie.NewPDI(
+			ie.NewSourceInterface(ie.SrcInterfaceCore),
+			ie.NewSDFFilter("permit out 17 from 192.0.2.1 to any", "", "", "", 0),
+		),
+		ie.NewFARID(1),
+	)
+	s := mustSession(t, pdr, newFAR(1, 1))
+	want := pdr.Clone()
+
+	p := &session.Packet{SourceInterface: ie.SrcInterfaceCore, SrcIP: net.ParseIP("192.0.2.1"), Protocol: 17}
+	if _, ok := s.Classify(p); !ok {
+		t.Fatal("no PDR matched")
+	}
+
+	// the IE edited by the caller after Apply does not change the Session.
+	if err := pdr.ReplaceAt(ie.NewSDFFilter("permit out 6 from 192.0.2.1 to any", "", "", "", 0), 2, 1); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, s.Rule(session.RulePDR, 1)); diff != "" {
+		t.Error(diff)
+	}
+	if _, ok := s.Classify(p); !ok {
+		t.Error("no PDR matched after the IE given to Apply is edited")
+	}
+}
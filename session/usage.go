@@ -0,0 +1,498 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"sort"
+	"time"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+)
+
+// Usage measures the traffic of the PDRs in a Session per URR, and generates the
+// usage reports when the reporting triggers of the URRs are met, following the
+// clause 5.2.2 of TS 29.244.
+//
+// The usage is counted with Count and CountEvent as the PDRs match the packets,
+// and the time-based triggers are evaluated with Tick, which is expected to be
+// called periodically. Update should be called every time the Session is
+// modified to follow the changes in the URRs.
+//
+// The following reporting triggers are supported: PERIO, VOLTH, VOLQU, TIMTH,
+// TIMQU, EVETH, EVEQU and QUHTI. When the MonitoringTime is reached, the
+// measurement is split at it: the report with the MONIT trigger has the usage
+// before the MonitoringTime as its EndTime, with the BEF flag in UsageInformation,
+// and the usage after it is in the next reports, with the AFT flag. The reports
+// generated by the methods other than Update, Query and Terminate are
+// UsageReportWithinSessionReportRequest.
+//
+// Usage is not safe for concurrent use.
+type Usage struct {
+	urrs map[uint32]*urrUsage
+}
+
+// urrUsage is the parameters and the measurement of a URR.
+type urrUsage struct {
+	urr *ie.IE
+	id  uint32
+
+	method        ie.MeasurementMethodFlags
+	triggers      ie.ReportingTriggersFlags
+	period        time.Duration
+	volThreshold  *ie.VolumeThresholdFields
+	volQuota      *ie.VolumeQuotaFields
+	timeThreshold time.Duration
+	timeQuota     time.Duration
+	evThreshold   uint32
+	evQuota       uint32
+	holdingTime   time.Duration
+	inactivity    time.Duration
+	monitoring    time.Time
+
+	// the VolumeQuota, TimeQuota and EventQuota IEs, to see if they are given again.
+	quotas [3]*ie.IE
+
+	seq         uint32
+	start       time.Time
+	periodStart time.Time
+	first, last time.Time
+
+	// the usage since the last report, and since the quotas were given.
+	vol, quotaVol       volume
+	dur, quotaDur       time.Duration
+	events, quotaEvents uint32
+
+	// clock is the time up to which the duration is counted, and lastPacket is the
+	// time of the last packet regardless of the reports.
+	clock      time.Time
+	lastPacket time.Time
+
+	exhausted bool
+	held      bool
+	monitored bool
+
+	// before is the measurement before the MonitoringTime, which is reported with
+	// the MONIT trigger.
+	before []*ie.IE
+}
+
+type volume struct {
+	ul, dl         uint64
+	ulPkts, dlPkts uint64
+}
+
+// NewUsage returns a Usage that starts measuring the URRs in s at now.
+func NewUsage(s *Session, now time.Time) *Usage {
+	u := &Usage{urrs: map[uint32]*urrUsage{}}
+	u.Update(s, now)
+	return u
+}
+
+// Update makes the Usage follow the URRs in s. The measurement of the URRs added
+// starts at now, and the parameters of the URRs modified are updated without
+// resetting the measurement, except that the usage counted against the quotas is
+// reset when the new quotas are given.
+//
+// The final reports of the URRs removed are returned as
+// UsageReportWithinSessionModificationResponse with the TERMR trigger.
+func (u *Usage) Update(s *Session, now time.Time) []*ie.IE {
+	var reports []*ie.IE
+	for _, id := range sortedIDs(u.urrs) {
+		if s.URRs[id] != nil {
+			continue
+		}
+		x := u.urrs[id]
+		x.advance(now)
+		reports = append(reports, x.reportBefore(ie.UsageReportWithinSessionModificationResponse)...)
+		reports = append(reports, x.report(ie.UsageReportWithinSessionModificationResponse, ie.UsageReportTriggerTERMR, now))
+		delete(u.urrs, id)
+	}
+
+	for _, id := range s.IDs(RuleURR) {
+		x := u.urrs[id]
+		if x == nil {
+			x = &urrUsage{id: id, start: now, periodStart: now, clock: now}
+			u.urrs[id] = x
+		} else if x.urr == s.URRs[id] {
+			continue
+		}
+		x.advance(now)
+		x.configure(s.URRs[id])
+	}
+	return reports
+}
+
+// Count counts a packet of size octets matched by the PDR at now, for the URRs
+// referred to by the PDR. The packet is counted as uplink if the SourceInterface
+// in the PDI is Access, and as downlink otherwise.
+//
+// The reports triggered by the packet are returned.
+func (u *Usage) Count(pdr *ie.IE, size int, now time.Time) []*ie.IE {
+	ids, uplink := refsOf(pdr, ie.URRID)
+
+	var reports []*ie.IE
+	for _, id := range ids {
+		x := u.urrs[id]
+		if x == nil {
+			continue
+		}
+		x.packet(now)
+		if x.method.Has(ie.MeasurementMethodVOLUM) {
+			x.vol.add(uplink, size)
+			x.quotaVol.add(uplink, size)
+		}
+		reports = append(reports, x.evaluate(now)...)
+	}
+	return reports
+}
+
+// CountEvent counts an event detected for the PDR at now, for the URRs referred
+// to by the PDR whose MeasurementMethod has the EVENT flag.
+//
+// The reports triggered by the event are returned.
+func (u *Usage) CountEvent(pdr *ie.IE, now time.Time) []*ie.IE {
+	ids, _ := refsOf(pdr, ie.URRID)
+
+	var reports []*ie.IE
+	for _, id := range ids {
+		x := u.urrs[id]
+		if x == nil {
+			continue
+		}
+		x.advance(now)
+		if x.method.Has(ie.MeasurementMethodEVENT) {
+			x.events++
+			x.quotaEvents++
+		}
+		reports = append(reports, x.evaluate(now)...)
+	}
+	return reports
+}
+
+// Tick advances the duration measurement of all the URRs to now, and returns the
+// reports triggered by the time-based triggers in the order of the URR ID.
+func (u *Usage) Tick(now time.Time) []*ie.IE {
+	var reports []*ie.IE
+	for _, id := range sortedIDs(u.urrs) {
+		x := u.urrs[id]
+		x.advance(now)
+		reports = append(reports, x.evaluate(now)...)
+	}
+	return reports
+}
+
+// Query returns the reports of the URRs with the IMMER trigger as
+// UsageReportWithinSessionModificationResponse, as requested by QueryURR. All the
+// URRs are reported if no ID is given. The unknown IDs are ignored.
+func (u *Usage) Query(now time.Time, ids ...uint32) []*ie.IE {
+	if len(ids) == 0 {
+		ids = sortedIDs(u.urrs)
+	}
+
+	var reports []*ie.IE
+	for _, id := range ids {
+		x := u.urrs[id]
+		if x == nil {
+			continue
+		}
+		x.advance(now)
+		reports = append(reports, x.reportBefore(ie.UsageReportWithinSessionModificationResponse)...)
+		reports = append(reports, x.report(ie.UsageReportWithinSessionModificationResponse, ie.UsageReportTriggerIMMER, now))
+	}
+	return reports
+}
+
+// Terminate returns the final reports of all the URRs with the TERMR trigger as
+// UsageReportWithinSessionDeletionResponse, and stops measuring them.
+func (u *Usage) Terminate(now time.Time) []*ie.IE {
+	var reports []*ie.IE
+	for _, id := range sortedIDs(u.urrs) {
+		x := u.urrs[id]
+		x.advance(now)
+		reports = append(reports, x.reportBefore(ie.UsageReportWithinSessionDeletionResponse)...)
+		reports = append(reports, x.report(ie.UsageReportWithinSessionDeletionResponse, ie.UsageReportTriggerTERMR, now))
+	}
+	u.urrs = map[uint32]*urrUsage{}
+	return reports
+}
+
+// Exhausted reports whether any of the quotas of the URR is exhausted, i.e., the
+// report with the VOLQU, TIMQU or EVEQU trigger has been generated and no new
+// quota has been given since then.
+func (u *Usage) Exhausted(id uint32) bool {
+	x := u.urrs[id]
+	return x != nil && x.exhausted
+}
+
+// configure reads the parameters in the URR.
+func (x *urrUsage) configure(urr *ie.IE) {
+	x.urr = urr
+	x.method, x.triggers = 0, 0
+	x.period, x.timeThreshold, x.timeQuota, x.holdingTime, x.inactivity = 0, 0, 0, 0, 0
+	x.volThreshold, x.volQuota = nil, nil
+	x.evThreshold, x.evQuota = 0, 0
+
+	var quotas [3]*ie.IE
+	monitoring := x.monitoring
+	x.monitoring = time.Time{}
+
+	children, _ := urr.ValueAsGrouped()
+	for _, c := range children {
+		switch c.Type {
+		case ie.MeasurementMethod:
+			x.method, _ = c.MeasurementMethodFlags()
+		case ie.ReportingTriggers:
+			x.triggers, _ = c.ReportingTriggersFlags()
+		case ie.MeasurementPeriod:
+			x.period, _ = c.MeasurementPeriod()
+		case ie.VolumeThreshold:
+			x.volThreshold, _ = c.VolumeThreshold()
+		case ie.VolumeQuota:
+			x.volQuota, _ = c.VolumeQuota()
+			quotas[0] = c
+		case ie.TimeThreshold:
+			x.timeThreshold, _ = c.TimeThreshold()
+		case ie.TimeQuota:
+			x.timeQuota, _ = c.TimeQuota()
+			quotas[1] = c
+		case ie.EventThreshold:
+			x.evThreshold, _ = c.EventThreshold()
+		case ie.EventQuota:
+			x.evQuota, _ = c.EventQuota()
+			quotas[2] = c
+		case ie.QuotaHoldingTime:
+			x.holdingTime, _ = c.QuotaHoldingTime()
+		case ie.InactivityDetectionTime:
+			v, _ := c.InactivityDetectionTime()
+			x.inactivity = time.Duration(v) * time.Second
+		case ie.MonitoringTime:
+			x.monitoring, _ = c.MonitoringTime()
+		}
+	}
+
+	// the IEs in Session are never modified in place, so the quota IE that is not
+	// the same one as before is the one given by UpdateURR, even if it has the same
+	// value.
+	renewed := false
+	for n := range quotas {
+		if quotas[n] != nil && quotas[n] != x.quotas[n] {
+			renewed = true
+		}
+	}
+	if renewed {
+		x.quotaVol, x.quotaDur, x.quotaEvents = volume{}, 0, 0
+		x.exhausted, x.held = false, false
+	}
+	x.quotas = quotas
+
+	if !x.monitoring.Equal(monitoring) {
+		x.monitored = false
+	}
+}
+
+// packet records a packet at now.
+func (x *urrUsage) packet(now time.Time) {
+	x.advance(now)
+	if x.first.IsZero() {
+		x.first = now
+	}
+	x.last, x.lastPacket = now, now
+	x.held = false
+
+	// the time not counted due to inactivity is skipped.
+	x.clock = now
+}
+
+// advance counts the duration up to now. If the MonitoringTime is reached, the
+// measurement up to it is kept to be reported with the MONIT trigger, and the
+// next one starts at the MonitoringTime.
+func (x *urrUsage) advance(now time.Time) {
+	if !x.monitoring.IsZero() && !x.monitored && !now.Before(x.monitoring) {
+		at := x.monitoring
+		if at.Before(x.start) {
+			at = x.start
+		}
+		x.advanceTo(at)
+		x.before = x.measurement(ie.UsageReportTriggerMONIT, at)
+	}
+	x.advanceTo(now)
+}
+
+// advanceTo counts the duration up to now. The duration is counted from the first
+// packet, and while no packet is seen, it is counted only up to the
+// InactivityDetectionTime after the last packet if it is given.
+func (x *urrUsage) advanceTo(now time.Time) {
+	if x.lastPacket.IsZero() || !x.method.Has(ie.MeasurementMethodDURAT) {
+		x.clock = now
+		return
+	}
+
+	end := now
+	if x.inactivity > 0 && end.After(x.lastPacket.Add(x.inactivity)) {
+		end = x.lastPacket.Add(x.inactivity)
+	}
+	if end.After(x.clock) {
+		d := end.Sub(x.clock)
+		x.dur += d
+		x.quotaDur += d
+		x.clock = end
+	}
+}
+
+// evaluate returns the reports of the reporting triggers met at now: the one with
+// the MONIT trigger if the MonitoringTime is reached, and the one with the other
+// triggers.
+func (x *urrUsage) evaluate(now time.Time) []*ie.IE {
+	reports := x.reportBefore(ie.UsageReportWithinSessionReportRequest)
+
+	var trigger ie.UsageReportTriggerFlags
+
+	if x.triggers.Has(ie.ReportingTriggersPERIO) && x.period > 0 && now.Sub(x.periodStart) >= x.period {
+		trigger.Set(ie.UsageReportTriggerPERIO)
+		for now.Sub(x.periodStart) >= x.period {
+			x.periodStart = x.periodStart.Add(x.period)
+		}
+	}
+	if x.triggers.Has(ie.ReportingTriggersVOLTH) && x.volThreshold != nil && x.vol.reached(x.volThreshold.Flags, x.volThreshold.TotalVolume, x.volThreshold.UplinkVolume, x.volThreshold.DownlinkVolume) {
+		trigger.Set(ie.UsageReportTriggerVOLTH)
+	}
+	if x.triggers.Has(ie.ReportingTriggersTIMTH) && x.timeThreshold > 0 && x.dur >= x.timeThreshold {
+		trigger.Set(ie.UsageReportTriggerTIMTH)
+	}
+	if x.triggers.Has(ie.ReportingTriggersEVETH) && x.evThreshold > 0 && x.events >= x.evThreshold {
+		trigger.Set(ie.UsageReportTriggerEVETH)
+	}
+
+	if !x.exhausted {
+		if x.triggers.Has(ie.ReportingTriggersVOLQU) && x.volQuota != nil && x.quotaVol.reached(x.volQuota.Flags, x.volQuota.TotalVolume, x.volQuota.UplinkVolume, x.volQuota.DownlinkVolume) {
+			trigger.Set(ie.UsageReportTriggerVOLQU)
+		}
+		if x.triggers.Has(ie.ReportingTriggersTIMQU) && x.quotas[1] != nil && x.quotaDur >= x.timeQuota {
+			trigger.Set(ie.UsageReportTriggerTIMQU)
+		}
+		if x.triggers.Has(ie.ReportingTriggersEVEQU) && x.quotas[2] != nil && x.quotaEvents >= x.evQuota {
+			trigger.Set(ie.UsageReportTriggerEVEQU)
+		}
+		if trigger&(ie.UsageReportTriggerVOLQU|ie.UsageReportTriggerTIMQU|ie.UsageReportTriggerEVEQU) != 0 {
+			x.exhausted = true
+		}
+	}
+
+	hasQuota := x.quotas[0] != nil || x.quotas[1] != nil || x.quotas[2] != nil
+	if x.triggers.Has(ie.ReportingTriggersQUHTI) && hasQuota && x.holdingTime > 0 && !x.held &&
+		!x.lastPacket.IsZero() && now.Sub(x.lastPacket) >= x.holdingTime {
+		trigger.Set(ie.UsageReportTriggerQUHTI)
+		x.held = true
+	}
+
+	if trigger == 0 {
+		return reports
+	}
+	return append(reports, x.report(ie.UsageReportWithinSessionReportRequest, trigger, now))
+}
+
+// reportBefore returns the measurement before the MonitoringTime as the
+// UsageReport IE of the given type, if it is not reported yet.
+func (x *urrUsage) reportBefore(typ uint16) []*ie.IE {
+	if x.before == nil {
+		return nil
+	}
+	r := ie.NewUsageReport(typ, x.before...)
+	x.before = nil
+	return []*ie.IE{r}
+}
+
+// report returns the usage since the last report as the UsageReport IE of the
+// given type, and starts the next measurement at now.
+func (x *urrUsage) report(typ uint16, trigger ie.UsageReportTriggerFlags, now time.Time) *ie.IE {
+	return ie.NewUsageReport(typ, x.measurement(trigger, now)...)
+}
+
+// measurement returns the IEs in the UsageReport of the usage since the last
+// report, and starts the next measurement at now.
+func (x *urrUsage) measurement(trigger ie.UsageReportTriggerFlags, now time.Time) []*ie.IE {
+	ies := []*ie.IE{
+		ie.NewURRID(x.id),
+		ie.NewURSEQN(x.seq),
+		ie.NewUsageReportTriggerFlags(trigger),
+		ie.NewStartTime(x.start),
+		ie.NewEndTime(now),
+	}
+	if x.method.Has(ie.MeasurementMethodVOLUM) {
+		v := x.vol
+		ies = append(ies, ie.NewVolumeMeasurement(0x3f, v.ul+v.dl, v.ul, v.dl, v.ulPkts+v.dlPkts, v.ulPkts, v.dlPkts))
+	}
+	if x.method.Has(ie.MeasurementMethodDURAT) {
+		ies = append(ies, ie.NewDurationMeasurement(x.dur))
+	}
+	if !x.first.IsZero() {
+		ies = append(ies, ie.NewTimeOfFirstPacket(x.first), ie.NewTimeOfLastPacket(x.last))
+	}
+	switch {
+	case trigger.Has(ie.UsageReportTriggerMONIT):
+		ies = append(ies, ie.NewUsageInformation(1, 0, 0, 0))
+		x.monitored = true
+	case x.monitored:
+		ies = append(ies, ie.NewUsageInformation(0, 1, 0, 0))
+	}
+
+	x.seq++
+	x.start = now
+	x.first, x.last = time.Time{}, time.Time{}
+	x.vol, x.dur, x.events = volume{}, 0, 0
+	return ies
+}
+
+func (v *volume) add(uplink bool, size int) {
+	if uplink {
+		v.ul += uint64(size)
+		v.ulPkts++
+	} else {
+		v.dl += uint64(size)
+		v.dlPkts++
+	}
+}
+
+// reached reports whether any of the volumes given with the flags of
+// VolumeThreshold or VolumeQuota is reached.
+func (v *volume) reached(flags uint8, total, ul, dl uint64) bool {
+	return (flags&0x01 != 0 && v.ul+v.dl >= total) ||
+		(flags&0x02 != 0 && v.ul >= ul) ||
+		(flags&0x04 != 0 && v.dl >= dl)
+}
+
+// refsOf returns the IDs of the rules of itype in the PDR, e.g., URRID, and
+// whether the packets matched by the PDR are uplink.
+func refsOf(pdr *ie.IE, itype uint16) ([]uint32, bool) {
+	children, err := pdr.ValueAsGrouped()
+	if err != nil {
+		return nil, false
+	}
+
+	var (
+		ids    []uint32
+		uplink bool
+	)
+	for _, c := range children {
+		switch c.Type {
+		case itype:
+			ids = append(ids, uintOf(c.Payload))
+		case ie.PDI:
+			if v, err := c.SourceInterface(); err == nil {
+				uplink = v == ie.SrcInterfaceAccess
+			}
+		}
+	}
+	return ids, uplink
+}
+
+func sortedIDs[T any](m map[uint32]T) []uint32 {
+	ids := make([]uint32, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
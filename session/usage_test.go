@@ -0,0 +1,268 @@
+// Copyright 2019-2024 go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aalayanahmad/go-pfcp/ie"
+	"github.com/aalayanahmad/go-pfcp/session"
+	"github.com/google/go-cmp/cmp"
+)
+
+var t0 = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newURR(id uint32, method ie.MeasurementMethodFlags, triggers ie.ReportingTriggersFlags, ies ...*ie.IE) *ie.IE {
+	return ie.NewCreateURR(append([]*ie.IE{
+		ie.NewURRID(id),
+		ie.NewMeasurementMethodFlags(method),
+		ie.NewReportingTriggersFlags(triggers),
+	}, ies...)...)
+}
+
+func newReport(typ uint16, id uint32, trigger ie.UsageReportTriggerFlags, seq uint32, start, end time.Duration, ies ...*ie.IE) *ie.IE {
+	return ie.NewUsageReport(typ, append([]*ie.IE{
+		ie.NewURRID(id),
+		ie.NewURSEQN(seq),
+		ie.NewUsageReportTriggerFlags(trigger),
+		ie.NewStartTime(t0.Add(start)),
+		ie.NewEndTime(t0.Add(end)),
+	}, ies...)...)
+}
+
+func packets(first, last time.Duration) []*ie.IE {
+	return []*ie.IE{ie.NewTimeOfFirstPacket(t0.Add(first)), ie.NewTimeOfLastPacket(t0.Add(last))}
+}
+
+func TestUsage(t *testing.T) {
+	type action func(u *session.Usage, pdr *ie.IE, now time.Time) []*ie.IE
+	count := func(size int) action {
+		return func(u *session.Usage, pdr *ie.IE, now time.Time) []*ie.IE { return u.Count(pdr, size, now) }
+	}
+	event := func(u *session.Usage, pdr *ie.IE, now time.Time) []*ie.IE { return u.CountEvent(pdr, now) }
+	tick := func(u *session.Usage, _ *ie.IE, now time.Time) []*ie.IE { return u.Tick(now) }
+	report := func(trigger ie.UsageReportTriggerFlags, seq uint32, start, end time.Duration, ies ...*ie.IE) []*ie.IE {
+		return []*ie.IE{newReport(ie.UsageReportWithinSessionReportRequest, 1, trigger, seq, start, end, ies...)}
+	}
+
+	type step struct {
+		at   time.Duration
+		do   action
+		want []*ie.IE
+	}
+	cases := []struct {
+		description string
+		urr         *ie.IE
+		src         ie.SourceInterfaceValue
+		steps       []step
+	}{
+		{
+			"VolumeThreshold",
+			newURR(1, ie.MeasurementMethodVOLUM, ie.ReportingTriggersVOLTH, ie.NewVolumeThreshold(0x01, 1000, 0, 0)),
+			ie.SrcInterfaceAccess,
+			[]step{
+				{0, count(600), nil},
+				{time.Second, count(500), report(
+					ie.UsageReportTriggerVOLTH, 0, 0, time.Second,
+					append([]*ie.IE{ie.NewVolumeMeasurement(0x3f, 1100, 1100, 0, 2, 2, 0)}, packets(0, time.Second)...)...,
+				)},
+				{2 * time.Second, count(100), nil},
+			},
+		}, {
+			"VolumeQuota",
+			newURR(1, ie.MeasurementMethodVOLUM, ie.ReportingTriggersVOLQU, ie.NewVolumeQuota(0x04, 0, 0, 1000)),
+			ie.SrcInterfaceCore,
+			[]step{
+				{0, count(1000), report(
+					ie.UsageReportTriggerVOLQU, 0, 0, 0,
+					append([]*ie.IE{ie.NewVolumeMeasurement(0x3f, 1000, 0, 1000, 1, 0, 1)}, packets(0, 0)...)...,
+				)},
+				{time.Second, count(1000), nil},
+			},
+		}, {
+			"TimeThreshold/Inactivity",
+			newURR(1, ie.MeasurementMethodDURAT, ie.ReportingTriggersTIMTH, ie.NewTimeThreshold(10*time.Second), ie.NewInactivityDetectionTime(3)),
+			ie.SrcInterfaceAccess,
+			[]step{
+				{0, count(100), nil},
+				{5 * time.Second, count(100), nil},
+				{20 * time.Second, tick, nil},
+				{21 * time.Second, count(100), nil},
+				{22 * time.Second, count(100), nil},
+				{24 * time.Second, tick, nil},
+				{25 * time.Second, tick, report(
+					ie.UsageReportTriggerTIMTH, 0, 0, 25*time.Second,
+					append([]*ie.IE{ie.NewDurationMeasurement(10 * time.Second)}, packets(0, 22*time.Second)...)...,
+				)},
+			},
+		}, {
+			"Periodic",
+			newURR(1, ie.MeasurementMethodVOLUM, ie.ReportingTriggersPERIO, ie.NewMeasurementPeriod(60*time.Second)),
+			ie.SrcInterfaceAccess,
+			[]step{
+				{10 * time.Second, count(100), nil},
+				{59 * time.Second, tick, nil},
+				{60 * time.Second, tick, report(
+					ie.UsageReportTriggerPERIO, 0, 0, 60*time.Second,
+					append([]*ie.IE{ie.NewVolumeMeasurement(0x3f, 100, 100, 0, 1, 1, 0)}, packets(10*time.Second, 10*time.Second)...)...,
+				)},
+				{125 * time.Second, tick, report(
+					ie.UsageReportTriggerPERIO, 1, 60*time.Second, 125*time.Second,
+					ie.NewVolumeMeasurement(0x3f, 0, 0, 0, 0, 0, 0),
+				)},
+				{170 * time.Second, tick, nil},
+			},
+		}, {
+			"QuotaHoldingTime",
+			newURR(1, ie.MeasurementMethodDURAT, ie.ReportingTriggersQUHTI|ie.ReportingTriggersTIMQU,
+				ie.NewTimeQuota(100*time.Second), ie.NewQuotaHoldingTime(5*time.Second), ie.NewInactivityDetectionTime(1)),
+			ie.SrcInterfaceAccess,
+			[]step{
+				{0, count(100), nil},
+				{4 * time.Second, tick, nil},
+				{5 * time.Second, tick, report(
+					ie.UsageReportTriggerQUHTI, 0, 0, 5*time.Second,
+					append([]*ie.IE{ie.NewDurationMeasurement(time.Second)}, packets(0, 0)...)...,
+				)},
+				{6 * time.Second, tick, nil},
+			},
+		}, {
+			"TimeQuota",
+			newURR(1, ie.MeasurementMethodDURAT, ie.ReportingTriggersTIMQU, ie.NewTimeQuota(10*time.Second)),
+			ie.SrcInterfaceAccess,
+			[]step{
+				{0, count(100), nil},
+				{10 * time.Second, tick, report(
+					ie.UsageReportTriggerTIMQU, 0, 0, 10*time.Second,
+					append([]*ie.IE{ie.NewDurationMeasurement(10 * time.Second)}, packets(0, 0)...)...,
+				)},
+				{20 * time.Second, tick, nil},
+			},
+		}, {
+			"EventThreshold",
+			newURR(1, ie.MeasurementMethodEVENT, ie.ReportingTriggersEVETH, ie.NewEventThreshold(2)),
+			ie.SrcInterfaceAccess,
+			[]step{
+				{0, event, nil},
+				{time.Second, event, report(ie.UsageReportTriggerEVETH, 0, 0, time.Second)},
+				{2 * time.Second, event, nil},
+			},
+		}, {
+			"MonitoringTime",
+			newURR(1, ie.MeasurementMethodVOLUM, ie.ReportingTriggersVOLTH,
+				ie.NewVolumeThreshold(0x01, 1000, 0, 0), ie.NewMonitoringTime(t0.Add(30*time.Second))),
+			ie.SrcInterfaceAccess,
+			[]step{
+				{10 * time.Second, count(100), nil},
+				{30 * time.Second, tick, report(
+					ie.UsageReportTriggerMONIT, 0, 0, 30*time.Second,
+					append(append([]*ie.IE{ie.NewVolumeMeasurement(0x3f, 100, 100, 0, 1, 1, 0)}, packets(10*time.Second, 10*time.Second)...), ie.NewUsageInformation(1, 0, 0, 0))...,
+				)},
+				{40 * time.Second, count(1000), report(
+					ie.UsageReportTriggerVOLTH, 1, 30*time.Second, 40*time.Second,
+					append(append([]*ie.IE{ie.NewVolumeMeasurement(0x3f, 1000, 1000, 0, 1, 1, 0)}, packets(40*time.Second, 40*time.Second)...), ie.NewUsageInformation(0, 1, 0, 0))...,
+				)},
+			},
+		}, {
+			"MonitoringTime/Split",
+			newURR(1, ie.MeasurementMethodVOLUM, ie.ReportingTriggersVOLTH,
+				ie.NewVolumeThreshold(0x01, 1000, 0, 0), ie.NewMonitoringTime(t0.Add(10*time.Second))),
+			ie.SrcInterfaceAccess,
+			[]step{
+				{5 * time.Second, count(100), nil},
+				// the packet after the MonitoringTime is not in the report before it.
+				{15 * time.Second, count(100), report(
+					ie.UsageReportTriggerMONIT, 0, 0, 10*time.Second,
+					append(append([]*ie.IE{ie.NewVolumeMeasurement(0x3f, 100, 100, 0, 1, 1, 0)}, packets(5*time.Second, 5*time.Second)...), ie.NewUsageInformation(1, 0, 0, 0))...,
+				)},
+				{20 * time.Second, count(900), report(
+					ie.UsageReportTriggerVOLTH, 1, 10*time.Second, 20*time.Second,
+					append(append([]*ie.IE{ie.NewVolumeMeasurement(0x3f, 1000, 1000, 0, 2, 2, 0)}, packets(15*time.Second, 20*time.Second)...), ie.NewUsageInformation(0, 1, 0, 0))...,
+				)},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			pdr := newRulePDR(1, c.src, 1, ie.NewURRID(1))
+			u := session.NewUsage(mustSession(t, pdr, newFAR(1, 1), c.urr), t0)
+
+			for _, s := range c.steps {
+				got := s.do(u, pdr, t0.Add(s.at))
+				if diff := cmp.Diff(s.want, got); diff != "" {
+					t.Errorf("at %v: %s", s.at, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUsageUpdate(t *testing.T) {
+	pdr := newRulePDR(1, ie.SrcInterfaceAccess, 1, ie.NewURRID(1), ie.NewURRID(2))
+	s := mustSession(t,
+		pdr,
+		newFAR(1, 1),
+		newURR(1, ie.MeasurementMethodVOLUM, ie.ReportingTriggersVOLQU, ie.NewVolumeQuota(0x01, 1000, 0, 0)),
+		newURR(2, ie.MeasurementMethodEVENT, 0),
+	)
+	u := session.NewUsage(s, t0)
+
+	if got := u.Count(pdr, 1000, t0); len(got) != 1 {
+		t.Fatalf("got %d reports, want 1", len(got))
+	}
+	if !u.Exhausted(1) {
+		t.Error("quota not exhausted")
+	}
+
+	// the update without the quota does not renew it.
+	if err := s.Apply(newModification(ie.NewUpdateURR(ie.NewURRID(1), ie.NewReportingTriggersFlags(ie.ReportingTriggersVOLQU)))); err != nil {
+		t.Fatal(err)
+	}
+	if got := u.Update(s, t0.Add(time.Second)); len(got) != 0 {
+		t.Errorf("unexpected reports: %v", got)
+	}
+	if !u.Exhausted(1) {
+		t.Error("quota renewed without VolumeQuota")
+	}
+
+	// the same quota given again is a new one.
+	if err := s.Apply(newModification(
+		ie.NewUpdateURR(ie.NewURRID(1), ie.NewVolumeQuota(0x01, 1000, 0, 0)),
+		ie.NewRemoveURR(ie.NewURRID(2)),
+	)); err != nil {
+		t.Fatal(err)
+	}
+	got := u.Update(s, t0.Add(2*time.Second))
+	want := []*ie.IE{
+		newReport(ie.UsageReportWithinSessionModificationResponse, 2, ie.UsageReportTriggerTERMR, 0, 0, 2*time.Second, packets(0, 0)...),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+	if u.Exhausted(1) {
+		t.Error("quota not renewed")
+	}
+
+	u.Count(pdr, 500, t0.Add(3*time.Second))
+	got = u.Query(t0.Add(4 * time.Second))
+	want = []*ie.IE{
+		newReport(ie.UsageReportWithinSessionModificationResponse, 1, ie.UsageReportTriggerIMMER, 1, 0, 4*time.Second,
+			append([]*ie.IE{ie.NewVolumeMeasurement(0x3f, 500, 500, 0, 1, 1, 0)}, packets(3*time.Second, 3*time.Second)...)...),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+
+	got = u.Terminate(t0.Add(5 * time.Second))
+	want = []*ie.IE{
+		newReport(ie.UsageReportWithinSessionDeletionResponse, 1, ie.UsageReportTriggerTERMR, 2, 4*time.Second, 5*time.Second,
+			ie.NewVolumeMeasurement(0x3f, 0, 0, 0, 0, 0, 0)),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}